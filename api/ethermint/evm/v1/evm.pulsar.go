@@ -152,6 +152,108 @@ func (x *_Params_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]*PrecompileRegistration
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrecompileRegistration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrecompileRegistration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	v := new(PrecompileRegistration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := new(PrecompileRegistration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*OpCodeGas
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OpCodeGas)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OpCodeGas)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(OpCodeGas)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(OpCodeGas)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                           protoreflect.MessageDescriptor
 	fd_Params_extra_eips                protoreflect.FieldDescriptor
@@ -159,6 +261,10 @@ var (
 	fd_Params_evm_channels              protoreflect.FieldDescriptor
 	fd_Params_access_control            protoreflect.FieldDescriptor
 	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
+	fd_Params_precompile_registry       protoreflect.FieldDescriptor
+	fd_Params_gas_schedule              protoreflect.FieldDescriptor
+	fd_Params_max_code_size             protoreflect.FieldDescriptor
+	fd_Params_max_init_code_size        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -169,6 +275,10 @@ func init() {
 	fd_Params_evm_channels = md_Params.Fields().ByName("evm_channels")
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
+	fd_Params_precompile_registry = md_Params.Fields().ByName("precompile_registry")
+	fd_Params_gas_schedule = md_Params.Fields().ByName("gas_schedule")
+	fd_Params_max_code_size = md_Params.Fields().ByName("max_code_size")
+	fd_Params_max_init_code_size = md_Params.Fields().ByName("max_init_code_size")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -266,6 +376,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.PrecompileRegistry) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.PrecompileRegistry})
+		if !f(fd_Params_precompile_registry, value) {
+			return
+		}
+	}
+	if len(x.GasSchedule) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.GasSchedule})
+		if !f(fd_Params_gas_schedule, value) {
+			return
+		}
+	}
+	if x.MaxCodeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCodeSize)
+		if !f(fd_Params_max_code_size, value) {
+			return
+		}
+	}
+	if x.MaxInitCodeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxInitCodeSize)
+		if !f(fd_Params_max_init_code_size, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -291,6 +425,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AccessControl != nil
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		return len(x.ActiveStaticPrecompiles) != 0
+	case "ethermint.evm.v1.Params.precompile_registry":
+		return len(x.PrecompileRegistry) != 0
+	case "ethermint.evm.v1.Params.gas_schedule":
+		return len(x.GasSchedule) != 0
+	case "ethermint.evm.v1.Params.max_code_size":
+		return x.MaxCodeSize != uint64(0)
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		return x.MaxInitCodeSize != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -317,6 +459,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AccessControl = nil
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		x.ActiveStaticPrecompiles = nil
+	case "ethermint.evm.v1.Params.precompile_registry":
+		x.PrecompileRegistry = nil
+	case "ethermint.evm.v1.Params.gas_schedule":
+		x.GasSchedule = nil
+	case "ethermint.evm.v1.Params.max_code_size":
+		x.MaxCodeSize = uint64(0)
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		x.MaxInitCodeSize = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -357,6 +507,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_10_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.precompile_registry":
+		if len(x.PrecompileRegistry) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.PrecompileRegistry}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.gas_schedule":
+		if len(x.GasSchedule) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.GasSchedule}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.max_code_size":
+		value := x.MaxCodeSize
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		value := x.MaxInitCodeSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -393,6 +561,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_10_list)
 		x.ActiveStaticPrecompiles = *clv.list
+	case "ethermint.evm.v1.Params.precompile_registry":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.PrecompileRegistry = *clv.list
+	case "ethermint.evm.v1.Params.gas_schedule":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.GasSchedule = *clv.list
+	case "ethermint.evm.v1.Params.max_code_size":
+		x.MaxCodeSize = value.Uint()
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		x.MaxInitCodeSize = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -436,8 +616,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_10_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.precompile_registry":
+		if x.PrecompileRegistry == nil {
+			x.PrecompileRegistry = []*PrecompileRegistration{}
+		}
+		value := &_Params_11_list{list: &x.PrecompileRegistry}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.gas_schedule":
+		if x.GasSchedule == nil {
+			x.GasSchedule = []*OpCodeGas{}
+		}
+		value := &_Params_12_list{list: &x.GasSchedule}
+		return protoreflect.ValueOfList(value)
 	case "ethermint.evm.v1.Params.allow_unprotected_txs":
 		panic(fmt.Errorf("field allow_unprotected_txs of message ethermint.evm.v1.Params is not mutable"))
+	case "ethermint.evm.v1.Params.max_code_size":
+		panic(fmt.Errorf("field max_code_size of message ethermint.evm.v1.Params is not mutable"))
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		panic(fmt.Errorf("field max_init_code_size of message ethermint.evm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -465,6 +661,16 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "ethermint.evm.v1.Params.precompile_registry":
+		list := []*PrecompileRegistration{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "ethermint.evm.v1.Params.gas_schedule":
+		list := []*OpCodeGas{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "ethermint.evm.v1.Params.max_code_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Params.max_init_code_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -559,6 +765,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.PrecompileRegistry) > 0 {
+			for _, e := range x.PrecompileRegistry {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GasSchedule) > 0 {
+			for _, e := range x.GasSchedule {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxCodeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCodeSize))
+		}
+		if x.MaxInitCodeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxInitCodeSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -588,6 +812,48 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxInitCodeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxInitCodeSize))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxCodeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCodeSize))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.GasSchedule) > 0 {
+			for iNdEx := len(x.GasSchedule) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GasSchedule[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.PrecompileRegistry) > 0 {
+			for iNdEx := len(x.PrecompileRegistry) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PrecompileRegistry[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.ActiveStaticPrecompiles) > 0 {
 			for iNdEx := len(x.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ActiveStaticPrecompiles[iNdEx])
@@ -739,10 +1005,1159 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.AllowUnprotectedTxs = bool(v != 0)
-			case 8:
+				x.AllowUnprotectedTxs = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmChannels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvmChannels = append(x.EvmChannels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AccessControl == nil {
+					x.AccessControl = &AccessControl{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessControl); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveStaticPrecompiles", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecompileRegistry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PrecompileRegistry = append(x.PrecompileRegistry, &PrecompileRegistration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrecompileRegistry[len(x.PrecompileRegistry)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasSchedule = append(x.GasSchedule, &OpCodeGas{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasSchedule[len(x.GasSchedule)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+				}
+				x.MaxCodeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCodeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+				}
+				x.MaxInitCodeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxInitCodeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OpCodeGas              protoreflect.MessageDescriptor
+	fd_OpCodeGas_op_code      protoreflect.FieldDescriptor
+	fd_OpCodeGas_constant_gas protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_OpCodeGas = File_ethermint_evm_v1_evm_proto.Messages().ByName("OpCodeGas")
+	fd_OpCodeGas_op_code = md_OpCodeGas.Fields().ByName("op_code")
+	fd_OpCodeGas_constant_gas = md_OpCodeGas.Fields().ByName("constant_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_OpCodeGas)(nil)
+
+type fastReflection_OpCodeGas OpCodeGas
+
+func (x *OpCodeGas) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OpCodeGas)(x)
+}
+
+func (x *OpCodeGas) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OpCodeGas_messageType fastReflection_OpCodeGas_messageType
+var _ protoreflect.MessageType = fastReflection_OpCodeGas_messageType{}
+
+type fastReflection_OpCodeGas_messageType struct{}
+
+func (x fastReflection_OpCodeGas_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OpCodeGas)(nil)
+}
+func (x fastReflection_OpCodeGas_messageType) New() protoreflect.Message {
+	return new(fastReflection_OpCodeGas)
+}
+func (x fastReflection_OpCodeGas_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OpCodeGas
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OpCodeGas) Descriptor() protoreflect.MessageDescriptor {
+	return md_OpCodeGas
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OpCodeGas) Type() protoreflect.MessageType {
+	return _fastReflection_OpCodeGas_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OpCodeGas) New() protoreflect.Message {
+	return new(fastReflection_OpCodeGas)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OpCodeGas) Interface() protoreflect.ProtoMessage {
+	return (*OpCodeGas)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OpCodeGas) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OpCode != "" {
+		value := protoreflect.ValueOfString(x.OpCode)
+		if !f(fd_OpCodeGas_op_code, value) {
+			return
+		}
+	}
+	if x.ConstantGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ConstantGas)
+		if !f(fd_OpCodeGas_constant_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OpCodeGas) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		return x.OpCode != ""
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		return x.ConstantGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OpCodeGas) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		x.OpCode = ""
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		x.ConstantGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OpCodeGas) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		value := x.OpCode
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		value := x.ConstantGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OpCodeGas) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		x.OpCode = value.Interface().(string)
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		x.ConstantGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OpCodeGas) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		panic(fmt.Errorf("field op_code of message ethermint.evm.v1.OpCodeGas is not mutable"))
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		panic(fmt.Errorf("field constant_gas of message ethermint.evm.v1.OpCodeGas is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OpCodeGas) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.OpCodeGas.op_code":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.OpCodeGas.constant_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OpCodeGas"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.OpCodeGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OpCodeGas) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.OpCodeGas", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OpCodeGas) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OpCodeGas) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OpCodeGas) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OpCodeGas) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OpCodeGas)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OpCode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConstantGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConstantGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OpCodeGas)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ConstantGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConstantGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OpCode) > 0 {
+			i -= len(x.OpCode)
+			copy(dAtA[i:], x.OpCode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OpCode)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OpCodeGas)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OpCodeGas: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OpCodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpCode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OpCode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+				}
+				x.ConstantGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConstantGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PrecompileRegistration                   protoreflect.MessageDescriptor
+	fd_PrecompileRegistration_address           protoreflect.FieldDescriptor
+	fd_PrecompileRegistration_activation_height protoreflect.FieldDescriptor
+	fd_PrecompileRegistration_base_gas          protoreflect.FieldDescriptor
+	fd_PrecompileRegistration_per_byte_gas      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_PrecompileRegistration = File_ethermint_evm_v1_evm_proto.Messages().ByName("PrecompileRegistration")
+	fd_PrecompileRegistration_address = md_PrecompileRegistration.Fields().ByName("address")
+	fd_PrecompileRegistration_activation_height = md_PrecompileRegistration.Fields().ByName("activation_height")
+	fd_PrecompileRegistration_base_gas = md_PrecompileRegistration.Fields().ByName("base_gas")
+	fd_PrecompileRegistration_per_byte_gas = md_PrecompileRegistration.Fields().ByName("per_byte_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_PrecompileRegistration)(nil)
+
+type fastReflection_PrecompileRegistration PrecompileRegistration
+
+func (x *PrecompileRegistration) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrecompileRegistration)(x)
+}
+
+func (x *PrecompileRegistration) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrecompileRegistration_messageType fastReflection_PrecompileRegistration_messageType
+var _ protoreflect.MessageType = fastReflection_PrecompileRegistration_messageType{}
+
+type fastReflection_PrecompileRegistration_messageType struct{}
+
+func (x fastReflection_PrecompileRegistration_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrecompileRegistration)(nil)
+}
+func (x fastReflection_PrecompileRegistration_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrecompileRegistration)
+}
+func (x fastReflection_PrecompileRegistration_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileRegistration
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrecompileRegistration) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileRegistration
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrecompileRegistration) Type() protoreflect.MessageType {
+	return _fastReflection_PrecompileRegistration_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrecompileRegistration) New() protoreflect.Message {
+	return new(fastReflection_PrecompileRegistration)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrecompileRegistration) Interface() protoreflect.ProtoMessage {
+	return (*PrecompileRegistration)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrecompileRegistration) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PrecompileRegistration_address, value) {
+			return
+		}
+	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_PrecompileRegistration_activation_height, value) {
+			return
+		}
+	}
+	if x.BaseGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseGas)
+		if !f(fd_PrecompileRegistration_base_gas, value) {
+			return
+		}
+	}
+	if x.PerByteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerByteGas)
+		if !f(fd_PrecompileRegistration_per_byte_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrecompileRegistration) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		return x.Address != ""
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		return x.BaseGas != uint64(0)
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		return x.PerByteGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileRegistration) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		x.Address = ""
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		x.ActivationHeight = int64(0)
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		x.BaseGas = uint64(0)
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		x.PerByteGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrecompileRegistration) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		value := x.BaseGas
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		value := x.PerByteGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileRegistration) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		x.Address = value.Interface().(string)
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		x.ActivationHeight = value.Int()
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		x.BaseGas = value.Uint()
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		x.PerByteGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileRegistration) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		panic(fmt.Errorf("field address of message ethermint.evm.v1.PrecompileRegistration is not mutable"))
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		panic(fmt.Errorf("field activation_height of message ethermint.evm.v1.PrecompileRegistration is not mutable"))
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		panic(fmt.Errorf("field base_gas of message ethermint.evm.v1.PrecompileRegistration is not mutable"))
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		panic(fmt.Errorf("field per_byte_gas of message ethermint.evm.v1.PrecompileRegistration is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrecompileRegistration) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.PrecompileRegistration.address":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.PrecompileRegistration.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "ethermint.evm.v1.PrecompileRegistration.base_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.PrecompileRegistration.per_byte_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.PrecompileRegistration"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.PrecompileRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrecompileRegistration) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.PrecompileRegistration", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrecompileRegistration) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileRegistration) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrecompileRegistration) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrecompileRegistration) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrecompileRegistration)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		if x.BaseGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseGas))
+		}
+		if x.PerByteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerByteGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileRegistration)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PerByteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerByteGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BaseGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileRegistration)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileRegistration: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmChannels", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -770,13 +2185,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EvmChannels = append(x.EvmChannels, string(dAtA[iNdEx:postIndex]))
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 				}
-				var msglen int
+				x.ActivationHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -786,33 +2201,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ActivationHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.AccessControl == nil {
-					x.AccessControl = &AccessControl{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessControl); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveStaticPrecompiles", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
 				}
-				var stringLen uint64
+				x.BaseGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -822,24 +2220,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BaseGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerByteGas", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.PerByteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerByteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -897,7 +2301,7 @@ func (x *AccessControl) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessControl) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +2861,7 @@ func (x *AccessControlType) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,7 +3383,7 @@ func (x *ChainConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3679,7 +5083,7 @@ func (x *State) ProtoReflect() protoreflect.Message {
 }
 
 func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4214,7 +5618,7 @@ func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4781,7 +6185,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5665,7 +7069,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6436,7 +7840,7 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6952,7 +8356,7 @@ func (x *TraceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7992,6 +9396,18 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// precompile_registry defines the static precompiles compiled into the binary
+	// that are scheduled for activation at a given block height, together with
+	// their gas schedule
+	PrecompileRegistry []*PrecompileRegistration `protobuf:"bytes,11,rep,name=precompile_registry,json=precompileRegistry,proto3" json:"precompile_registry,omitempty"`
+	// gas_schedule defines the constant gas overrides for the EVM opcodes
+	GasSchedule []*OpCodeGas `protobuf:"bytes,12,rep,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule,omitempty"`
+	// max_code_size defines the maximum size in bytes of the deployed contract code.
+	// The EIP-170 limit is used if set to zero.
+	MaxCodeSize uint64 `protobuf:"varint,13,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size defines the maximum size in bytes of the contract creation code.
+	// The EIP-3860 limit is used if set to zero.
+	MaxInitCodeSize uint64 `protobuf:"varint,14,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -8049,6 +9465,145 @@ func (x *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (x *Params) GetPrecompileRegistry() []*PrecompileRegistration {
+	if x != nil {
+		return x.PrecompileRegistry
+	}
+	return nil
+}
+
+func (x *Params) GetGasSchedule() []*OpCodeGas {
+	if x != nil {
+		return x.GasSchedule
+	}
+	return nil
+}
+
+func (x *Params) GetMaxCodeSize() uint64 {
+	if x != nil {
+		return x.MaxCodeSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxInitCodeSize() uint64 {
+	if x != nil {
+		return x.MaxInitCodeSize
+	}
+	return 0
+}
+
+// OpCodeGas defines the constant gas charged for an EVM opcode
+type OpCodeGas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// op_code is the name of the opcode (eg: SSTORE)
+	OpCode string `protobuf:"bytes,1,opt,name=op_code,json=opCode,proto3" json:"op_code,omitempty"`
+	// constant_gas is the constant gas charged for the execution of the opcode
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (x *OpCodeGas) Reset() {
+	*x = OpCodeGas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpCodeGas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpCodeGas) ProtoMessage() {}
+
+// Deprecated: Use OpCodeGas.ProtoReflect.Descriptor instead.
+func (*OpCodeGas) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpCodeGas) GetOpCode() string {
+	if x != nil {
+		return x.OpCode
+	}
+	return ""
+}
+
+func (x *OpCodeGas) GetConstantGas() uint64 {
+	if x != nil {
+		return x.ConstantGas
+	}
+	return 0
+}
+
+// PrecompileRegistration defines the activation height and the gas schedule of
+// a static precompile that is compiled into the binary
+type PrecompileRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// activation_height is the block height from which the precompile is active
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// base_gas is the constant gas charged on every call to the precompile
+	BaseGas uint64 `protobuf:"varint,3,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// per_byte_gas is the gas charged for every byte of the call input
+	PerByteGas uint64 `protobuf:"varint,4,opt,name=per_byte_gas,json=perByteGas,proto3" json:"per_byte_gas,omitempty"`
+}
+
+func (x *PrecompileRegistration) Reset() {
+	*x = PrecompileRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileRegistration) ProtoMessage() {}
+
+// Deprecated: Use PrecompileRegistration.ProtoReflect.Descriptor instead.
+func (*PrecompileRegistration) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrecompileRegistration) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PrecompileRegistration) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *PrecompileRegistration) GetBaseGas() uint64 {
+	if x != nil {
+		return x.BaseGas
+	}
+	return 0
+}
+
+func (x *PrecompileRegistration) GetPerByteGas() uint64 {
+	if x != nil {
+		return x.PerByteGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -8065,7 +9620,7 @@ type AccessControl struct {
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8079,7 +9634,7 @@ func (*AccessControl) ProtoMessage() {}
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AccessControl) GetCreate() *AccessControlType {
@@ -8114,7 +9669,7 @@ type AccessControlType struct {
 func (x *AccessControlType) Reset() {
 	*x = AccessControlType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8128,7 +9683,7 @@ func (*AccessControlType) ProtoMessage() {}
 
 // Deprecated: Use AccessControlType.ProtoReflect.Descriptor instead.
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AccessControlType) GetAccessType() AccessType {
@@ -8202,7 +9757,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8216,7 +9771,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -8388,7 +9943,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8402,7 +9957,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *State) GetKey() string {
@@ -8436,7 +9991,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8450,7 +10005,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -8503,7 +10058,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8517,7 +10072,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Log) GetAddress() string {
@@ -8609,7 +10164,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8623,7 +10178,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -8683,7 +10238,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8697,7 +10252,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -8748,7 +10303,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8762,7 +10317,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -8850,7 +10405,7 @@ var file_ethermint_evm_v1_evm_proto_rawDesc = []byte{
 	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73,
 	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72,
 	0x61, 0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65,
@@ -8871,12 +10426,42 @@ var file_ethermint_evm_v1_evm_proto_rawDesc = []byte{
 	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x70, 0x72,
 	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x3a, 0x17, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x65,
-	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
-	0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52,
-	0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0c, 0x67, 0x61, 0x73,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x61, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x3a, 0x17, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10,
+	0x06, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x47, 0x0a, 0x09, 0x4f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x61, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x47, 0x61, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x16, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62,
+	0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x47, 0x61, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
@@ -9150,33 +10735,37 @@ func file_ethermint_evm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_ethermint_evm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ethermint_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ethermint_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ethermint_evm_v1_evm_proto_goTypes = []interface{}{
-	(AccessType)(0),           // 0: ethermint.evm.v1.AccessType
-	(*Params)(nil),            // 1: ethermint.evm.v1.Params
-	(*AccessControl)(nil),     // 2: ethermint.evm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: ethermint.evm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: ethermint.evm.v1.ChainConfig
-	(*State)(nil),             // 5: ethermint.evm.v1.State
-	(*TransactionLogs)(nil),   // 6: ethermint.evm.v1.TransactionLogs
-	(*Log)(nil),               // 7: ethermint.evm.v1.Log
-	(*TxResult)(nil),          // 8: ethermint.evm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: ethermint.evm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: ethermint.evm.v1.TraceConfig
+	(AccessType)(0),                // 0: ethermint.evm.v1.AccessType
+	(*Params)(nil),                 // 1: ethermint.evm.v1.Params
+	(*OpCodeGas)(nil),              // 2: ethermint.evm.v1.OpCodeGas
+	(*PrecompileRegistration)(nil), // 3: ethermint.evm.v1.PrecompileRegistration
+	(*AccessControl)(nil),          // 4: ethermint.evm.v1.AccessControl
+	(*AccessControlType)(nil),      // 5: ethermint.evm.v1.AccessControlType
+	(*ChainConfig)(nil),            // 6: ethermint.evm.v1.ChainConfig
+	(*State)(nil),                  // 7: ethermint.evm.v1.State
+	(*TransactionLogs)(nil),        // 8: ethermint.evm.v1.TransactionLogs
+	(*Log)(nil),                    // 9: ethermint.evm.v1.Log
+	(*TxResult)(nil),               // 10: ethermint.evm.v1.TxResult
+	(*AccessTuple)(nil),            // 11: ethermint.evm.v1.AccessTuple
+	(*TraceConfig)(nil),            // 12: ethermint.evm.v1.TraceConfig
 }
 var file_ethermint_evm_v1_evm_proto_depIdxs = []int32{
-	2, // 0: ethermint.evm.v1.Params.access_control:type_name -> ethermint.evm.v1.AccessControl
-	3, // 1: ethermint.evm.v1.AccessControl.create:type_name -> ethermint.evm.v1.AccessControlType
-	3, // 2: ethermint.evm.v1.AccessControl.call:type_name -> ethermint.evm.v1.AccessControlType
-	0, // 3: ethermint.evm.v1.AccessControlType.access_type:type_name -> ethermint.evm.v1.AccessType
-	7, // 4: ethermint.evm.v1.TransactionLogs.logs:type_name -> ethermint.evm.v1.Log
-	6, // 5: ethermint.evm.v1.TxResult.tx_logs:type_name -> ethermint.evm.v1.TransactionLogs
-	4, // 6: ethermint.evm.v1.TraceConfig.overrides:type_name -> ethermint.evm.v1.ChainConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	4, // 0: ethermint.evm.v1.Params.access_control:type_name -> ethermint.evm.v1.AccessControl
+	3, // 1: ethermint.evm.v1.Params.precompile_registry:type_name -> ethermint.evm.v1.PrecompileRegistration
+	2, // 2: ethermint.evm.v1.Params.gas_schedule:type_name -> ethermint.evm.v1.OpCodeGas
+	5, // 3: ethermint.evm.v1.AccessControl.create:type_name -> ethermint.evm.v1.AccessControlType
+	5, // 4: ethermint.evm.v1.AccessControl.call:type_name -> ethermint.evm.v1.AccessControlType
+	0, // 5: ethermint.evm.v1.AccessControlType.access_type:type_name -> ethermint.evm.v1.AccessType
+	9, // 6: ethermint.evm.v1.TransactionLogs.logs:type_name -> ethermint.evm.v1.Log
+	8, // 7: ethermint.evm.v1.TxResult.tx_logs:type_name -> ethermint.evm.v1.TransactionLogs
+	6, // 8: ethermint.evm.v1.TraceConfig.overrides:type_name -> ethermint.evm.v1.ChainConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_ethermint_evm_v1_evm_proto_init() }
@@ -9198,7 +10787,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*OpCodeGas); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9210,7 +10799,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControlType); i {
+			switch v := v.(*PrecompileRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9222,7 +10811,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9234,7 +10823,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*AccessControlType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9246,7 +10835,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9258,7 +10847,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9270,7 +10859,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9282,7 +10871,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9294,6 +10883,30 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceConfig); i {
 			case 0:
 				return &v.state
@@ -9312,7 +10925,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ethermint_evm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]*FeeLane
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeLane)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeLane)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	v := new(FeeLane)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := new(FeeLane)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                             protoreflect.MessageDescriptor
 	fd_Params_no_base_fee                 protoreflect.FieldDescriptor
@@ -23,6 +74,9 @@ var (
 	fd_Params_base_fee                    protoreflect.FieldDescriptor
 	fd_Params_min_gas_price               protoreflect.FieldDescriptor
 	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	fd_Params_base_fee_history_length     protoreflect.FieldDescriptor
+	fd_Params_fee_lanes                   protoreflect.FieldDescriptor
+	fd_Params_fee_split                   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +89,9 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_base_fee_history_length = md_Params.Fields().ByName("base_fee_history_length")
+	fd_Params_fee_lanes = md_Params.Fields().ByName("fee_lanes")
+	fd_Params_fee_split = md_Params.Fields().ByName("fee_split")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +201,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.BaseFeeHistoryLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeHistoryLength)
+		if !f(fd_Params_base_fee_history_length, value) {
+			return
+		}
+	}
+	if len(x.FeeLanes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.FeeLanes})
+		if !f(fd_Params_fee_lanes, value) {
+			return
+		}
+	}
+	if x.FeeSplit != nil {
+		value := protoreflect.ValueOfMessage(x.FeeSplit.ProtoReflect())
+		if !f(fd_Params_fee_split, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +248,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		return x.BaseFeeHistoryLength != uint64(0)
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		return len(x.FeeLanes) != 0
+	case "ethermint.feemarket.v1.Params.fee_split":
+		return x.FeeSplit != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -203,6 +284,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		x.BaseFeeHistoryLength = uint64(0)
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		x.FeeLanes = nil
+	case "ethermint.feemarket.v1.Params.fee_split":
+		x.FeeSplit = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -240,6 +327,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		value := x.BaseFeeHistoryLength
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		if len(x.FeeLanes) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.FeeLanes}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.feemarket.v1.Params.fee_split":
+		value := x.FeeSplit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -274,6 +373,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		x.BaseFeeHistoryLength = value.Uint()
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.FeeLanes = *clv.list
+	case "ethermint.feemarket.v1.Params.fee_split":
+		x.FeeSplit = value.Message().Interface().(*FeeSplit)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -294,6 +401,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		if x.FeeLanes == nil {
+			x.FeeLanes = []*FeeLane{}
+		}
+		value := &_Params_10_list{list: &x.FeeLanes}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.feemarket.v1.Params.fee_split":
+		if x.FeeSplit == nil {
+			x.FeeSplit = new(FeeSplit)
+		}
+		return protoreflect.ValueOfMessage(x.FeeSplit.ProtoReflect())
 	case "ethermint.feemarket.v1.Params.no_base_fee":
 		panic(fmt.Errorf("field no_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.base_fee_change_denominator":
@@ -308,6 +426,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		panic(fmt.Errorf("field base_fee_history_length of message ethermint.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -335,6 +455,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.base_fee_history_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.Params.fee_lanes":
+		list := []*FeeLane{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "ethermint.feemarket.v1.Params.fee_split":
+		m := new(FeeSplit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -428,6 +556,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BaseFeeHistoryLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeHistoryLength))
+		}
+		if len(x.FeeLanes) > 0 {
+			for _, e := range x.FeeLanes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeSplit != nil {
+			l = options.Size(x.FeeSplit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +598,41 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FeeSplit != nil {
+			encoded, err := options.Marshal(x.FeeSplit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.FeeLanes) > 0 {
+			for iNdEx := len(x.FeeLanes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLanes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.BaseFeeHistoryLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeHistoryLength))
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,6 +901,95 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistoryLength", wireType)
+				}
+				x.BaseFeeHistoryLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeHistoryLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLanes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLanes = append(x.FeeLanes, &FeeLane{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLanes[len(x.FeeLanes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeSplit == nil {
+					x.FeeSplit = &FeeSplit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -760,199 +1025,2035 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Copyright Tharsis Labs Ltd.(Evmos)
-// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+var _ protoreflect.List = (*_FeeLane_2_list)(nil)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: ethermint/feemarket/v1/feemarket.proto
+type _FeeLane_2_list struct {
+	list *[]string
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *_FeeLane_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-// Params defines the feemarket module parameters
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_FeeLane_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
-	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
-	// base_fee_change_denominator bounds the amount the base fee can change
-	// between blocks.
-	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
-	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
-	// have.
-	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
-	// enable_height defines at which block height the base fee calculation is enabled.
-	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
-	// base_fee for EIP-1559 blocks.
-	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
-	// min_gas_price defines the minimum gas price value for cosmos and eth transactions
-	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
-	// min_gas_multiplier bounds the minimum gas used to be charged
-	// to senders based on gas limit
-	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+func (x *_FeeLane_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_FeeLane_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_FeeLane_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeLane at list field MsgTypeUrls as it is not of Message kind"))
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_FeeLane_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+func (x *_FeeLane_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *Params) GetNoBaseFee() bool {
-	if x != nil {
-		return x.NoBaseFee
-	}
-	return false
+func (x *_FeeLane_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Params) GetBaseFeeChangeDenominator() uint32 {
-	if x != nil {
-		return x.BaseFeeChangeDenominator
-	}
-	return 0
+var _ protoreflect.List = (*_FeeLane_3_list)(nil)
+
+type _FeeLane_3_list struct {
+	list *[]EVMAddressClass
 }
 
-func (x *Params) GetElasticityMultiplier() uint32 {
-	if x != nil {
-		return x.ElasticityMultiplier
+func (x *_FeeLane_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return 0
+	return len(*x.list)
 }
 
-func (x *Params) GetEnableHeight() int64 {
-	if x != nil {
-		return x.EnableHeight
-	}
-	return 0
+func (x *_FeeLane_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
 }
 
-func (x *Params) GetBaseFee() string {
-	if x != nil {
-		return x.BaseFee
-	}
-	return ""
+func (x *_FeeLane_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (EVMAddressClass)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Params) GetMinGasPrice() string {
-	if x != nil {
-		return x.MinGasPrice
-	}
-	return ""
+func (x *_FeeLane_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (EVMAddressClass)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Params) GetMinGasMultiplier() string {
-	if x != nil {
-		return x.MinGasMultiplier
-	}
-	return ""
+func (x *_FeeLane_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeLane at list field EvmAddressClasses as it is not of Message kind"))
 }
 
-var File_ethermint_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
+func (x *_FeeLane_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
 
-var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
-	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x73,
-	0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x62, 0x61, 0x73, 0x65, 0x46,
-	0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74,
-	0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x14, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a,
-	0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46,
-	0x65, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x56, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65,
-	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42,
-	0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
-	0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *_FeeLane_3_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_FeeLane_3_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	file_ethermint_feemarket_v1_feemarket_proto_rawDescOnce sync.Once
-	file_ethermint_feemarket_v1_feemarket_proto_rawDescData = file_ethermint_feemarket_v1_feemarket_proto_rawDesc
+	md_FeeLane                          protoreflect.MessageDescriptor
+	fd_FeeLane_name                     protoreflect.FieldDescriptor
+	fd_FeeLane_msg_type_urls            protoreflect.FieldDescriptor
+	fd_FeeLane_evm_address_classes      protoreflect.FieldDescriptor
+	fd_FeeLane_min_gas_price_multiplier protoreflect.FieldDescriptor
+	fd_FeeLane_priority                 protoreflect.FieldDescriptor
 )
 
-func file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
-	file_ethermint_feemarket_v1_feemarket_proto_rawDescOnce.Do(func() {
-		file_ethermint_feemarket_v1_feemarket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ethermint_feemarket_v1_feemarket_proto_rawDescData)
-	})
-	return file_ethermint_feemarket_v1_feemarket_proto_rawDescData
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_FeeLane = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("FeeLane")
+	fd_FeeLane_name = md_FeeLane.Fields().ByName("name")
+	fd_FeeLane_msg_type_urls = md_FeeLane.Fields().ByName("msg_type_urls")
+	fd_FeeLane_evm_address_classes = md_FeeLane.Fields().ByName("evm_address_classes")
+	fd_FeeLane_min_gas_price_multiplier = md_FeeLane.Fields().ByName("min_gas_price_multiplier")
+	fd_FeeLane_priority = md_FeeLane.Fields().ByName("priority")
 }
 
-var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_ethermint_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: ethermint.feemarket.v1.Params
-}
-var file_ethermint_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+var _ protoreflect.Message = (*fastReflection_FeeLane)(nil)
+
+type fastReflection_FeeLane FeeLane
+
+func (x *FeeLane) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeLane)(x)
 }
 
-func init() { file_ethermint_feemarket_v1_feemarket_proto_init() }
-func file_ethermint_feemarket_v1_feemarket_proto_init() {
+func (x *FeeLane) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeLane_messageType fastReflection_FeeLane_messageType
+var _ protoreflect.MessageType = fastReflection_FeeLane_messageType{}
+
+type fastReflection_FeeLane_messageType struct{}
+
+func (x fastReflection_FeeLane_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeLane)(nil)
+}
+func (x fastReflection_FeeLane_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeLane)
+}
+func (x fastReflection_FeeLane_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeLane
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeLane) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeLane
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeLane) Type() protoreflect.MessageType {
+	return _fastReflection_FeeLane_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeLane) New() protoreflect.Message {
+	return new(fastReflection_FeeLane)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeLane) Interface() protoreflect.ProtoMessage {
+	return (*FeeLane)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeLane) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_FeeLane_name, value) {
+			return
+		}
+	}
+	if len(x.MsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_FeeLane_2_list{list: &x.MsgTypeUrls})
+		if !f(fd_FeeLane_msg_type_urls, value) {
+			return
+		}
+	}
+	if len(x.EvmAddressClasses) != 0 {
+		value := protoreflect.ValueOfList(&_FeeLane_3_list{list: &x.EvmAddressClasses})
+		if !f(fd_FeeLane_evm_address_classes, value) {
+			return
+		}
+	}
+	if x.MinGasPriceMultiplier != "" {
+		value := protoreflect.ValueOfString(x.MinGasPriceMultiplier)
+		if !f(fd_FeeLane_min_gas_price_multiplier, value) {
+			return
+		}
+	}
+	if x.Priority != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Priority)
+		if !f(fd_FeeLane_priority, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeLane) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.name":
+		return x.Name != ""
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		return len(x.MsgTypeUrls) != 0
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		return len(x.EvmAddressClasses) != 0
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		return x.MinGasPriceMultiplier != ""
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		return x.Priority != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeLane) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.name":
+		x.Name = ""
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		x.MsgTypeUrls = nil
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		x.EvmAddressClasses = nil
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		x.MinGasPriceMultiplier = ""
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		x.Priority = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeLane) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		if len(x.MsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_FeeLane_2_list{})
+		}
+		listValue := &_FeeLane_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		if len(x.EvmAddressClasses) == 0 {
+			return protoreflect.ValueOfList(&_FeeLane_3_list{})
+		}
+		listValue := &_FeeLane_3_list{list: &x.EvmAddressClasses}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		value := x.MinGasPriceMultiplier
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		value := x.Priority
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeLane) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.name":
+		x.Name = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_FeeLane_2_list)
+		x.MsgTypeUrls = *clv.list
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		lv := value.List()
+		clv := lv.(*_FeeLane_3_list)
+		x.EvmAddressClasses = *clv.list
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		x.MinGasPriceMultiplier = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		x.Priority = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeLane) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		if x.MsgTypeUrls == nil {
+			x.MsgTypeUrls = []string{}
+		}
+		value := &_FeeLane_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		if x.EvmAddressClasses == nil {
+			x.EvmAddressClasses = []EVMAddressClass{}
+		}
+		value := &_FeeLane_3_list{list: &x.EvmAddressClasses}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.feemarket.v1.FeeLane.name":
+		panic(fmt.Errorf("field name of message ethermint.feemarket.v1.FeeLane is not mutable"))
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		panic(fmt.Errorf("field min_gas_price_multiplier of message ethermint.feemarket.v1.FeeLane is not mutable"))
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		panic(fmt.Errorf("field priority of message ethermint.feemarket.v1.FeeLane is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeLane) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeLane.name":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeLane.msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeLane_2_list{list: &list})
+	case "ethermint.feemarket.v1.FeeLane.evm_address_classes":
+		list := []EVMAddressClass{}
+		return protoreflect.ValueOfList(&_FeeLane_3_list{list: &list})
+	case "ethermint.feemarket.v1.FeeLane.min_gas_price_multiplier":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeLane.priority":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeLane"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeLane does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeLane) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.FeeLane", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeLane) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeLane) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeLane) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeLane) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeLane)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypeUrls) > 0 {
+			for _, s := range x.MsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EvmAddressClasses) > 0 {
+			l = 0
+			for _, e := range x.EvmAddressClasses {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.MinGasPriceMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Priority != 0 {
+			n += 1 + runtime.Sov(uint64(x.Priority))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeLane)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Priority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Priority))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MinGasPriceMultiplier) > 0 {
+			i -= len(x.MinGasPriceMultiplier)
+			copy(dAtA[i:], x.MinGasPriceMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPriceMultiplier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.EvmAddressClasses) > 0 {
+			var pksize2 int
+			for _, num := range x.EvmAddressClasses {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.EvmAddressClasses {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MsgTypeUrls) > 0 {
+			for iNdEx := len(x.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.MsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeLane)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeLane: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeLane: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrls = append(x.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v EVMAddressClass
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EVMAddressClass(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.EvmAddressClasses = append(x.EvmAddressClasses, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.EvmAddressClasses) == 0 {
+						x.EvmAddressClasses = make([]EVMAddressClass, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v EVMAddressClass
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= EVMAddressClass(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.EvmAddressClasses = append(x.EvmAddressClasses, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmAddressClasses", wireType)
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceMultiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPriceMultiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				}
+				x.Priority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Priority |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeSplit                          protoreflect.MessageDescriptor
+	fd_FeeSplit_base_fee_burn_share      protoreflect.FieldDescriptor
+	fd_FeeSplit_tip_proposer_share       protoreflect.FieldDescriptor
+	fd_FeeSplit_tip_community_pool_share protoreflect.FieldDescriptor
+	fd_FeeSplit_tip_module_share         protoreflect.FieldDescriptor
+	fd_FeeSplit_tip_module               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_FeeSplit = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("FeeSplit")
+	fd_FeeSplit_base_fee_burn_share = md_FeeSplit.Fields().ByName("base_fee_burn_share")
+	fd_FeeSplit_tip_proposer_share = md_FeeSplit.Fields().ByName("tip_proposer_share")
+	fd_FeeSplit_tip_community_pool_share = md_FeeSplit.Fields().ByName("tip_community_pool_share")
+	fd_FeeSplit_tip_module_share = md_FeeSplit.Fields().ByName("tip_module_share")
+	fd_FeeSplit_tip_module = md_FeeSplit.Fields().ByName("tip_module")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSplit)(nil)
+
+type fastReflection_FeeSplit FeeSplit
+
+func (x *FeeSplit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(x)
+}
+
+func (x *FeeSplit) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSplit_messageType fastReflection_FeeSplit_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSplit_messageType{}
+
+type fastReflection_FeeSplit_messageType struct{}
+
+func (x fastReflection_FeeSplit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(nil)
+}
+func (x fastReflection_FeeSplit_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+func (x fastReflection_FeeSplit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSplit) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSplit) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSplit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSplit) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSplit) Interface() protoreflect.ProtoMessage {
+	return (*FeeSplit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSplit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseFeeBurnShare != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeBurnShare)
+		if !f(fd_FeeSplit_base_fee_burn_share, value) {
+			return
+		}
+	}
+	if x.TipProposerShare != "" {
+		value := protoreflect.ValueOfString(x.TipProposerShare)
+		if !f(fd_FeeSplit_tip_proposer_share, value) {
+			return
+		}
+	}
+	if x.TipCommunityPoolShare != "" {
+		value := protoreflect.ValueOfString(x.TipCommunityPoolShare)
+		if !f(fd_FeeSplit_tip_community_pool_share, value) {
+			return
+		}
+	}
+	if x.TipModuleShare != "" {
+		value := protoreflect.ValueOfString(x.TipModuleShare)
+		if !f(fd_FeeSplit_tip_module_share, value) {
+			return
+		}
+	}
+	if x.TipModule != "" {
+		value := protoreflect.ValueOfString(x.TipModule)
+		if !f(fd_FeeSplit_tip_module, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSplit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		return x.BaseFeeBurnShare != ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		return x.TipProposerShare != ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		return x.TipCommunityPoolShare != ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		return x.TipModuleShare != ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		return x.TipModule != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		x.BaseFeeBurnShare = ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		x.TipProposerShare = ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		x.TipCommunityPoolShare = ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		x.TipModuleShare = ""
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		x.TipModule = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSplit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		value := x.BaseFeeBurnShare
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		value := x.TipProposerShare
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		value := x.TipCommunityPoolShare
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		value := x.TipModuleShare
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		value := x.TipModule
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		x.BaseFeeBurnShare = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		x.TipProposerShare = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		x.TipCommunityPoolShare = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		x.TipModuleShare = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		x.TipModule = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		panic(fmt.Errorf("field base_fee_burn_share of message ethermint.feemarket.v1.FeeSplit is not mutable"))
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		panic(fmt.Errorf("field tip_proposer_share of message ethermint.feemarket.v1.FeeSplit is not mutable"))
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		panic(fmt.Errorf("field tip_community_pool_share of message ethermint.feemarket.v1.FeeSplit is not mutable"))
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		panic(fmt.Errorf("field tip_module_share of message ethermint.feemarket.v1.FeeSplit is not mutable"))
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		panic(fmt.Errorf("field tip_module of message ethermint.feemarket.v1.FeeSplit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSplit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeSplit.base_fee_burn_share":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeSplit.tip_proposer_share":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeSplit.tip_community_pool_share":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeSplit.tip_module_share":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeSplit.tip_module":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSplit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.FeeSplit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSplit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSplit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSplit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseFeeBurnShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TipProposerShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TipCommunityPoolShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TipModuleShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TipModule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TipModule) > 0 {
+			i -= len(x.TipModule)
+			copy(dAtA[i:], x.TipModule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipModule)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TipModuleShare) > 0 {
+			i -= len(x.TipModuleShare)
+			copy(dAtA[i:], x.TipModuleShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipModuleShare)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TipCommunityPoolShare) > 0 {
+			i -= len(x.TipCommunityPoolShare)
+			copy(dAtA[i:], x.TipCommunityPoolShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipCommunityPoolShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TipProposerShare) > 0 {
+			i -= len(x.TipProposerShare)
+			copy(dAtA[i:], x.TipProposerShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipProposerShare)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseFeeBurnShare) > 0 {
+			i -= len(x.BaseFeeBurnShare)
+			copy(dAtA[i:], x.BaseFeeBurnShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeBurnShare)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFeeBurnShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipProposerShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipProposerShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipCommunityPoolShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipCommunityPoolShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipModuleShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipModuleShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipModule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipModule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: ethermint/feemarket/v1/feemarket.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EVMAddressClass enumerates the classes of the `to` address of an Ethereum
+// transaction.
+type EVMAddressClass int32
+
+const (
+	// EVM_ADDRESS_CLASS_UNSPECIFIED defines an invalid/undefined class.
+	EVMAddressClass_EVM_ADDRESS_CLASS_UNSPECIFIED EVMAddressClass = 0
+	// EVM_ADDRESS_CLASS_CONTRACT_CREATION - the transaction has no `to` address.
+	EVMAddressClass_EVM_ADDRESS_CLASS_CONTRACT_CREATION EVMAddressClass = 1
+	// EVM_ADDRESS_CLASS_ACCOUNT - the `to` address is an externally owned account.
+	EVMAddressClass_EVM_ADDRESS_CLASS_ACCOUNT EVMAddressClass = 2
+	// EVM_ADDRESS_CLASS_CONTRACT - the `to` address is a contract with code.
+	EVMAddressClass_EVM_ADDRESS_CLASS_CONTRACT EVMAddressClass = 3
+	// EVM_ADDRESS_CLASS_PRECOMPILE - the `to` address is an active static precompile.
+	EVMAddressClass_EVM_ADDRESS_CLASS_PRECOMPILE EVMAddressClass = 4
+)
+
+// Enum value maps for EVMAddressClass.
+var (
+	EVMAddressClass_name = map[int32]string{
+		0: "EVM_ADDRESS_CLASS_UNSPECIFIED",
+		1: "EVM_ADDRESS_CLASS_CONTRACT_CREATION",
+		2: "EVM_ADDRESS_CLASS_ACCOUNT",
+		3: "EVM_ADDRESS_CLASS_CONTRACT",
+		4: "EVM_ADDRESS_CLASS_PRECOMPILE",
+	}
+	EVMAddressClass_value = map[string]int32{
+		"EVM_ADDRESS_CLASS_UNSPECIFIED":       0,
+		"EVM_ADDRESS_CLASS_CONTRACT_CREATION": 1,
+		"EVM_ADDRESS_CLASS_ACCOUNT":           2,
+		"EVM_ADDRESS_CLASS_CONTRACT":          3,
+		"EVM_ADDRESS_CLASS_PRECOMPILE":        4,
+	}
+)
+
+func (x EVMAddressClass) Enum() *EVMAddressClass {
+	p := new(EVMAddressClass)
+	*p = x
+	return p
+}
+
+func (x EVMAddressClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EVMAddressClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0].Descriptor()
+}
+
+func (EVMAddressClass) Type() protoreflect.EnumType {
+	return &file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0]
+}
+
+func (x EVMAddressClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EVMAddressClass.Descriptor instead.
+func (EVMAddressClass) EnumDescriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the feemarket module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
+	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
+	// have.
+	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// enable_height defines at which block height the base fee calculation is enabled.
+	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
+	// base_fee for EIP-1559 blocks.
+	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// min_gas_price defines the minimum gas price value for cosmos and eth transactions
+	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	// min_gas_multiplier bounds the minimum gas used to be charged
+	// to senders based on gas limit
+	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// base_fee_history_length defines the number of most recent blocks for which
+	// the base fee and block gas are kept in the base fee history. A length of 0
+	// disables the history.
+	BaseFeeHistoryLength uint64 `protobuf:"varint,9,opt,name=base_fee_history_length,json=baseFeeHistoryLength,proto3" json:"base_fee_history_length,omitempty"`
+	// fee_lanes defines the min gas price multiplier and mempool priority of the
+	// transactions matched by a Cosmos msg type URL or an EVM `to` address class.
+	FeeLanes []*FeeLane `protobuf:"bytes,10,rep,name=fee_lanes,json=feeLanes,proto3" json:"fee_lanes,omitempty"`
+	// fee_split defines how the fees paid by EVM and Cosmos transactions are
+	// burned and distributed. If it is not set, the fees of Cosmos transactions
+	// are burned and the fees of EVM transactions are distributed by
+	// x/distribution.
+	FeeSplit *FeeSplit `protobuf:"bytes,11,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetNoBaseFee() bool {
+	if x != nil {
+		return x.NoBaseFee
+	}
+	return false
+}
+
+func (x *Params) GetBaseFeeChangeDenominator() uint32 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *Params) GetElasticityMultiplier() uint32 {
+	if x != nil {
+		return x.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (x *Params) GetEnableHeight() int64 {
+	if x != nil {
+		return x.EnableHeight
+	}
+	return 0
+}
+
+func (x *Params) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMinGasPrice() string {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMinGasMultiplier() string {
+	if x != nil {
+		return x.MinGasMultiplier
+	}
+	return ""
+}
+
+func (x *Params) GetBaseFeeHistoryLength() uint64 {
+	if x != nil {
+		return x.BaseFeeHistoryLength
+	}
+	return 0
+}
+
+func (x *Params) GetFeeLanes() []*FeeLane {
+	if x != nil {
+		return x.FeeLanes
+	}
+	return nil
+}
+
+func (x *Params) GetFeeSplit() *FeeSplit {
+	if x != nil {
+		return x.FeeSplit
+	}
+	return nil
+}
+
+// FeeLane defines the min gas price multiplier and mempool priority of the
+// transactions matched by the lane.
+type FeeLane struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the fee lane
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls are the Cosmos msg type URLs matched by the lane. A Cosmos
+	// transaction is matched when all its messages are listed.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// evm_address_classes are the classes of the `to` address of the Ethereum
+	// transactions matched by the lane.
+	EvmAddressClasses []EVMAddressClass `protobuf:"varint,3,rep,packed,name=evm_address_classes,json=evmAddressClasses,proto3,enum=ethermint.feemarket.v1.EVMAddressClass" json:"evm_address_classes,omitempty"`
+	// min_gas_price_multiplier multiplies the min gas price and the base fee
+	// required from the transactions of the lane. Ethereum transactions still
+	// pay at least the block base fee.
+	MinGasPriceMultiplier string `protobuf:"bytes,4,opt,name=min_gas_price_multiplier,json=minGasPriceMultiplier,proto3" json:"min_gas_price_multiplier,omitempty"`
+	// priority is added to the fee based mempool priority of the transactions
+	// of the lane
+	Priority int64 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *FeeLane) Reset() {
+	*x = FeeLane{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeLane) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeLane) ProtoMessage() {}
+
+// Deprecated: Use FeeLane.ProtoReflect.Descriptor instead.
+func (*FeeLane) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeLane) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeeLane) GetMsgTypeUrls() []string {
+	if x != nil {
+		return x.MsgTypeUrls
+	}
+	return nil
+}
+
+func (x *FeeLane) GetEvmAddressClasses() []EVMAddressClass {
+	if x != nil {
+		return x.EvmAddressClasses
+	}
+	return nil
+}
+
+func (x *FeeLane) GetMinGasPriceMultiplier() string {
+	if x != nil {
+		return x.MinGasPriceMultiplier
+	}
+	return ""
+}
+
+func (x *FeeLane) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// FeeSplit defines the share of the base fee part of the transaction fees that
+// is burned and how the tip part, paid on top of the base fee, is split between
+// the block proposer, the community pool and a module account. The tip part not
+// assigned by any share stays in the fee collector and is distributed by
+// x/distribution.
+type FeeSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base_fee_burn_share is the share of the base fee part that is burned. The
+	// rest stays in the fee collector.
+	BaseFeeBurnShare string `protobuf:"bytes,1,opt,name=base_fee_burn_share,json=baseFeeBurnShare,proto3" json:"base_fee_burn_share,omitempty"`
+	// tip_proposer_share is the share of the tip part sent to the operator of
+	// the block proposer
+	TipProposerShare string `protobuf:"bytes,2,opt,name=tip_proposer_share,json=tipProposerShare,proto3" json:"tip_proposer_share,omitempty"`
+	// tip_community_pool_share is the share of the tip part sent to the
+	// community pool
+	TipCommunityPoolShare string `protobuf:"bytes,3,opt,name=tip_community_pool_share,json=tipCommunityPoolShare,proto3" json:"tip_community_pool_share,omitempty"`
+	// tip_module_share is the share of the tip part sent to the tip_module
+	// module account
+	TipModuleShare string `protobuf:"bytes,4,opt,name=tip_module_share,json=tipModuleShare,proto3" json:"tip_module_share,omitempty"`
+	// tip_module is the name of the module account receiving the
+	// tip_module_share of the tip part
+	TipModule string `protobuf:"bytes,5,opt,name=tip_module,json=tipModule,proto3" json:"tip_module,omitempty"`
+}
+
+func (x *FeeSplit) Reset() {
+	*x = FeeSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSplit) ProtoMessage() {}
+
+// Deprecated: Use FeeSplit.ProtoReflect.Descriptor instead.
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeSplit) GetBaseFeeBurnShare() string {
+	if x != nil {
+		return x.BaseFeeBurnShare
+	}
+	return ""
+}
+
+func (x *FeeSplit) GetTipProposerShare() string {
+	if x != nil {
+		return x.TipProposerShare
+	}
+	return ""
+}
+
+func (x *FeeSplit) GetTipCommunityPoolShare() string {
+	if x != nil {
+		return x.TipCommunityPoolShare
+	}
+	return ""
+}
+
+func (x *FeeSplit) GetTipModuleShare() string {
+	if x != nil {
+		return x.TipModuleShare
+	}
+	return ""
+}
+
+func (x *FeeSplit) GetTipModule() string {
+	if x != nil {
+		return x.TipModule
+	}
+	return ""
+}
+
+var File_ethermint_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
+
+var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x05, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74,
+	0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x14, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x56, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x17, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x47, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x4c,
+	0x61, 0x6e, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x4c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65, 0x76,
+	0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x99,
+	0x02, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x4c, 0x61, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72,
+	0x6c, 0x73, 0x12, 0x57, 0x0a, 0x13, 0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x11, 0x65, 0x76, 0x6d, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x18, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x03, 0x0a, 0x08, 0x46,
+	0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x56, 0x0a, 0x12, 0x74, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x74, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x61, 0x0a, 0x18, 0x74, 0x69, 0x70, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x74, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x74,
+	0x69, 0x70, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x74, 0x69, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x70, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2a, 0xc4,
+	0x01, 0x0a, 0x0f, 0x45, 0x56, 0x4d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
+	0x53, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x4d, 0x5f, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x41, 0x43, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x45, 0x56, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a,
+	0x1a, 0x45, 0x56, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x03, 0x12, 0x20, 0x0a,
+	0x1c, 0x45, 0x56, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46,
+	0x58, 0xaa, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c,
+	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ethermint_feemarket_v1_feemarket_proto_rawDescOnce sync.Once
+	file_ethermint_feemarket_v1_feemarket_proto_rawDescData = file_ethermint_feemarket_v1_feemarket_proto_rawDesc
+)
+
+func file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
+	file_ethermint_feemarket_v1_feemarket_proto_rawDescOnce.Do(func() {
+		file_ethermint_feemarket_v1_feemarket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ethermint_feemarket_v1_feemarket_proto_rawDescData)
+	})
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescData
+}
+
+var file_ethermint_feemarket_v1_feemarket_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ethermint_feemarket_v1_feemarket_proto_goTypes = []interface{}{
+	(EVMAddressClass)(0), // 0: ethermint.feemarket.v1.EVMAddressClass
+	(*Params)(nil),       // 1: ethermint.feemarket.v1.Params
+	(*FeeLane)(nil),      // 2: ethermint.feemarket.v1.FeeLane
+	(*FeeSplit)(nil),     // 3: ethermint.feemarket.v1.FeeSplit
+}
+var file_ethermint_feemarket_v1_feemarket_proto_depIdxs = []int32{
+	2, // 0: ethermint.feemarket.v1.Params.fee_lanes:type_name -> ethermint.feemarket.v1.FeeLane
+	3, // 1: ethermint.feemarket.v1.Params.fee_split:type_name -> ethermint.feemarket.v1.FeeSplit
+	0, // 2: ethermint.feemarket.v1.FeeLane.evm_address_classes:type_name -> ethermint.feemarket.v1.EVMAddressClass
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ethermint_feemarket_v1_feemarket_proto_init() }
+func file_ethermint_feemarket_v1_feemarket_proto_init() {
 	if File_ethermint_feemarket_v1_feemarket_proto != nil {
 		return
 	}
@@ -969,19 +3070,44 @@ func file_ethermint_feemarket_v1_feemarket_proto_init() {
 				return nil
 			}
 		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeLane); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ethermint_feemarket_v1_feemarket_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ethermint_feemarket_v1_feemarket_proto_goTypes,
 		DependencyIndexes: file_ethermint_feemarket_v1_feemarket_proto_depIdxs,
+		EnumInfos:         file_ethermint_feemarket_v1_feemarket_proto_enumTypes,
 		MessageInfos:      file_ethermint_feemarket_v1_feemarket_proto_msgTypes,
 	}.Build()
 	File_ethermint_feemarket_v1_feemarket_proto = out.File
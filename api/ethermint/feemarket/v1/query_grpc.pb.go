@@ -22,9 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/ethermint.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/ethermint.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName         = "/ethermint.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName        = "/ethermint.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName       = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_BaseFeeHistory_FullMethodName = "/ethermint.feemarket.v1.Query/BaseFeeHistory"
+	Query_BurnedFees_FullMethodName     = "/ethermint.feemarket.v1.Query/BurnedFees"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +39,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and block gas of the most recent
+	// blocks kept in the base fee history, within an optional height range.
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	// BurnedFees queries the cumulative amount of transaction fees burned by the
+	// fee split.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -74,6 +82,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFeeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BurnedFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -84,6 +110,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and block gas of the most recent
+	// blocks kept in the base fee history, within an optional height range.
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	// BurnedFees queries the cumulative amount of transaction fees burned by the
+	// fee split.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -100,6 +132,12 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (UnimplementedQueryServer) BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +205,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -186,6 +260,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -0,0 +1,2253 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package forwardv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+
+type _GenesisState_1_list struct {
+	list *[]*InFlightPacket
+}
+
+func (x *_GenesisState_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
+	v := new(InFlightPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
+	v := new(InFlightPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                   protoreflect.MessageDescriptor
+	fd_GenesisState_in_flight_packets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_forward_v1_genesis_proto_init()
+	md_GenesisState = File_evmos_forward_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_in_flight_packets = md_GenesisState.Fields().ByName("in_flight_packets")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_forward_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.InFlightPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.InFlightPackets})
+		if !f(fd_GenesisState_in_flight_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		return len(x.InFlightPackets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		x.InFlightPackets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		if len(x.InFlightPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+		}
+		listValue := &_GenesisState_1_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_1_list)
+		x.InFlightPackets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		if x.InFlightPackets == nil {
+			x.InFlightPackets = []*InFlightPacket{}
+		}
+		value := &_GenesisState_1_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.forward.v1.GenesisState.in_flight_packets":
+		list := []*InFlightPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.forward.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.InFlightPackets) > 0 {
+			for _, e := range x.InFlightPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InFlightPackets) > 0 {
+			for iNdEx := len(x.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InFlightPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InFlightPackets = append(x.InFlightPackets, &InFlightPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InFlightPackets[len(x.InFlightPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InFlightPacket                         protoreflect.MessageDescriptor
+	fd_InFlightPacket_src_port_id             protoreflect.FieldDescriptor
+	fd_InFlightPacket_src_channel_id          protoreflect.FieldDescriptor
+	fd_InFlightPacket_dst_port_id             protoreflect.FieldDescriptor
+	fd_InFlightPacket_dst_channel_id          protoreflect.FieldDescriptor
+	fd_InFlightPacket_sequence                protoreflect.FieldDescriptor
+	fd_InFlightPacket_data                    protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_revision_number protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_revision_height protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout_timestamp       protoreflect.FieldDescriptor
+	fd_InFlightPacket_forward_port_id         protoreflect.FieldDescriptor
+	fd_InFlightPacket_forward_channel_id      protoreflect.FieldDescriptor
+	fd_InFlightPacket_forward_sequence        protoreflect.FieldDescriptor
+	fd_InFlightPacket_receiver                protoreflect.FieldDescriptor
+	fd_InFlightPacket_token                   protoreflect.FieldDescriptor
+	fd_InFlightPacket_memo                    protoreflect.FieldDescriptor
+	fd_InFlightPacket_timeout                 protoreflect.FieldDescriptor
+	fd_InFlightPacket_retries_remaining       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_forward_v1_genesis_proto_init()
+	md_InFlightPacket = File_evmos_forward_v1_genesis_proto.Messages().ByName("InFlightPacket")
+	fd_InFlightPacket_src_port_id = md_InFlightPacket.Fields().ByName("src_port_id")
+	fd_InFlightPacket_src_channel_id = md_InFlightPacket.Fields().ByName("src_channel_id")
+	fd_InFlightPacket_dst_port_id = md_InFlightPacket.Fields().ByName("dst_port_id")
+	fd_InFlightPacket_dst_channel_id = md_InFlightPacket.Fields().ByName("dst_channel_id")
+	fd_InFlightPacket_sequence = md_InFlightPacket.Fields().ByName("sequence")
+	fd_InFlightPacket_data = md_InFlightPacket.Fields().ByName("data")
+	fd_InFlightPacket_timeout_revision_number = md_InFlightPacket.Fields().ByName("timeout_revision_number")
+	fd_InFlightPacket_timeout_revision_height = md_InFlightPacket.Fields().ByName("timeout_revision_height")
+	fd_InFlightPacket_timeout_timestamp = md_InFlightPacket.Fields().ByName("timeout_timestamp")
+	fd_InFlightPacket_forward_port_id = md_InFlightPacket.Fields().ByName("forward_port_id")
+	fd_InFlightPacket_forward_channel_id = md_InFlightPacket.Fields().ByName("forward_channel_id")
+	fd_InFlightPacket_forward_sequence = md_InFlightPacket.Fields().ByName("forward_sequence")
+	fd_InFlightPacket_receiver = md_InFlightPacket.Fields().ByName("receiver")
+	fd_InFlightPacket_token = md_InFlightPacket.Fields().ByName("token")
+	fd_InFlightPacket_memo = md_InFlightPacket.Fields().ByName("memo")
+	fd_InFlightPacket_timeout = md_InFlightPacket.Fields().ByName("timeout")
+	fd_InFlightPacket_retries_remaining = md_InFlightPacket.Fields().ByName("retries_remaining")
+}
+
+var _ protoreflect.Message = (*fastReflection_InFlightPacket)(nil)
+
+type fastReflection_InFlightPacket InFlightPacket
+
+func (x *InFlightPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InFlightPacket)(x)
+}
+
+func (x *InFlightPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_forward_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InFlightPacket_messageType fastReflection_InFlightPacket_messageType
+var _ protoreflect.MessageType = fastReflection_InFlightPacket_messageType{}
+
+type fastReflection_InFlightPacket_messageType struct{}
+
+func (x fastReflection_InFlightPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InFlightPacket)(nil)
+}
+func (x fastReflection_InFlightPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_InFlightPacket)
+}
+func (x fastReflection_InFlightPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InFlightPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InFlightPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_InFlightPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InFlightPacket) Type() protoreflect.MessageType {
+	return _fastReflection_InFlightPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InFlightPacket) New() protoreflect.Message {
+	return new(fastReflection_InFlightPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InFlightPacket) Interface() protoreflect.ProtoMessage {
+	return (*InFlightPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InFlightPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SrcPortId != "" {
+		value := protoreflect.ValueOfString(x.SrcPortId)
+		if !f(fd_InFlightPacket_src_port_id, value) {
+			return
+		}
+	}
+	if x.SrcChannelId != "" {
+		value := protoreflect.ValueOfString(x.SrcChannelId)
+		if !f(fd_InFlightPacket_src_channel_id, value) {
+			return
+		}
+	}
+	if x.DstPortId != "" {
+		value := protoreflect.ValueOfString(x.DstPortId)
+		if !f(fd_InFlightPacket_dst_port_id, value) {
+			return
+		}
+	}
+	if x.DstChannelId != "" {
+		value := protoreflect.ValueOfString(x.DstChannelId)
+		if !f(fd_InFlightPacket_dst_channel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_InFlightPacket_sequence, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_InFlightPacket_data, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionNumber)
+		if !f(fd_InFlightPacket_timeout_revision_number, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionHeight)
+		if !f(fd_InFlightPacket_timeout_revision_height, value) {
+			return
+		}
+	}
+	if x.TimeoutTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutTimestamp)
+		if !f(fd_InFlightPacket_timeout_timestamp, value) {
+			return
+		}
+	}
+	if x.ForwardPortId != "" {
+		value := protoreflect.ValueOfString(x.ForwardPortId)
+		if !f(fd_InFlightPacket_forward_port_id, value) {
+			return
+		}
+	}
+	if x.ForwardChannelId != "" {
+		value := protoreflect.ValueOfString(x.ForwardChannelId)
+		if !f(fd_InFlightPacket_forward_channel_id, value) {
+			return
+		}
+	}
+	if x.ForwardSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ForwardSequence)
+		if !f(fd_InFlightPacket_forward_sequence, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_InFlightPacket_receiver, value) {
+			return
+		}
+	}
+	if x.Token != nil {
+		value := protoreflect.ValueOfMessage(x.Token.ProtoReflect())
+		if !f(fd_InFlightPacket_token, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_InFlightPacket_memo, value) {
+			return
+		}
+	}
+	if x.Timeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Timeout)
+		if !f(fd_InFlightPacket_timeout, value) {
+			return
+		}
+	}
+	if x.RetriesRemaining != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetriesRemaining)
+		if !f(fd_InFlightPacket_retries_remaining, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InFlightPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		return x.SrcPortId != ""
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		return x.SrcChannelId != ""
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		return x.DstPortId != ""
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		return x.DstChannelId != ""
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.data":
+		return len(x.Data) != 0
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		return x.TimeoutRevisionNumber != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		return x.TimeoutRevisionHeight != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		return x.TimeoutTimestamp != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		return x.ForwardPortId != ""
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		return x.ForwardChannelId != ""
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		return x.ForwardSequence != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		return x.Receiver != ""
+	case "evmos.forward.v1.InFlightPacket.token":
+		return x.Token != nil
+	case "evmos.forward.v1.InFlightPacket.memo":
+		return x.Memo != ""
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		return x.Timeout != uint64(0)
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		return x.RetriesRemaining != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		x.SrcPortId = ""
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		x.SrcChannelId = ""
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		x.DstPortId = ""
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		x.DstChannelId = ""
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		x.Sequence = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.data":
+		x.Data = nil
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		x.TimeoutTimestamp = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		x.ForwardPortId = ""
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		x.ForwardChannelId = ""
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		x.ForwardSequence = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		x.Receiver = ""
+	case "evmos.forward.v1.InFlightPacket.token":
+		x.Token = nil
+	case "evmos.forward.v1.InFlightPacket.memo":
+		x.Memo = ""
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		x.Timeout = uint64(0)
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		x.RetriesRemaining = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InFlightPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		value := x.SrcPortId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		value := x.SrcChannelId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		value := x.DstPortId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		value := x.DstChannelId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		value := x.TimeoutRevisionNumber
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		value := x.TimeoutRevisionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		value := x.TimeoutTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		value := x.ForwardPortId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		value := x.ForwardChannelId
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		value := x.ForwardSequence
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.token":
+		value := x.Token
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "evmos.forward.v1.InFlightPacket.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		value := x.Timeout
+		return protoreflect.ValueOfUint64(value)
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		value := x.RetriesRemaining
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		x.SrcPortId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		x.SrcChannelId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		x.DstPortId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		x.DstChannelId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		x.Sequence = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.data":
+		x.Data = value.Bytes()
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		x.TimeoutTimestamp = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		x.ForwardPortId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		x.ForwardChannelId = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		x.ForwardSequence = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		x.Receiver = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.token":
+		x.Token = value.Message().Interface().(*v1beta1.Coin)
+	case "evmos.forward.v1.InFlightPacket.memo":
+		x.Memo = value.Interface().(string)
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		x.Timeout = value.Uint()
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		x.RetriesRemaining = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.forward.v1.InFlightPacket.token":
+		if x.Token == nil {
+			x.Token = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Token.ProtoReflect())
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		panic(fmt.Errorf("field src_port_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		panic(fmt.Errorf("field src_channel_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		panic(fmt.Errorf("field dst_port_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		panic(fmt.Errorf("field dst_channel_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		panic(fmt.Errorf("field sequence of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.data":
+		panic(fmt.Errorf("field data of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		panic(fmt.Errorf("field timeout_revision_number of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		panic(fmt.Errorf("field timeout_revision_height of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		panic(fmt.Errorf("field timeout_timestamp of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		panic(fmt.Errorf("field forward_port_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		panic(fmt.Errorf("field forward_channel_id of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		panic(fmt.Errorf("field forward_sequence of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		panic(fmt.Errorf("field receiver of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.memo":
+		panic(fmt.Errorf("field memo of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		panic(fmt.Errorf("field timeout of message evmos.forward.v1.InFlightPacket is not mutable"))
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		panic(fmt.Errorf("field retries_remaining of message evmos.forward.v1.InFlightPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InFlightPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.forward.v1.InFlightPacket.src_port_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.src_channel_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.dst_port_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.dst_channel_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.timeout_revision_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.timeout_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.forward_port_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.forward_channel_id":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.forward_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.receiver":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.token":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "evmos.forward.v1.InFlightPacket.memo":
+		return protoreflect.ValueOfString("")
+	case "evmos.forward.v1.InFlightPacket.timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.forward.v1.InFlightPacket.retries_remaining":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.forward.v1.InFlightPacket"))
+		}
+		panic(fmt.Errorf("message evmos.forward.v1.InFlightPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InFlightPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.forward.v1.InFlightPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InFlightPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InFlightPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InFlightPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InFlightPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SrcPortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SrcChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DstPortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DstChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionNumber))
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionHeight))
+		}
+		if x.TimeoutTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutTimestamp))
+		}
+		l = len(x.ForwardPortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ForwardChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForwardSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForwardSequence))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Token != nil {
+			l = options.Size(x.Token)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timeout != 0 {
+			n += 2 + runtime.Sov(uint64(x.Timeout))
+		}
+		if x.RetriesRemaining != 0 {
+			n += 2 + runtime.Sov(uint64(x.RetriesRemaining))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetriesRemaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetriesRemaining))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.Timeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timeout))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Token != nil {
+			encoded, err := options.Marshal(x.Token)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.ForwardSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForwardSequence))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.ForwardChannelId) > 0 {
+			i -= len(x.ForwardChannelId)
+			copy(dAtA[i:], x.ForwardChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ForwardChannelId)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ForwardPortId) > 0 {
+			i -= len(x.ForwardPortId)
+			copy(dAtA[i:], x.ForwardPortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ForwardPortId)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.TimeoutTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutTimestamp))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionNumber))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.DstChannelId) > 0 {
+			i -= len(x.DstChannelId)
+			copy(dAtA[i:], x.DstChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DstChannelId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DstPortId) > 0 {
+			i -= len(x.DstPortId)
+			copy(dAtA[i:], x.DstPortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DstPortId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SrcChannelId) > 0 {
+			i -= len(x.SrcChannelId)
+			copy(dAtA[i:], x.SrcChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SrcPortId) > 0 {
+			i -= len(x.SrcPortId)
+			copy(dAtA[i:], x.SrcPortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcPortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InFlightPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SrcPortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SrcChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstPortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DstPortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DstChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+				}
+				x.TimeoutRevisionNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+				}
+				x.TimeoutRevisionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				}
+				x.TimeoutTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardPortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+				}
+				x.ForwardSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForwardSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Token == nil {
+					x.Token = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Token); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				}
+				x.Timeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+				}
+				x.RetriesRemaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetriesRemaining |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: evmos/forward/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in_flight_packets is a slice of the forwarded packets awaiting an
+	// acknowledgement or a timeout at genesis
+	InFlightPackets []*InFlightPacket `protobuf:"bytes,1,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_forward_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_evmos_forward_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetInFlightPackets() []*InFlightPacket {
+	if x != nil {
+		return x.InFlightPackets
+	}
+	return nil
+}
+
+// InFlightPacket defines a packet forwarded to the next hop. The packet
+// received from the previous hop is acknowledged once the forwarded packet is
+// acknowledged, or refunded if it fails after all the retries.
+type InFlightPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// src_port_id is the source port of the received packet
+	SrcPortId string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
+	// src_channel_id is the source channel of the received packet
+	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
+	// dst_port_id is the destination port of the received packet on this chain
+	DstPortId string `protobuf:"bytes,3,opt,name=dst_port_id,json=dstPortId,proto3" json:"dst_port_id,omitempty"`
+	// dst_channel_id is the destination channel of the received packet on this chain
+	DstChannelId string `protobuf:"bytes,4,opt,name=dst_channel_id,json=dstChannelId,proto3" json:"dst_channel_id,omitempty"`
+	// sequence is the sequence of the received packet
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the ICS20 packet data of the received packet
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// timeout_revision_number is the revision number of the received packet timeout height
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	// timeout_revision_height is the revision height of the received packet timeout height
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the received packet
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// forward_port_id is the port the packet is forwarded on
+	ForwardPortId string `protobuf:"bytes,10,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty"`
+	// forward_channel_id is the channel the packet is forwarded on
+	ForwardChannelId string `protobuf:"bytes,11,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty"`
+	// forward_sequence is the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,12,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// receiver is the receiver on the next hop
+	Receiver string `protobuf:"bytes,13,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// token is the token forwarded to the next hop
+	Token *v1beta1.Coin `protobuf:"bytes,14,opt,name=token,proto3" json:"token,omitempty"`
+	// memo is the memo of the forwarded packet
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout is the relative timeout in nanoseconds of the forwarded packet
+	Timeout uint64 `protobuf:"varint,16,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retries_remaining is the number of times the packet is sent again on timeout
+	RetriesRemaining uint32 `protobuf:"varint,17,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+}
+
+func (x *InFlightPacket) Reset() {
+	*x = InFlightPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_forward_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InFlightPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightPacket) ProtoMessage() {}
+
+// Deprecated: Use InFlightPacket.ProtoReflect.Descriptor instead.
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return file_evmos_forward_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InFlightPacket) GetSrcPortId() string {
+	if x != nil {
+		return x.SrcPortId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetSrcChannelId() string {
+	if x != nil {
+		return x.SrcChannelId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetDstPortId() string {
+	if x != nil {
+		return x.DstPortId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetDstChannelId() string {
+	if x != nil {
+		return x.DstChannelId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InFlightPacket) GetTimeoutRevisionNumber() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetTimeoutRevisionHeight() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetForwardPortId() string {
+	if x != nil {
+		return x.ForwardPortId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetForwardChannelId() string {
+	if x != nil {
+		return x.ForwardChannelId
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetForwardSequence() uint64 {
+	if x != nil {
+		return x.ForwardSequence
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetToken() *v1beta1.Coin {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *InFlightPacket) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *InFlightPacket) GetTimeout() uint64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *InFlightPacket) GetRetriesRemaining() uint32 {
+	if x != nil {
+		return x.RetriesRemaining
+	}
+	return 0
+}
+
+var File_evmos_forward_v1_genesis_proto protoreflect.FileDescriptor
+
+var file_evmos_forward_v1_genesis_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x10, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x11, 0x69,
+	0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x9d, 0x05, 0x0a, 0x0e, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72,
+	0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0b, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x64, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0xb3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d,
+	0x6f, 0x73, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x76, 0x31,
+	0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58,
+	0xaa, 0x02, 0x10, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_evmos_forward_v1_genesis_proto_rawDescOnce sync.Once
+	file_evmos_forward_v1_genesis_proto_rawDescData = file_evmos_forward_v1_genesis_proto_rawDesc
+)
+
+func file_evmos_forward_v1_genesis_proto_rawDescGZIP() []byte {
+	file_evmos_forward_v1_genesis_proto_rawDescOnce.Do(func() {
+		file_evmos_forward_v1_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_evmos_forward_v1_genesis_proto_rawDescData)
+	})
+	return file_evmos_forward_v1_genesis_proto_rawDescData
+}
+
+var file_evmos_forward_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evmos_forward_v1_genesis_proto_goTypes = []interface{}{
+	(*GenesisState)(nil),   // 0: evmos.forward.v1.GenesisState
+	(*InFlightPacket)(nil), // 1: evmos.forward.v1.InFlightPacket
+	(*v1beta1.Coin)(nil),   // 2: cosmos.base.v1beta1.Coin
+}
+var file_evmos_forward_v1_genesis_proto_depIdxs = []int32{
+	1, // 0: evmos.forward.v1.GenesisState.in_flight_packets:type_name -> evmos.forward.v1.InFlightPacket
+	2, // 1: evmos.forward.v1.InFlightPacket.token:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_evmos_forward_v1_genesis_proto_init() }
+func file_evmos_forward_v1_genesis_proto_init() {
+	if File_evmos_forward_v1_genesis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_evmos_forward_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmos_forward_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InFlightPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evmos_forward_v1_genesis_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evmos_forward_v1_genesis_proto_goTypes,
+		DependencyIndexes: file_evmos_forward_v1_genesis_proto_depIdxs,
+		MessageInfos:      file_evmos_forward_v1_genesis_proto_msgTypes,
+	}.Build()
+	File_evmos_forward_v1_genesis_proto = out.File
+	file_evmos_forward_v1_genesis_proto_rawDesc = nil
+	file_evmos_forward_v1_genesis_proto_goTypes = nil
+	file_evmos_forward_v1_genesis_proto_depIdxs = nil
+}
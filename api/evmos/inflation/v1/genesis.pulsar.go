@@ -21,6 +21,7 @@ var (
 	fd_GenesisState_epoch_identifier  protoreflect.FieldDescriptor
 	fd_GenesisState_epochs_per_period protoreflect.FieldDescriptor
 	fd_GenesisState_skipped_epochs    protoreflect.FieldDescriptor
+	fd_GenesisState_annual_inflation  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +32,7 @@ func init() {
 	fd_GenesisState_epoch_identifier = md_GenesisState.Fields().ByName("epoch_identifier")
 	fd_GenesisState_epochs_per_period = md_GenesisState.Fields().ByName("epochs_per_period")
 	fd_GenesisState_skipped_epochs = md_GenesisState.Fields().ByName("skipped_epochs")
+	fd_GenesisState_annual_inflation = md_GenesisState.Fields().ByName("annual_inflation")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -128,6 +130,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.AnnualInflation != "" {
+		value := protoreflect.ValueOfString(x.AnnualInflation)
+		if !f(fd_GenesisState_annual_inflation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -153,6 +161,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.EpochsPerPeriod != int64(0)
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		return x.SkippedEpochs != uint64(0)
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		return x.AnnualInflation != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -179,6 +189,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.EpochsPerPeriod = int64(0)
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = uint64(0)
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		x.AnnualInflation = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -210,6 +222,9 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		value := x.SkippedEpochs
 		return protoreflect.ValueOfUint64(value)
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		value := x.AnnualInflation
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -240,6 +255,8 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.EpochsPerPeriod = value.Int()
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = value.Uint()
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		x.AnnualInflation = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -273,6 +290,8 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field epochs_per_period of message evmos.inflation.v1.GenesisState is not mutable"))
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		panic(fmt.Errorf("field skipped_epochs of message evmos.inflation.v1.GenesisState is not mutable"))
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		panic(fmt.Errorf("field annual_inflation of message evmos.inflation.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -297,6 +316,8 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfInt64(int64(0))
 	case "evmos.inflation.v1.GenesisState.skipped_epochs":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "evmos.inflation.v1.GenesisState.annual_inflation":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.GenesisState"))
@@ -383,6 +404,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.SkippedEpochs != 0 {
 			n += 1 + runtime.Sov(uint64(x.SkippedEpochs))
 		}
+		l = len(x.AnnualInflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -412,6 +437,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AnnualInflation) > 0 {
+			i -= len(x.AnnualInflation)
+			copy(dAtA[i:], x.AnnualInflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualInflation)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.SkippedEpochs != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkippedEpochs))
 			i--
@@ -622,6 +654,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualInflation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualInflation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -658,11 +722,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                         protoreflect.MessageDescriptor
-	fd_Params_mint_denom              protoreflect.FieldDescriptor
-	fd_Params_exponential_calculation protoreflect.FieldDescriptor
-	fd_Params_inflation_distribution  protoreflect.FieldDescriptor
-	fd_Params_enable_inflation        protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_mint_denom                protoreflect.FieldDescriptor
+	fd_Params_exponential_calculation   protoreflect.FieldDescriptor
+	fd_Params_inflation_distribution    protoreflect.FieldDescriptor
+	fd_Params_enable_inflation          protoreflect.FieldDescriptor
+	fd_Params_inflation_curve           protoreflect.FieldDescriptor
+	fd_Params_bonded_ratio_calculation  protoreflect.FieldDescriptor
+	fd_Params_capped_supply_calculation protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -672,6 +739,9 @@ func init() {
 	fd_Params_exponential_calculation = md_Params.Fields().ByName("exponential_calculation")
 	fd_Params_inflation_distribution = md_Params.Fields().ByName("inflation_distribution")
 	fd_Params_enable_inflation = md_Params.Fields().ByName("enable_inflation")
+	fd_Params_inflation_curve = md_Params.Fields().ByName("inflation_curve")
+	fd_Params_bonded_ratio_calculation = md_Params.Fields().ByName("bonded_ratio_calculation")
+	fd_Params_capped_supply_calculation = md_Params.Fields().ByName("capped_supply_calculation")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -763,6 +833,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.InflationCurve != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.InflationCurve))
+		if !f(fd_Params_inflation_curve, value) {
+			return
+		}
+	}
+	if x.BondedRatioCalculation != nil {
+		value := protoreflect.ValueOfMessage(x.BondedRatioCalculation.ProtoReflect())
+		if !f(fd_Params_bonded_ratio_calculation, value) {
+			return
+		}
+	}
+	if x.CappedSupplyCalculation != nil {
+		value := protoreflect.ValueOfMessage(x.CappedSupplyCalculation.ProtoReflect())
+		if !f(fd_Params_capped_supply_calculation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -786,6 +874,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InflationDistribution != nil
 	case "evmos.inflation.v1.Params.enable_inflation":
 		return x.EnableInflation != false
+	case "evmos.inflation.v1.Params.inflation_curve":
+		return x.InflationCurve != 0
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		return x.BondedRatioCalculation != nil
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		return x.CappedSupplyCalculation != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -810,6 +904,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InflationDistribution = nil
 	case "evmos.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = false
+	case "evmos.inflation.v1.Params.inflation_curve":
+		x.InflationCurve = 0
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		x.BondedRatioCalculation = nil
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		x.CappedSupplyCalculation = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -838,6 +938,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "evmos.inflation.v1.Params.enable_inflation":
 		value := x.EnableInflation
 		return protoreflect.ValueOfBool(value)
+	case "evmos.inflation.v1.Params.inflation_curve":
+		value := x.InflationCurve
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		value := x.BondedRatioCalculation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		value := x.CappedSupplyCalculation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -866,6 +975,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InflationDistribution = value.Message().Interface().(*InflationDistribution)
 	case "evmos.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = value.Bool()
+	case "evmos.inflation.v1.Params.inflation_curve":
+		x.InflationCurve = (InflationCurve)(value.Enum())
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		x.BondedRatioCalculation = value.Message().Interface().(*BondedRatioCalculation)
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		x.CappedSupplyCalculation = value.Message().Interface().(*CappedSupplyCalculation)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -896,10 +1011,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.InflationDistribution = new(InflationDistribution)
 		}
 		return protoreflect.ValueOfMessage(x.InflationDistribution.ProtoReflect())
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		if x.BondedRatioCalculation == nil {
+			x.BondedRatioCalculation = new(BondedRatioCalculation)
+		}
+		return protoreflect.ValueOfMessage(x.BondedRatioCalculation.ProtoReflect())
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		if x.CappedSupplyCalculation == nil {
+			x.CappedSupplyCalculation = new(CappedSupplyCalculation)
+		}
+		return protoreflect.ValueOfMessage(x.CappedSupplyCalculation.ProtoReflect())
 	case "evmos.inflation.v1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message evmos.inflation.v1.Params is not mutable"))
 	case "evmos.inflation.v1.Params.enable_inflation":
 		panic(fmt.Errorf("field enable_inflation of message evmos.inflation.v1.Params is not mutable"))
+	case "evmos.inflation.v1.Params.inflation_curve":
+		panic(fmt.Errorf("field inflation_curve of message evmos.inflation.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -923,6 +1050,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "evmos.inflation.v1.Params.enable_inflation":
 		return protoreflect.ValueOfBool(false)
+	case "evmos.inflation.v1.Params.inflation_curve":
+		return protoreflect.ValueOfEnum(0)
+	case "evmos.inflation.v1.Params.bonded_ratio_calculation":
+		m := new(BondedRatioCalculation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "evmos.inflation.v1.Params.capped_supply_calculation":
+		m := new(CappedSupplyCalculation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -1007,6 +1142,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableInflation {
 			n += 2
 		}
+		if x.InflationCurve != 0 {
+			n += 1 + runtime.Sov(uint64(x.InflationCurve))
+		}
+		if x.BondedRatioCalculation != nil {
+			l = options.Size(x.BondedRatioCalculation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CappedSupplyCalculation != nil {
+			l = options.Size(x.CappedSupplyCalculation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1036,6 +1182,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CappedSupplyCalculation != nil {
+			encoded, err := options.Marshal(x.CappedSupplyCalculation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.BondedRatioCalculation != nil {
+			encoded, err := options.Marshal(x.BondedRatioCalculation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.InflationCurve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InflationCurve))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.EnableInflation {
 			i--
 			if x.EnableInflation {
@@ -1254,6 +1433,97 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableInflation = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationCurve", wireType)
+				}
+				x.InflationCurve = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InflationCurve |= InflationCurve(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedRatioCalculation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BondedRatioCalculation == nil {
+					x.BondedRatioCalculation = &BondedRatioCalculation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BondedRatioCalculation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CappedSupplyCalculation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CappedSupplyCalculation == nil {
+					x.CappedSupplyCalculation = &CappedSupplyCalculation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CappedSupplyCalculation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1321,6 +1591,8 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// annual_inflation is the current annual inflation of the bonded ratio curve
+	AnnualInflation string `protobuf:"bytes,6,opt,name=annual_inflation,json=annualInflation,proto3" json:"annual_inflation,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1378,6 +1650,13 @@ func (x *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetAnnualInflation() string {
+	if x != nil {
+		return x.AnnualInflation
+	}
+	return ""
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1392,6 +1671,14 @@ type Params struct {
 	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// inflation_curve selects the calculation of the epoch mint provision
+	InflationCurve InflationCurve `protobuf:"varint,5,opt,name=inflation_curve,json=inflationCurve,proto3,enum=evmos.inflation.v1.InflationCurve" json:"inflation_curve,omitempty"`
+	// bonded_ratio_calculation takes in the variables to calculate the inflation
+	// targeting a bonded ratio
+	BondedRatioCalculation *BondedRatioCalculation `protobuf:"bytes,6,opt,name=bonded_ratio_calculation,json=bondedRatioCalculation,proto3" json:"bonded_ratio_calculation,omitempty"`
+	// capped_supply_calculation takes in the variables to calculate the inflation
+	// capped by a max supply
+	CappedSupplyCalculation *CappedSupplyCalculation `protobuf:"bytes,7,opt,name=capped_supply_calculation,json=cappedSupplyCalculation,proto3" json:"capped_supply_calculation,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1442,6 +1729,27 @@ func (x *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (x *Params) GetInflationCurve() InflationCurve {
+	if x != nil {
+		return x.InflationCurve
+	}
+	return InflationCurve_INFLATION_CURVE_UNSPECIFIED
+}
+
+func (x *Params) GetBondedRatioCalculation() *BondedRatioCalculation {
+	if x != nil {
+		return x.BondedRatioCalculation
+	}
+	return nil
+}
+
+func (x *Params) GetCappedSupplyCalculation() *CappedSupplyCalculation {
+	if x != nil {
+		return x.CappedSupplyCalculation
+	}
+	return nil
+}
+
 var File_evmos_inflation_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
@@ -1453,7 +1761,7 @@ var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x22, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -1467,39 +1775,63 @@ var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x6e, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16,
-	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12,
-	0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x61, 0x6e, 0x6e,
+	0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x61,
+	0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe1,
+	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x6e, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f,
+	0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x16, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x6f,
+	0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x0e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x6f, 0x0a,
+	0x18, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72,
+	0x0a, 0x19, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x70, 0x65, 0x64, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x63, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f,
+	0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1516,20 +1848,26 @@ func file_evmos_inflation_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_evmos_inflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_evmos_inflation_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),           // 0: evmos.inflation.v1.GenesisState
-	(*Params)(nil),                 // 1: evmos.inflation.v1.Params
-	(*ExponentialCalculation)(nil), // 2: evmos.inflation.v1.ExponentialCalculation
-	(*InflationDistribution)(nil),  // 3: evmos.inflation.v1.InflationDistribution
+	(*GenesisState)(nil),            // 0: evmos.inflation.v1.GenesisState
+	(*Params)(nil),                  // 1: evmos.inflation.v1.Params
+	(*ExponentialCalculation)(nil),  // 2: evmos.inflation.v1.ExponentialCalculation
+	(*InflationDistribution)(nil),   // 3: evmos.inflation.v1.InflationDistribution
+	(InflationCurve)(0),             // 4: evmos.inflation.v1.InflationCurve
+	(*BondedRatioCalculation)(nil),  // 5: evmos.inflation.v1.BondedRatioCalculation
+	(*CappedSupplyCalculation)(nil), // 6: evmos.inflation.v1.CappedSupplyCalculation
 }
 var file_evmos_inflation_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: evmos.inflation.v1.GenesisState.params:type_name -> evmos.inflation.v1.Params
 	2, // 1: evmos.inflation.v1.Params.exponential_calculation:type_name -> evmos.inflation.v1.ExponentialCalculation
 	3, // 2: evmos.inflation.v1.Params.inflation_distribution:type_name -> evmos.inflation.v1.InflationDistribution
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: evmos.inflation.v1.Params.inflation_curve:type_name -> evmos.inflation.v1.InflationCurve
+	5, // 4: evmos.inflation.v1.Params.bonded_ratio_calculation:type_name -> evmos.inflation.v1.BondedRatioCalculation
+	6, // 5: evmos.inflation.v1.Params.capped_supply_calculation:type_name -> evmos.inflation.v1.CappedSupplyCalculation
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_evmos_inflation_v1_genesis_proto_init() }
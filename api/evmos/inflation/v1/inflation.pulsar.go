@@ -14,11 +14,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_InflationDistribution_4_list)(nil)
+
+type _InflationDistribution_4_list struct {
+	list *[]*InflationRecipient
+}
+
+func (x *_InflationDistribution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InflationDistribution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InflationDistribution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InflationDistribution_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InflationDistribution_4_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_InflationDistribution                  protoreflect.MessageDescriptor
 	fd_InflationDistribution_staking_rewards  protoreflect.FieldDescriptor
 	fd_InflationDistribution_usage_incentives protoreflect.FieldDescriptor
 	fd_InflationDistribution_community_pool   protoreflect.FieldDescriptor
+	fd_InflationDistribution_recipients       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +79,7 @@ func init() {
 	fd_InflationDistribution_staking_rewards = md_InflationDistribution.Fields().ByName("staking_rewards")
 	fd_InflationDistribution_usage_incentives = md_InflationDistribution.Fields().ByName("usage_incentives")
 	fd_InflationDistribution_community_pool = md_InflationDistribution.Fields().ByName("community_pool")
+	fd_InflationDistribution_recipients = md_InflationDistribution.Fields().ByName("recipients")
 }
 
 var _ protoreflect.Message = (*fastReflection_InflationDistribution)(nil)
@@ -112,6 +165,12 @@ func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &x.Recipients})
+		if !f(fd_InflationDistribution_recipients, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,6 +192,8 @@ func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescript
 		return x.UsageIncentives != ""
 	case "evmos.inflation.v1.InflationDistribution.community_pool":
 		return x.CommunityPool != ""
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		return len(x.Recipients) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationDistribution"))
@@ -155,6 +216,8 @@ func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescri
 		x.UsageIncentives = ""
 	case "evmos.inflation.v1.InflationDistribution.community_pool":
 		x.CommunityPool = ""
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		x.Recipients = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationDistribution"))
@@ -180,6 +243,12 @@ func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.Field
 	case "evmos.inflation.v1.InflationDistribution.community_pool":
 		value := x.CommunityPool
 		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_InflationDistribution_4_list{})
+		}
+		listValue := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationDistribution"))
@@ -206,6 +275,10 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 		x.UsageIncentives = value.Interface().(string)
 	case "evmos.inflation.v1.InflationDistribution.community_pool":
 		x.CommunityPool = value.Interface().(string)
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		lv := value.List()
+		clv := lv.(*_InflationDistribution_4_list)
+		x.Recipients = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationDistribution"))
@@ -226,6 +299,12 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_InflationDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []*InflationRecipient{}
+		}
+		value := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
 	case "evmos.inflation.v1.InflationDistribution.staking_rewards":
 		panic(fmt.Errorf("field staking_rewards of message evmos.inflation.v1.InflationDistribution is not mutable"))
 	case "evmos.inflation.v1.InflationDistribution.usage_incentives":
@@ -251,6 +330,9 @@ func (x *fastReflection_InflationDistribution) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "evmos.inflation.v1.InflationDistribution.community_pool":
 		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.InflationDistribution.recipients":
+		list := []*InflationRecipient{}
+		return protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationDistribution"))
@@ -332,6 +414,12 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Recipients) > 0 {
+			for _, e := range x.Recipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -361,6 +449,22 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Recipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.CommunityPool) > 0 {
 			i -= len(x.CommunityPool)
 			copy(dAtA[i:], x.CommunityPool)
@@ -527,6 +631,40 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				}
 				x.CommunityPool = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, &InflationRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recipients[len(x.Recipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -563,33 +701,27 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_ExponentialCalculation                protoreflect.MessageDescriptor
-	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+	md_InflationRecipient         protoreflect.MessageDescriptor
+	fd_InflationRecipient_address protoreflect.FieldDescriptor
+	fd_InflationRecipient_share   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_evmos_inflation_v1_inflation_proto_init()
-	md_ExponentialCalculation = File_evmos_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
-	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
-	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
-	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
-	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
-	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+	md_InflationRecipient = File_evmos_inflation_v1_inflation_proto.Messages().ByName("InflationRecipient")
+	fd_InflationRecipient_address = md_InflationRecipient.Fields().ByName("address")
+	fd_InflationRecipient_share = md_InflationRecipient.Fields().ByName("share")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+var _ protoreflect.Message = (*fastReflection_InflationRecipient)(nil)
 
-type fastReflection_ExponentialCalculation ExponentialCalculation
+type fastReflection_InflationRecipient InflationRecipient
 
-func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(x)
+func (x *InflationRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(x)
 }
 
-func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+func (x *InflationRecipient) slowProtoReflect() protoreflect.Message {
 	mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,43 +733,43 @@ func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
-var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+var _fastReflection_InflationRecipient_messageType fastReflection_InflationRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_InflationRecipient_messageType{}
 
-type fastReflection_ExponentialCalculation_messageType struct{}
+type fastReflection_InflationRecipient_messageType struct{}
 
-func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(nil)
+func (x fastReflection_InflationRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(nil)
 }
-func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x fastReflection_InflationRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
 }
-func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x fastReflection_InflationRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x *fastReflection_InflationRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
-	return _fastReflection_ExponentialCalculation_messageType
+func (x *fastReflection_InflationRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_InflationRecipient_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x *fastReflection_InflationRecipient) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
-	return (*ExponentialCalculation)(x)
+func (x *fastReflection_InflationRecipient) Interface() protoreflect.ProtoMessage {
+	return (*InflationRecipient)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -645,34 +777,16 @@ func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.A != "" {
-		value := protoreflect.ValueOfString(x.A)
-		if !f(fd_ExponentialCalculation_a, value) {
-			return
-		}
-	}
-	if x.R != "" {
-		value := protoreflect.ValueOfString(x.R)
-		if !f(fd_ExponentialCalculation_r, value) {
-			return
-		}
-	}
-	if x.C != "" {
-		value := protoreflect.ValueOfString(x.C)
-		if !f(fd_ExponentialCalculation_c, value) {
-			return
-		}
-	}
-	if x.BondingTarget != "" {
-		value := protoreflect.ValueOfString(x.BondingTarget)
-		if !f(fd_ExponentialCalculation_bonding_target, value) {
+func (x *fastReflection_InflationRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_InflationRecipient_address, value) {
 			return
 		}
 	}
-	if x.MaxVariance != "" {
-		value := protoreflect.ValueOfString(x.MaxVariance)
-		if !f(fd_ExponentialCalculation_max_variance, value) {
+	if x.Share != "" {
+		value := protoreflect.ValueOfString(x.Share)
+		if !f(fd_InflationRecipient_share, value) {
 			return
 		}
 	}
@@ -689,23 +803,17 @@ func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InflationRecipient) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		return x.A != ""
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		return x.R != ""
-	case "evmos.inflation.v1.ExponentialCalculation.c":
-		return x.C != ""
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		return x.BondingTarget != ""
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
-		return x.MaxVariance != ""
+	case "evmos.inflation.v1.InflationRecipient.address":
+		return x.Address != ""
+	case "evmos.inflation.v1.InflationRecipient.share":
+		return x.Share != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -715,23 +823,17 @@ func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InflationRecipient) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		x.A = ""
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		x.R = ""
-	case "evmos.inflation.v1.ExponentialCalculation.c":
-		x.C = ""
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = ""
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = ""
+	case "evmos.inflation.v1.InflationRecipient.address":
+		x.Address = ""
+	case "evmos.inflation.v1.InflationRecipient.share":
+		x.Share = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -741,28 +843,19 @@ func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		value := x.A
-		return protoreflect.ValueOfString(value)
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		value := x.R
-		return protoreflect.ValueOfString(value)
-	case "evmos.inflation.v1.ExponentialCalculation.c":
-		value := x.C
-		return protoreflect.ValueOfString(value)
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		value := x.BondingTarget
+	case "evmos.inflation.v1.InflationRecipient.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
-		value := x.MaxVariance
+	case "evmos.inflation.v1.InflationRecipient.share":
+		value := x.Share
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -776,23 +869,17 @@ func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InflationRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		x.A = value.Interface().(string)
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		x.R = value.Interface().(string)
-	case "evmos.inflation.v1.ExponentialCalculation.c":
-		x.C = value.Interface().(string)
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = value.Interface().(string)
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = value.Interface().(string)
+	case "evmos.inflation.v1.InflationRecipient.address":
+		x.Address = value.Interface().(string)
+	case "evmos.inflation.v1.InflationRecipient.share":
+		x.Share = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -806,56 +893,44 @@ func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		panic(fmt.Errorf("field a of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		panic(fmt.Errorf("field r of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
-	case "evmos.inflation.v1.ExponentialCalculation.c":
-		panic(fmt.Errorf("field c of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		panic(fmt.Errorf("field bonding_target of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
-		panic(fmt.Errorf("field max_variance of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	case "evmos.inflation.v1.InflationRecipient.address":
+		panic(fmt.Errorf("field address of message evmos.inflation.v1.InflationRecipient is not mutable"))
+	case "evmos.inflation.v1.InflationRecipient.share":
+		panic(fmt.Errorf("field share of message evmos.inflation.v1.InflationRecipient is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "evmos.inflation.v1.ExponentialCalculation.a":
-		return protoreflect.ValueOfString("")
-	case "evmos.inflation.v1.ExponentialCalculation.r":
-		return protoreflect.ValueOfString("")
-	case "evmos.inflation.v1.ExponentialCalculation.c":
+	case "evmos.inflation.v1.InflationRecipient.address":
 		return protoreflect.ValueOfString("")
-	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
-		return protoreflect.ValueOfString("")
-	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+	case "evmos.inflation.v1.InflationRecipient.share":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evmos.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InflationRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in evmos.inflation.v1.ExponentialCalculation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in evmos.inflation.v1.InflationRecipient", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -863,7 +938,7 @@ func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InflationRecipient) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -874,7 +949,7 @@ func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InflationRecipient) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -886,7 +961,7 @@ func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+func (x *fastReflection_InflationRecipient) IsValid() bool {
 	return x != nil
 }
 
@@ -896,9 +971,9 @@ func (x *fastReflection_ExponentialCalculation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InflationRecipient) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -910,23 +985,11 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.A)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.R)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.C)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BondingTarget)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxVariance)
+		l = len(x.Share)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -940,7 +1003,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -959,38 +1022,17 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxVariance) > 0 {
-			i -= len(x.MaxVariance)
-			copy(dAtA[i:], x.MaxVariance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.BondingTarget) > 0 {
-			i -= len(x.BondingTarget)
-			copy(dAtA[i:], x.BondingTarget)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.C) > 0 {
-			i -= len(x.C)
-			copy(dAtA[i:], x.C)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.R) > 0 {
-			i -= len(x.R)
-			copy(dAtA[i:], x.R)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+		if len(x.Share) > 0 {
+			i -= len(x.Share)
+			copy(dAtA[i:], x.Share)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Share)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.A) > 0 {
-			i -= len(x.A)
-			copy(dAtA[i:], x.A)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1005,7 +1047,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1037,15 +1079,15 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1073,11 +1115,11 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.A = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1105,11 +1147,1267 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.R = string(dAtA[iNdEx:postIndex])
+				x.Share = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExponentialCalculation                protoreflect.MessageDescriptor
+	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_inflation_v1_inflation_proto_init()
+	md_ExponentialCalculation = File_evmos_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
+	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
+	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
+	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
+	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
+	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+
+type fastReflection_ExponentialCalculation ExponentialCalculation
+
+func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(x)
+}
+
+func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+
+type fastReflection_ExponentialCalculation_messageType struct{}
+
+func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(nil)
+}
+func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_ExponentialCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
+	return (*ExponentialCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != "" {
+		value := protoreflect.ValueOfString(x.A)
+		if !f(fd_ExponentialCalculation_a, value) {
+			return
+		}
+	}
+	if x.R != "" {
+		value := protoreflect.ValueOfString(x.R)
+		if !f(fd_ExponentialCalculation_r, value) {
+			return
+		}
+	}
+	if x.C != "" {
+		value := protoreflect.ValueOfString(x.C)
+		if !f(fd_ExponentialCalculation_c, value) {
+			return
+		}
+	}
+	if x.BondingTarget != "" {
+		value := protoreflect.ValueOfString(x.BondingTarget)
+		if !f(fd_ExponentialCalculation_bonding_target, value) {
+			return
+		}
+	}
+	if x.MaxVariance != "" {
+		value := protoreflect.ValueOfString(x.MaxVariance)
+		if !f(fd_ExponentialCalculation_max_variance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		return x.A != ""
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		return x.R != ""
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		return x.C != ""
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		return x.BondingTarget != ""
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		return x.MaxVariance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		x.A = ""
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		x.R = ""
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		x.C = ""
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = ""
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		value := x.A
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		value := x.R
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		value := x.C
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		value := x.BondingTarget
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		value := x.MaxVariance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		x.A = value.Interface().(string)
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		x.R = value.Interface().(string)
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		x.C = value.Interface().(string)
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = value.Interface().(string)
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		panic(fmt.Errorf("field a of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		panic(fmt.Errorf("field r of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		panic(fmt.Errorf("field c of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		panic(fmt.Errorf("field bonding_target of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		panic(fmt.Errorf("field max_variance of message evmos.inflation.v1.ExponentialCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.ExponentialCalculation.a":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.ExponentialCalculation.r":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.ExponentialCalculation.c":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.ExponentialCalculation.bonding_target":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.ExponentialCalculation.max_variance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.inflation.v1.ExponentialCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.A)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.R)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.C)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondingTarget)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxVariance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxVariance) > 0 {
+			i -= len(x.MaxVariance)
+			copy(dAtA[i:], x.MaxVariance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BondingTarget) > 0 {
+			i -= len(x.BondingTarget)
+			copy(dAtA[i:], x.BondingTarget)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.C) > 0 {
+			i -= len(x.C)
+			copy(dAtA[i:], x.C)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.R) > 0 {
+			i -= len(x.R)
+			copy(dAtA[i:], x.R)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.A) > 0 {
+			i -= len(x.A)
+			copy(dAtA[i:], x.A)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.A = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.R = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.C = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondingTarget = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxVariance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BondedRatioCalculation                       protoreflect.MessageDescriptor
+	fd_BondedRatioCalculation_inflation_rate_change protoreflect.FieldDescriptor
+	fd_BondedRatioCalculation_inflation_max         protoreflect.FieldDescriptor
+	fd_BondedRatioCalculation_inflation_min         protoreflect.FieldDescriptor
+	fd_BondedRatioCalculation_goal_bonded           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_inflation_v1_inflation_proto_init()
+	md_BondedRatioCalculation = File_evmos_inflation_v1_inflation_proto.Messages().ByName("BondedRatioCalculation")
+	fd_BondedRatioCalculation_inflation_rate_change = md_BondedRatioCalculation.Fields().ByName("inflation_rate_change")
+	fd_BondedRatioCalculation_inflation_max = md_BondedRatioCalculation.Fields().ByName("inflation_max")
+	fd_BondedRatioCalculation_inflation_min = md_BondedRatioCalculation.Fields().ByName("inflation_min")
+	fd_BondedRatioCalculation_goal_bonded = md_BondedRatioCalculation.Fields().ByName("goal_bonded")
+}
+
+var _ protoreflect.Message = (*fastReflection_BondedRatioCalculation)(nil)
+
+type fastReflection_BondedRatioCalculation BondedRatioCalculation
+
+func (x *BondedRatioCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BondedRatioCalculation)(x)
+}
+
+func (x *BondedRatioCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BondedRatioCalculation_messageType fastReflection_BondedRatioCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_BondedRatioCalculation_messageType{}
+
+type fastReflection_BondedRatioCalculation_messageType struct{}
+
+func (x fastReflection_BondedRatioCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BondedRatioCalculation)(nil)
+}
+func (x fastReflection_BondedRatioCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_BondedRatioCalculation)
+}
+func (x fastReflection_BondedRatioCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BondedRatioCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BondedRatioCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_BondedRatioCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BondedRatioCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_BondedRatioCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BondedRatioCalculation) New() protoreflect.Message {
+	return new(fastReflection_BondedRatioCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BondedRatioCalculation) Interface() protoreflect.ProtoMessage {
+	return (*BondedRatioCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BondedRatioCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InflationRateChange != "" {
+		value := protoreflect.ValueOfString(x.InflationRateChange)
+		if !f(fd_BondedRatioCalculation_inflation_rate_change, value) {
+			return
+		}
+	}
+	if x.InflationMax != "" {
+		value := protoreflect.ValueOfString(x.InflationMax)
+		if !f(fd_BondedRatioCalculation_inflation_max, value) {
+			return
+		}
+	}
+	if x.InflationMin != "" {
+		value := protoreflect.ValueOfString(x.InflationMin)
+		if !f(fd_BondedRatioCalculation_inflation_min, value) {
+			return
+		}
+	}
+	if x.GoalBonded != "" {
+		value := protoreflect.ValueOfString(x.GoalBonded)
+		if !f(fd_BondedRatioCalculation_goal_bonded, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BondedRatioCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		return x.InflationRateChange != ""
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		return x.InflationMax != ""
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		return x.InflationMin != ""
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		return x.GoalBonded != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BondedRatioCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		x.InflationRateChange = ""
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		x.InflationMax = ""
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		x.InflationMin = ""
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		x.GoalBonded = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BondedRatioCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		value := x.InflationRateChange
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		value := x.InflationMax
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		value := x.InflationMin
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		value := x.GoalBonded
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BondedRatioCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		x.InflationRateChange = value.Interface().(string)
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		x.InflationMax = value.Interface().(string)
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		x.InflationMin = value.Interface().(string)
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		x.GoalBonded = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BondedRatioCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		panic(fmt.Errorf("field inflation_rate_change of message evmos.inflation.v1.BondedRatioCalculation is not mutable"))
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		panic(fmt.Errorf("field inflation_max of message evmos.inflation.v1.BondedRatioCalculation is not mutable"))
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		panic(fmt.Errorf("field inflation_min of message evmos.inflation.v1.BondedRatioCalculation is not mutable"))
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		panic(fmt.Errorf("field goal_bonded of message evmos.inflation.v1.BondedRatioCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BondedRatioCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_rate_change":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_max":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.BondedRatioCalculation.inflation_min":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.BondedRatioCalculation.goal_bonded":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.BondedRatioCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.BondedRatioCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BondedRatioCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.inflation.v1.BondedRatioCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BondedRatioCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BondedRatioCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BondedRatioCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BondedRatioCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BondedRatioCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InflationRateChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMax)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GoalBonded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BondedRatioCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GoalBonded) > 0 {
+			i -= len(x.GoalBonded)
+			copy(dAtA[i:], x.GoalBonded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GoalBonded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.InflationMin) > 0 {
+			i -= len(x.InflationMin)
+			copy(dAtA[i:], x.InflationMin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMin)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.InflationMax) > 0 {
+			i -= len(x.InflationMax)
+			copy(dAtA[i:], x.InflationMax)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMax)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InflationRateChange) > 0 {
+			i -= len(x.InflationRateChange)
+			copy(dAtA[i:], x.InflationRateChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRateChange)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BondedRatioCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BondedRatioCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BondedRatioCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRateChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationMax = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationMin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1134,14 +2432,434 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GoalBonded = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CappedSupplyCalculation                  protoreflect.MessageDescriptor
+	fd_CappedSupplyCalculation_max_supply       protoreflect.FieldDescriptor
+	fd_CappedSupplyCalculation_annual_inflation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_inflation_v1_inflation_proto_init()
+	md_CappedSupplyCalculation = File_evmos_inflation_v1_inflation_proto.Messages().ByName("CappedSupplyCalculation")
+	fd_CappedSupplyCalculation_max_supply = md_CappedSupplyCalculation.Fields().ByName("max_supply")
+	fd_CappedSupplyCalculation_annual_inflation = md_CappedSupplyCalculation.Fields().ByName("annual_inflation")
+}
+
+var _ protoreflect.Message = (*fastReflection_CappedSupplyCalculation)(nil)
+
+type fastReflection_CappedSupplyCalculation CappedSupplyCalculation
+
+func (x *CappedSupplyCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CappedSupplyCalculation)(x)
+}
+
+func (x *CappedSupplyCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CappedSupplyCalculation_messageType fastReflection_CappedSupplyCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_CappedSupplyCalculation_messageType{}
+
+type fastReflection_CappedSupplyCalculation_messageType struct{}
+
+func (x fastReflection_CappedSupplyCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CappedSupplyCalculation)(nil)
+}
+func (x fastReflection_CappedSupplyCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_CappedSupplyCalculation)
+}
+func (x fastReflection_CappedSupplyCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CappedSupplyCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CappedSupplyCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_CappedSupplyCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CappedSupplyCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_CappedSupplyCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CappedSupplyCalculation) New() protoreflect.Message {
+	return new(fastReflection_CappedSupplyCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CappedSupplyCalculation) Interface() protoreflect.ProtoMessage {
+	return (*CappedSupplyCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CappedSupplyCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_CappedSupplyCalculation_max_supply, value) {
+			return
+		}
+	}
+	if x.AnnualInflation != "" {
+		value := protoreflect.ValueOfString(x.AnnualInflation)
+		if !f(fd_CappedSupplyCalculation_annual_inflation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CappedSupplyCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		return x.MaxSupply != ""
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		return x.AnnualInflation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CappedSupplyCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		x.MaxSupply = ""
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		x.AnnualInflation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CappedSupplyCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		value := x.AnnualInflation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CappedSupplyCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		x.AnnualInflation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CappedSupplyCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		panic(fmt.Errorf("field max_supply of message evmos.inflation.v1.CappedSupplyCalculation is not mutable"))
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		panic(fmt.Errorf("field annual_inflation of message evmos.inflation.v1.CappedSupplyCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CappedSupplyCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.inflation.v1.CappedSupplyCalculation.max_supply":
+		return protoreflect.ValueOfString("")
+	case "evmos.inflation.v1.CappedSupplyCalculation.annual_inflation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.CappedSupplyCalculation"))
+		}
+		panic(fmt.Errorf("message evmos.inflation.v1.CappedSupplyCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CappedSupplyCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.inflation.v1.CappedSupplyCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CappedSupplyCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CappedSupplyCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CappedSupplyCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CappedSupplyCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CappedSupplyCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AnnualInflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CappedSupplyCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AnnualInflation) > 0 {
+			i -= len(x.AnnualInflation)
+			copy(dAtA[i:], x.AnnualInflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualInflation)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CappedSupplyCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.C = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CappedSupplyCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CappedSupplyCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1169,11 +2887,11 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondingTarget = string(dAtA[iNdEx:postIndex])
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualInflation", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1201,7 +2919,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxVariance = string(dAtA[iNdEx:postIndex])
+				x.AnnualInflation = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1254,8 +2972,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InflationCurve defines the calculation used to compute the mint provision of
+// each epoch.
+type InflationCurve int32
+
+const (
+	// INFLATION_CURVE_UNSPECIFIED defines an undefined curve, which falls back to
+	// the exponential calculation of the chains created before the curves were
+	// introduced.
+	InflationCurve_INFLATION_CURVE_UNSPECIFIED InflationCurve = 0
+	// INFLATION_CURVE_EXPONENTIAL - the provision decays exponentially on each
+	// period, following the ExponentialCalculation.
+	InflationCurve_INFLATION_CURVE_EXPONENTIAL InflationCurve = 1
+	// INFLATION_CURVE_BONDED_RATIO - the annual inflation moves towards a goal
+	// bonded ratio, following the BondedRatioCalculation.
+	InflationCurve_INFLATION_CURVE_BONDED_RATIO InflationCurve = 2
+	// INFLATION_CURVE_CAPPED_SUPPLY - a fixed annual inflation is minted until the
+	// supply reaches a maximum, following the CappedSupplyCalculation.
+	InflationCurve_INFLATION_CURVE_CAPPED_SUPPLY InflationCurve = 3
+)
+
+// Enum value maps for InflationCurve.
+var (
+	InflationCurve_name = map[int32]string{
+		0: "INFLATION_CURVE_UNSPECIFIED",
+		1: "INFLATION_CURVE_EXPONENTIAL",
+		2: "INFLATION_CURVE_BONDED_RATIO",
+		3: "INFLATION_CURVE_CAPPED_SUPPLY",
+	}
+	InflationCurve_value = map[string]int32{
+		"INFLATION_CURVE_UNSPECIFIED":   0,
+		"INFLATION_CURVE_EXPONENTIAL":   1,
+		"INFLATION_CURVE_BONDED_RATIO":  2,
+		"INFLATION_CURVE_CAPPED_SUPPLY": 3,
+	}
+)
+
+func (x InflationCurve) Enum() *InflationCurve {
+	p := new(InflationCurve)
+	*p = x
+	return p
+}
+
+func (x InflationCurve) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InflationCurve) Descriptor() protoreflect.EnumDescriptor {
+	return file_evmos_inflation_v1_inflation_proto_enumTypes[0].Descriptor()
+}
+
+func (InflationCurve) Type() protoreflect.EnumType {
+	return &file_evmos_inflation_v1_inflation_proto_enumTypes[0]
+}
+
+func (x InflationCurve) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InflationCurve.Descriptor instead.
+func (InflationCurve) EnumDescriptor() ([]byte, []int) {
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
+// allocated through minting on each epoch (staking, community, recipients). It
 // excludes the team vesting distribution, as this is minted once at genesis.
 // The initial InflationDistribution can be calculated from the Evmos Token
 // Model like this:
@@ -1277,6 +3058,10 @@ type InflationDistribution struct {
 	// community_pool defines the proportion of the minted minted_denom that is to
 	// be allocated to the community pool
 	CommunityPool string `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// recipients defines the module accounts and addresses that are allocated a
+	// proportion of the minted minted_denom, besides the staking rewards and the
+	// community pool
+	Recipients []*InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
 func (x *InflationDistribution) Reset() {
@@ -1321,6 +3106,62 @@ func (x *InflationDistribution) GetCommunityPool() string {
 	return ""
 }
 
+func (x *InflationDistribution) GetRecipients() []*InflationRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient defines a module account or an address that is allocated
+// a proportion of the minted coins on each epoch.
+type InflationRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the name of the recipient module account or the bech32 address
+	// of the recipient account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// share defines the proportion of the minted minted_denom that is to be
+	// allocated to the recipient
+	Share string `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *InflationRecipient) Reset() {
+	*x = InflationRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRecipient) ProtoMessage() {}
+
+// Deprecated: Use InflationRecipient.ProtoReflect.Descriptor instead.
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InflationRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InflationRecipient) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -1346,7 +3187,7 @@ type ExponentialCalculation struct {
 func (x *ExponentialCalculation) Reset() {
 	*x = ExponentialCalculation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[1]
+		mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1360,7 +3201,7 @@ func (*ExponentialCalculation) ProtoMessage() {}
 
 // Deprecated: Use ExponentialCalculation.ProtoReflect.Descriptor instead.
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ExponentialCalculation) GetA() string {
@@ -1398,6 +3239,126 @@ func (x *ExponentialCalculation) GetMaxVariance() string {
 	return ""
 }
 
+// BondedRatioCalculation holds the factors to calculate the annual inflation
+// targeting a bonded ratio, as the dynamic inflation of the Cosmos SDK x/mint
+// module. On each epoch the annual inflation changes by:
+// inflationChange = (1 - bondedRatio / goal_bonded) * inflation_rate_change /
+// epochsPerPeriod
+// and is kept within inflation_min and inflation_max.
+type BondedRatioCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inflation_rate_change defines the maximum annual change in the inflation
+	InflationRateChange string `protobuf:"bytes,1,opt,name=inflation_rate_change,json=inflationRateChange,proto3" json:"inflation_rate_change,omitempty"`
+	// inflation_max defines the maximum annual inflation
+	InflationMax string `protobuf:"bytes,2,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
+	// inflation_min defines the minimum annual inflation
+	InflationMin string `protobuf:"bytes,3,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
+	// goal_bonded defines the targeted bonded ratio
+	GoalBonded string `protobuf:"bytes,4,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
+}
+
+func (x *BondedRatioCalculation) Reset() {
+	*x = BondedRatioCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondedRatioCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondedRatioCalculation) ProtoMessage() {}
+
+// Deprecated: Use BondedRatioCalculation.ProtoReflect.Descriptor instead.
+func (*BondedRatioCalculation) Descriptor() ([]byte, []int) {
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BondedRatioCalculation) GetInflationRateChange() string {
+	if x != nil {
+		return x.InflationRateChange
+	}
+	return ""
+}
+
+func (x *BondedRatioCalculation) GetInflationMax() string {
+	if x != nil {
+		return x.InflationMax
+	}
+	return ""
+}
+
+func (x *BondedRatioCalculation) GetInflationMin() string {
+	if x != nil {
+		return x.InflationMin
+	}
+	return ""
+}
+
+func (x *BondedRatioCalculation) GetGoalBonded() string {
+	if x != nil {
+		return x.GoalBonded
+	}
+	return ""
+}
+
+// CappedSupplyCalculation holds the factors to calculate a fixed annual
+// inflation of the total supply, which stops once the supply reaches the
+// max_supply. Calculation reference:
+// epochProvision = min(totalSupply * annual_inflation / epochsPerPeriod,
+// max_supply - totalSupply)
+type CappedSupplyCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_supply defines the maximum total supply of the mint denom
+	MaxSupply string `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// annual_inflation defines the proportion of the total supply minted each
+	// period
+	AnnualInflation string `protobuf:"bytes,2,opt,name=annual_inflation,json=annualInflation,proto3" json:"annual_inflation,omitempty"`
+}
+
+func (x *CappedSupplyCalculation) Reset() {
+	*x = CappedSupplyCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_inflation_v1_inflation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CappedSupplyCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CappedSupplyCalculation) ProtoMessage() {}
+
+// Deprecated: Use CappedSupplyCalculation.ProtoReflect.Descriptor instead.
+func (*CappedSupplyCalculation) Descriptor() ([]byte, []int) {
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CappedSupplyCalculation) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *CappedSupplyCalculation) GetAnnualInflation() string {
+	if x != nil {
+		return x.AnnualInflation
+	}
+	return ""
+}
+
 var File_evmos_inflation_v1_inflation_proto protoreflect.FileDescriptor
 
 var file_evmos_inflation_v1_inflation_proto_rawDesc = []byte{
@@ -1407,7 +3368,7 @@ var file_evmos_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
 	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x22, 0xe5, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
 	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0f, 0x73,
 	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
@@ -1415,51 +3376,106 @@ var file_evmos_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
 	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x55,
 	0x0a, 0x10, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x18, 0x01, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x18, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
 	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
 	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
 	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
 	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0xde, 0x02, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12, 0x36, 0x0a, 0x01, 0x72, 0x18, 0x02,
+	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x51, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x76, 0x6d,
+	0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x12, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xde, 0x02, 0x0a, 0x16, 0x45, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12, 0x36, 0x0a, 0x01,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x01, 0x72, 0x12, 0x36, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x63, 0x12, 0x4f, 0x0a, 0x0e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x16, 0x42,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01,
-	0x72, 0x12, 0x36, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x63, 0x12, 0x4f, 0x0a, 0x0e, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x6d, 0x61,
-	0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x61, 0x78, 0x12, 0x4d, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x12, 0x49, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a,
+	0x17, 0x43, 0x61, 0x70, 0x70, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x10, 0x61,
+	0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2a, 0x9d, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75,
+	0x72, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54,
+	0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x5f,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x46, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x50, 0x45,
+	0x44, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f,
+	0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1474,17 +3490,23 @@ func file_evmos_inflation_v1_inflation_proto_rawDescGZIP() []byte {
 	return file_evmos_inflation_v1_inflation_proto_rawDescData
 }
 
-var file_evmos_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evmos_inflation_v1_inflation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evmos_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_evmos_inflation_v1_inflation_proto_goTypes = []interface{}{
-	(*InflationDistribution)(nil),  // 0: evmos.inflation.v1.InflationDistribution
-	(*ExponentialCalculation)(nil), // 1: evmos.inflation.v1.ExponentialCalculation
+	(InflationCurve)(0),             // 0: evmos.inflation.v1.InflationCurve
+	(*InflationDistribution)(nil),   // 1: evmos.inflation.v1.InflationDistribution
+	(*InflationRecipient)(nil),      // 2: evmos.inflation.v1.InflationRecipient
+	(*ExponentialCalculation)(nil),  // 3: evmos.inflation.v1.ExponentialCalculation
+	(*BondedRatioCalculation)(nil),  // 4: evmos.inflation.v1.BondedRatioCalculation
+	(*CappedSupplyCalculation)(nil), // 5: evmos.inflation.v1.CappedSupplyCalculation
 }
 var file_evmos_inflation_v1_inflation_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: evmos.inflation.v1.InflationDistribution.recipients:type_name -> evmos.inflation.v1.InflationRecipient
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_evmos_inflation_v1_inflation_proto_init() }
@@ -1506,6 +3528,18 @@ func file_evmos_inflation_v1_inflation_proto_init() {
 			}
 		}
 		file_evmos_inflation_v1_inflation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmos_inflation_v1_inflation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExponentialCalculation); i {
 			case 0:
 				return &v.state
@@ -1517,19 +3551,44 @@ func file_evmos_inflation_v1_inflation_proto_init() {
 				return nil
 			}
 		}
+		file_evmos_inflation_v1_inflation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondedRatioCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmos_inflation_v1_inflation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CappedSupplyCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evmos_inflation_v1_inflation_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_evmos_inflation_v1_inflation_proto_goTypes,
 		DependencyIndexes: file_evmos_inflation_v1_inflation_proto_depIdxs,
+		EnumInfos:         file_evmos_inflation_v1_inflation_proto_enumTypes,
 		MessageInfos:      file_evmos_inflation_v1_inflation_proto_msgTypes,
 	}.Build()
 	File_evmos_inflation_v1_inflation_proto = out.File
@@ -22,7 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balances_FullMethodName = "/evmos.vesting.v2.Query/Balances"
+	Query_Balances_FullMethodName                = "/evmos.vesting.v2.Query/Balances"
+	Query_VestingAccountsByFunder_FullMethodName = "/evmos.vesting.v2.Query/VestingAccountsByFunder"
+	Query_Schedule_FullMethodName                = "/evmos.vesting.v2.Query/Schedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,12 @@ const (
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// VestingAccountsByFunder retrieves the addresses of the clawback vesting
+	// accounts funded by the given funder
+	VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error)
+	// Schedule retrieves the unlock and vesting timeline of a clawback vesting
+	// account
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -50,12 +58,36 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error) {
+	out := new(QueryVestingAccountsByFunderResponse)
+	err := c.cc.Invoke(ctx, Query_VestingAccountsByFunder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_Schedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// VestingAccountsByFunder retrieves the addresses of the clawback vesting
+	// accounts funded by the given funder
+	VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error)
+	// Schedule retrieves the unlock and vesting timeline of a clawback vesting
+	// account
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -66,6 +98,12 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (UnimplementedQueryServer) VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountsByFunder not implemented")
+}
+func (UnimplementedQueryServer) Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -97,6 +135,42 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingAccountsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingAccountsByFunder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, req.(*QueryVestingAccountsByFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Schedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -108,6 +182,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "VestingAccountsByFunder",
+			Handler:    _Query_VestingAccountsByFunder_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/query.proto",
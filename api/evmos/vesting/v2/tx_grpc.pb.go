@@ -27,6 +27,7 @@ const (
 	Msg_Clawback_FullMethodName                     = "/evmos.vesting.v2.Msg/Clawback"
 	Msg_UpdateVestingFunder_FullMethodName          = "/evmos.vesting.v2.Msg/UpdateVestingFunder"
 	Msg_ConvertVestingAccount_FullMethodName        = "/evmos.vesting.v2.Msg/ConvertVestingAccount"
+	Msg_UpdateVestingSchedule_FullMethodName        = "/evmos.vesting.v2.Msg/UpdateVestingSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +46,9 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule updates the lockup and vesting schedules of an
+	// existing ClawbackVestingAccount.
+	UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +104,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error) {
+	out := new(MsgUpdateVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateVestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -116,6 +129,9 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule updates the lockup and vesting schedules of an
+	// existing ClawbackVestingAccount.
+	UpdateVestingSchedule(context.Context, *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -138,6 +154,9 @@ func (UnimplementedMsgServer) UpdateVestingFunder(context.Context, *MsgUpdateVes
 func (UnimplementedMsgServer) ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) UpdateVestingSchedule(context.Context, *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +260,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateVestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, req.(*MsgUpdateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +305,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "UpdateVestingSchedule",
+			Handler:    _Msg_UpdateVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/tx.proto",
@@ -13,14 +13,19 @@ import (
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
+// DisabledAuthzMsgTypes defines the Msg types that cannot be granted or included
+// on an authz.MsgExec msgs field.
+var DisabledAuthzMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
 func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
-		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
-		),
+		// disable the Msg types that cannot be included on an authz.MsgExec msgs field
+		cosmosante.NewAuthzLimiterDecorator(DisabledAuthzMsgTypes...),
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
@@ -35,6 +35,13 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// CheckDisabledMsgs returns an error if any of the msgs is an authz MsgGrant or
+// MsgExec that grants or executes a disabled msg type. It allows to enforce the
+// same restrictions outside the AnteHandler, e.g. on the authz precompile.
+func (ald AuthzLimiterDecorator) CheckDisabledMsgs(msgs []sdk.Msg) error {
+	return ald.checkDisabledMsgs(msgs, false, 1)
+}
+
 // checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
@@ -16,8 +16,9 @@ import (
 )
 
 // MinGasPriceDecorator will check if the transaction's fee is at least as large
-// as the MinGasPrices param. If fee is too low, decorator returns error and tx
-// is rejected. This applies for both CheckTx and DeliverTx
+// as the MinGasPrices param, multiplied by the min gas price multiplier of the
+// fee lane matching all the transaction messages, if any. If fee is too low,
+// decorator returns error and tx is rejected. This applies for both CheckTx and DeliverTx
 // If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
@@ -37,7 +38,13 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
+	params := mpd.feemarketKeeper.GetParams(ctx)
+	minGasPrice := params.MinGasPrice
+
+	// the transactions of a fee lane pay the min gas price of the lane
+	if lane, found := params.FeeLaneForMsgs(tx.GetMsgs()); found {
+		minGasPrice = lane.MinGasPrice(minGasPrice)
+	}
 
 	feeCoins := feeTx.GetFee()
 	baseDenom, err := sdk.GetBaseDenom()
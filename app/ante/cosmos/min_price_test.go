@@ -10,6 +10,7 @@ import (
 	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
 	"github.com/evmos/evmos/v20/testutil"
 	testutiltx "github.com/evmos/evmos/v20/testutil/tx"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 var execTypes = []struct {
@@ -108,6 +109,42 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 			"provided fee < minimum global fee",
 			true,
 		},
+		{
+			"valid cosmos tx with MinGasPrices = 10, gasPrice = 5 and fee lane multiplier = 0.5",
+			func() sdk.Tx {
+				params := nw.App.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeLanes = []feemarkettypes.FeeLane{
+					feemarkettypes.NewFeeLane("bank", []string{sdk.MsgTypeURL(&testMsg)}, nil, math.LegacyNewDecWithPrec(5, 1), 0),
+				}
+				err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(5), denom, &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, gasPrice = 10 and fee lane multiplier = 2",
+			func() sdk.Tx {
+				params := nw.App.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeLanes = []feemarkettypes.FeeLane{
+					feemarkettypes.NewFeeLane("bank", []string{sdk.MsgTypeURL(&testMsg)}, nil, math.LegacyNewDec(2), 0),
+				}
+				err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(10), denom, &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
 		{
 			"invalid cosmos tx with stake denom",
 			func() sdk.Tx {
@@ -15,6 +15,7 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.BankKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.PaymasterKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
 			options.MaxTxGasWanted,
@@ -25,9 +25,58 @@ func VerifyAccountBalance(
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
+	account, err := verifyEOA(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifySponsoredAccountBalance checks that the account balance is greater than the
+// transaction value. The transaction fees are paid by the paymaster of the sender.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+// This method will fail if:
+// - from address is NOT an EOA
+// - account balance is lower than the transaction value
+func VerifySponsoredAccountBalance(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	account, err := verifyEOA(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	value := txData.GetValue()
+	if value != nil && account.Balance.Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// verifyEOA checks that the sender account is an EOA and sets it to store if
+// it doesn't exist.
+func verifyEOA(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
 		)
@@ -39,9 +88,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
@@ -22,7 +22,10 @@ import (
 // b) tipFeeCap = tx.MaxPriorityPrice (default) or MaxInt64
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
-// - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
+// - the base fee is multiplied by the min gas price multiplier of the fee lane matching all the tx
+// messages, if any.
+// - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`, plus the priority of the
+// fee lane matching all the tx messages, if any.
 func NewDynamicFeeChecker(fmk FeeMarketKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -35,7 +38,16 @@ func NewDynamicFeeChecker(fmk FeeMarketKeeper) authante.TxFeeChecker {
 			return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 		}
 
-		return feeChecker(ctx, fmk, feeTx)
+		fees, priority, err := feeChecker(ctx, fmk, feeTx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if lane, found := fmk.GetParams(ctx).FeeLaneForMsgs(tx.GetMsgs()); found {
+			priority = lane.ApplyPriority(priority)
+		}
+
+		return fees, priority, nil
 	}
 }
 
@@ -61,6 +73,11 @@ func feeChecker(
 		baseFee = sdkmath.LegacyZeroDec()
 	}
 
+	// the transactions of a fee lane pay the base fee of the lane
+	if lane, found := k.GetParams(ctx).FeeLaneForMsgs(feeTx.GetMsgs()); found {
+		baseFee = lane.BaseFee(baseFee)
+	}
+
 	// default to `MaxInt64` when there's no extension option.
 	maxPriorityPrice := sdkmath.LegacyNewDec(math.MaxInt64)
 
@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/evmos/v20/app/ante/evm"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/types"
@@ -22,7 +23,8 @@ import (
 var _ evm.FeeMarketKeeper = MockFeemarketKeeper{}
 
 type MockFeemarketKeeper struct {
-	BaseFee math.LegacyDec
+	BaseFee  math.LegacyDec
+	FeeLanes []feemarkettypes.FeeLane
 }
 
 func (m MockFeemarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec {
@@ -38,7 +40,9 @@ func (m MockFeemarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uin
 }
 
 func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Params) {
-	return feemarkettypes.DefaultParams()
+	params = feemarkettypes.DefaultParams()
+	params.FeeLanes = m.FeeLanes
+	return params
 }
 
 func TestSDKTxFeeChecker(t *testing.T) {
@@ -171,6 +175,48 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			10,
 			true,
 		},
+		{
+			"success, dynamic fee priority with fee lane",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+				FeeLanes: []feemarkettypes.FeeLane{
+					feemarkettypes.NewFeeLane("bank", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, math.LegacyOneDec(), 100),
+				},
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(baseDenom, math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(10)))))
+				require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{}))
+				return txBuilder.GetTx()
+			},
+			true,
+			"10000010aevmos",
+			110,
+			true,
+		},
+		{
+			"success, dynamic fee priority with fee lane of other msgs",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+				FeeLanes: []feemarkettypes.FeeLane{
+					feemarkettypes.NewFeeLane("bank", []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, nil, math.LegacyOneDec(), 100),
+				},
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(baseDenom, math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(10)))))
+				require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{}))
+				return txBuilder.GetTx()
+			},
+			true,
+			"10000010aevmos",
+			10,
+			true,
+		},
 		{
 			"success, dynamic fee empty tipFeeCap",
 			deliverTxCtx,
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// GetEVMAddressClass returns the class of the `to` address of an Ethereum
+// transaction, used to match the transaction with a fee lane.
+func GetEVMAddressClass(
+	ctx sdk.Context,
+	ek EVMKeeper,
+	evmParams evmtypes.Params,
+	to *common.Address,
+) feemarkettypes.EVMAddressClass {
+	if to == nil {
+		return feemarkettypes.EVM_ADDRESS_CLASS_CONTRACT_CREATION
+	}
+
+	if evmParams.IsActiveStaticPrecompile(*to, ctx.BlockHeight()) {
+		return feemarkettypes.EVM_ADDRESS_CLASS_PRECOMPILE
+	}
+
+	if account := ek.GetAccount(ctx, *to); account != nil && account.IsContract() {
+		return feemarkettypes.EVM_ADDRESS_CLASS_CONTRACT
+	}
+
+	return feemarkettypes.EVM_ADDRESS_CLASS_ACCOUNT
+}
+
+// CheckFeeLaneBaseFee checks that the gas fee cap of an Ethereum transaction
+// covers the base fee of its fee lane. The transaction is still required to
+// cover the block base fee when the lane base fee is lower.
+func CheckFeeLaneBaseFee(lane feemarkettypes.FeeLane, gasFeeCap, baseFee *big.Int) error {
+	laneBaseFee := lane.BaseFee(sdkmath.LegacyNewDecFromBigInt(baseFee))
+	if sdkmath.LegacyNewDecFromBigInt(gasFeeCap).LT(laneBaseFee) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"max fee per gas less than the base fee of fee lane %s: got %s, required %s",
+			lane.Name, gasFeeCap, laneBaseFee,
+		)
+	}
+	return nil
+}
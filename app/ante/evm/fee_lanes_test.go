@@ -0,0 +1,169 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/app/ante/evm"
+	commonfactory "github.com/evmos/evmos/v20/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+func TestGetEVMAddressClass(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	params := nw.App.EvmKeeper.GetParams(ctx)
+	params.ActiveStaticPrecompiles = []string{evmtypes.StakingPrecompileAddress}
+	require.NoError(t, nw.App.EvmKeeper.SetParams(ctx, params))
+
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	contract := tx.GenerateAddress()
+	nw.App.EvmKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, nw.App.EvmKeeper.SetAccount(ctx, contract, statedb.Account{
+		Balance:  common.Big0,
+		CodeHash: codeHash,
+	}))
+
+	staking := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	account := tx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		to       *common.Address
+		expClass feemarkettypes.EVMAddressClass
+	}{
+		{"contract creation", nil, feemarkettypes.EVM_ADDRESS_CLASS_CONTRACT_CREATION},
+		{"static precompile", &staking, feemarkettypes.EVM_ADDRESS_CLASS_PRECOMPILE},
+		{"contract", &contract, feemarkettypes.EVM_ADDRESS_CLASS_CONTRACT},
+		{"account", &account, feemarkettypes.EVM_ADDRESS_CLASS_ACCOUNT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			class := evm.GetEVMAddressClass(ctx, nw.App.EvmKeeper, params, tc.to)
+			require.Equal(t, tc.expClass, class)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeLanes() {
+	baseFee := sdkmath.LegacyNewDec(ethparams.InitialBaseFee)
+	baseFeeInt := baseFee.TruncateInt()
+	to := tx.GenerateAddress()
+	gas := uint64(200000)
+
+	premiumLane := func(evmClasses ...feemarkettypes.EVMAddressClass) feemarkettypes.FeeLane {
+		return feemarkettypes.NewFeeLane("premium", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, evmClasses, sdkmath.LegacyNewDec(2), 0)
+	}
+	discountLane := func(evmClasses ...feemarkettypes.EVMAddressClass) feemarkettypes.FeeLane {
+		return feemarkettypes.NewFeeLane("discount", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, evmClasses, sdkmath.LegacyNewDecWithPrec(5, 1), 0)
+	}
+
+	ethTx := func(gasFeeCap sdkmath.Int) sdk.Tx {
+		key := suite.GetKeyring().GetKey(0)
+		tx, err := suite.GetTxFactory().GenerateSignedEthTx(key.Priv, evmtypes.EvmTxArgs{
+			To:        &to,
+			Amount:    big.NewInt(10),
+			GasLimit:  gas,
+			GasFeeCap: gasFeeCap.BigInt(),
+			GasTipCap: big.NewInt(1),
+			Accesses:  &ethtypes.AccessList{},
+		})
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	cosmosTx := func(gasPrice sdkmath.Int) sdk.Tx {
+		key := suite.GetKeyring().GetKey(1)
+		tx, err := suite.GetTxFactory().BuildCosmosTx(key.Priv, commonfactory.CosmosTxArgs{
+			Gas:  &gas,
+			Fees: sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), gasPrice.MulRaw(int64(gas)))), //#nosec G115
+			Msgs: []sdk.Msg{banktypes.NewMsgSend(
+				key.AccAddr, to.Bytes(), sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(10))),
+			)},
+		})
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	testCases := []struct {
+		name        string
+		lane        feemarkettypes.FeeLane
+		txFn        func() sdk.Tx
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - eth tx below the base fee of a premium lane",
+			premiumLane(feemarkettypes.EVM_ADDRESS_CLASS_ACCOUNT),
+			func() sdk.Tx { return ethTx(baseFeeInt.AddRaw(1)) },
+			false,
+			"base fee of fee lane premium",
+		},
+		{
+			"success - eth tx covering the base fee of a premium lane",
+			premiumLane(feemarkettypes.EVM_ADDRESS_CLASS_ACCOUNT),
+			func() sdk.Tx { return ethTx(baseFeeInt.MulRaw(2)) },
+			true,
+			"",
+		},
+		{
+			"fail - eth tx below the block base fee in a discount lane",
+			discountLane(feemarkettypes.EVM_ADDRESS_CLASS_ACCOUNT),
+			func() sdk.Tx { return ethTx(baseFeeInt.QuoRaw(2)) },
+			false,
+			"max fee per gas less than block base fee",
+		},
+		{
+			"fail - cosmos tx below the base fee of a premium lane",
+			premiumLane(),
+			func() sdk.Tx { return cosmosTx(baseFeeInt) },
+			false,
+			"gas prices too low",
+		},
+		{
+			"success - cosmos tx covering the base fee of a discount lane",
+			discountLane(),
+			func() sdk.Tx { return cosmosTx(baseFeeInt.QuoRaw(2)) },
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.WithFeemarketEnabled(true)
+			suite.WithBaseFee(&baseFee)
+			suite.SetupTest() // reset
+			ctx := suite.GetNetwork().GetContext()
+
+			params := suite.GetNetwork().App.FeeMarketKeeper.GetParams(ctx)
+			params.FeeLanes = []feemarkettypes.FeeLane{tc.lane}
+			suite.Require().NoError(suite.GetNetwork().App.FeeMarketKeeper.SetParams(ctx, params))
+			suite.Require().Equal(baseFee, suite.GetNetwork().App.FeeMarketKeeper.GetBaseFee(ctx))
+
+			_, err := suite.GetAnteHandler()(ctx, tc.txFn(), false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+	suite.WithFeemarketEnabled(false)
+	suite.WithBaseFee(nil)
+}
@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	paymastertypes "github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+// GetFeeSponsorship returns the sponsorship of the paymaster that pays the
+// transaction fees on behalf of the sender, if any. The paymaster must have
+// enough balance to cover the fees. Transactions without fees are never
+// sponsored.
+func GetFeeSponsorship(
+	ctx sdk.Context,
+	paymasterKeeper PaymasterKeeper,
+	evmKeeper EVMKeeper,
+	from common.Address,
+	txData evmtypes.TxData,
+	baseFee *big.Int,
+) (paymastertypes.Sponsorship, bool) {
+	fee := txData.EffectiveFee(baseFee)
+	if fee == nil || fee.Sign() <= 0 {
+		return paymastertypes.Sponsorship{}, false
+	}
+
+	return paymasterKeeper.GetFeeSponsorship(
+		ctx,
+		from,
+		txData.GetTo(),
+		sdkmath.NewIntFromBigInt(fee),
+		func(paymaster common.Address) bool {
+			return evmKeeper.GetBalance(ctx, paymaster).Cmp(fee) >= 0
+		},
+	)
+}
+
+// ConsumeSponsorshipAndEmitEvent adds the fees paid by the paymaster to the
+// sponsorship spent amount and emits the sponsored transaction event.
+func ConsumeSponsorshipAndEmitEvent(
+	ctx sdk.Context,
+	paymasterKeeper PaymasterKeeper,
+	sponsorship paymastertypes.Sponsorship,
+	fees sdk.Coins,
+) error {
+	fee := fees.AmountOf(evmtypes.GetEVMCoinDenom())
+	if err := paymasterKeeper.ConsumeSponsorship(ctx, sponsorship, fee); err != nil {
+		return errorsmod.Wrapf(err, "failed to consume sponsorship of paymaster %s", sponsorship.Paymaster)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			paymastertypes.EventTypeSponsoredTx,
+			sdk.NewAttribute(paymastertypes.AttributeKeyPaymaster, sponsorship.Paymaster),
+			sdk.NewAttribute(paymastertypes.AttributeKeySender, sponsorship.Sender),
+			sdk.NewAttribute(paymastertypes.AttributeKeyFee, fees.String()),
+		),
+	)
+	return nil
+}
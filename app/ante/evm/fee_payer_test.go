@@ -0,0 +1,71 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestSponsoredTxFeePayer() {
+	suite.WithFeemarketEnabled(false)
+	suite.SetupTest()
+
+	ctx := suite.GetNetwork().GetContext()
+	app := suite.GetNetwork().App
+	to := utiltx.GenerateAddress()
+
+	paymaster := suite.GetKeyring().GetKey(0)
+	otherSender := suite.GetKeyring().GetKey(1)
+	sender, senderPriv := utiltx.NewAddrKey()
+
+	_, err := app.PaymasterKeeper.Sponsor(ctx, paymaster.Addr, sender, sdkmath.NewInt(1e18))
+	suite.Require().NoError(err)
+	err = app.PaymasterKeeper.SelectPaymaster(ctx, sender, paymaster.Addr)
+	suite.Require().NoError(err)
+
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:  evmtypes.GetEthChainConfig().ChainID,
+		To:       &to,
+		GasLimit: 100_000,
+		GasPrice: big.NewInt(1e9),
+	}
+	fee := sdkmath.NewIntFromUint64(txArgs.GasLimit).Mul(sdkmath.NewIntFromBigInt(txArgs.GasPrice))
+
+	paymasterBalance := app.EvmKeeper.GetBalance(ctx, paymaster.Addr)
+
+	// the fees of the sponsored tx are paid by the paymaster
+	sponsoredTx, err := suite.GetTxFactory().GenerateSignedEthTx(senderPriv, txArgs)
+	suite.Require().NoError(err)
+	ctx, err = suite.GetAnteHandler()(ctx, sponsoredTx, false)
+	suite.Require().NoError(err)
+
+	paymasterBalanceAfter := app.EvmKeeper.GetBalance(ctx, paymaster.Addr)
+	suite.Require().Equal(fee.BigInt(), new(big.Int).Sub(paymasterBalance, paymasterBalanceAfter))
+	suite.Require().Zero(app.EvmKeeper.GetBalance(ctx, sender).Sign())
+
+	sponsorship, found := app.PaymasterKeeper.GetSponsorship(ctx, sender, paymaster.Addr)
+	suite.Require().True(found)
+	suite.Require().Equal(fee, sponsorship.Spent)
+
+	// a tx of another sender processed afterwards doesn't override the fee
+	// payer of the sponsored tx
+	otherTx, err := suite.GetTxFactory().GenerateSignedEthTx(otherSender.Priv, txArgs)
+	suite.Require().NoError(err)
+	ctx, err = suite.GetAnteHandler()(ctx, otherTx, false)
+	suite.Require().NoError(err)
+
+	sponsoredMsg, ok := sponsoredTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	suite.Require().True(ok)
+	feePayer, found := app.EvmKeeper.GetTxFeePayerTransient(ctx, common.HexToHash(sponsoredMsg.Hash))
+	suite.Require().True(found)
+	suite.Require().Equal(paymaster.Addr, feePayer)
+
+	otherMsg, ok := otherTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	suite.Require().True(ok)
+	_, found = app.EvmKeeper.GetTxFeePayerTransient(ctx, common.HexToHash(otherMsg.Hash))
+	suite.Require().False(found)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	paymastertypes "github.com/evmos/evmos/v20/x/paymaster/types"
 )
 
 // EVMKeeper defines the expected keeper interface used on the AnteHandler
@@ -25,6 +26,7 @@ type EVMKeeper interface { //nolint: revive
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
@@ -42,6 +44,19 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 }
 
+// PaymasterKeeper defines the expected keeper interface used on the AnteHandler
+// to charge the transaction fees to the paymaster that sponsors the sender.
+type PaymasterKeeper interface {
+	GetFeeSponsorship(
+		ctx sdk.Context,
+		sender common.Address,
+		recipient *common.Address,
+		fee math.Int,
+		canPay func(paymaster common.Address) bool,
+	) (paymastertypes.Sponsorship, bool)
+	ConsumeSponsorship(ctx sdk.Context, sponsorship paymastertypes.Sponsorship, fee math.Int) error
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
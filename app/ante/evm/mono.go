@@ -31,6 +31,7 @@ type MonoDecorator struct {
 	bankKeeper         evmtypes.BankKeeper
 	feeMarketKeeper    FeeMarketKeeper
 	evmKeeper          EVMKeeper
+	paymasterKeeper    PaymasterKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
@@ -56,6 +57,7 @@ func NewMonoDecorator(
 	bankKeeper evmtypes.BankKeeper,
 	feeMarketKeeper FeeMarketKeeper,
 	evmKeeper EVMKeeper,
+	paymasterKeeper PaymasterKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
@@ -65,6 +67,7 @@ func NewMonoDecorator(
 		bankKeeper:         bankKeeper,
 		feeMarketKeeper:    feeMarketKeeper,
 		evmKeeper:          evmKeeper,
+		paymasterKeeper:    paymasterKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
 		maxGasWanted:       maxGasWanted,
@@ -158,6 +161,8 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
 	}
 
+	feeMarketParams := md.feeMarketKeeper.GetParams(ctx)
+
 	// NOTE: the protocol does not support multiple EVM messages currently so
 	// this loop will complete after the first message.
 	for i, msg := range msgs {
@@ -171,13 +176,30 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
 
+		// the fee lane matching the class of the `to` address adjusts the min
+		// gas prices and the base fee required from the transaction
+		lane, hasLane := feeMarketParams.FeeLaneForEVMAddressClass(
+			GetEVMAddressClass(ctx, md.evmKeeper, decUtils.EvmParams, txData.GetTo()),
+		)
+
 		// TODO: computation for mempool and global fee can be made using only
 		// the price instead of the fee. This would save some computation.
 		//
 		// 2. mempool inclusion fee
 		if ctx.IsCheckTx() && !simulate {
+			mempoolMinGasPrice := decUtils.MempoolMinGasPrice
+			if hasLane {
+				mempoolMinGasPrice = lane.MinGasPrice(mempoolMinGasPrice)
+			}
+
 			// FIX: Mempool dec should be converted
-			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+			if err := CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+				return ctx, err
+			}
+		}
+
+		if hasLane && decUtils.BaseFee != nil {
+			if err := CheckFeeLaneBaseFee(lane, txData.GetGasFeeCap(), decUtils.BaseFee); err != nil {
 				return ctx, err
 			}
 		}
@@ -192,8 +214,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		}
 
-		// 3. min gas price (global min fee)
-		if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
+		// 3. min gas price (global min fee), adjusted by the fee lane
+		globalMinGasPrice := decUtils.GlobalMinGasPrice
+		if hasLane {
+			globalMinGasPrice = lane.MinGasPrice(globalMinGasPrice)
+		}
+
+		if err := CheckGlobalFee(fee, globalMinGasPrice, gasLimit); err != nil {
 			return ctx, err
 		}
 
@@ -219,17 +246,27 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		fromAddr := common.BytesToAddress(from)
 
 		// 6. account balance verification
+		// The fees of sponsored transactions are paid by the paymaster, so the
+		// sender balance only needs to cover the transaction value.
+		sponsorship, sponsored := GetFeeSponsorship(
+			ctx,
+			md.paymasterKeeper,
+			md.evmKeeper,
+			fromAddr,
+			txData,
+			decUtils.BaseFee,
+		)
+
 		// We get the account with the balance from the EVM keeper because it is
 		// using a wrapper of the bank keeper as a dependency to scale all
 		// balances to 18 decimals.
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(
-			ctx,
-			md.accountKeeper,
-			account,
-			fromAddr,
-			txData,
-		); err != nil {
+		if sponsored {
+			err = VerifySponsoredAccountBalance(ctx, md.accountKeeper, account, fromAddr, txData)
+		} else {
+			err = VerifyAccountBalance(ctx, md.accountKeeper, account, fromAddr, txData)
+		}
+		if err != nil {
 			return ctx, err
 		}
 
@@ -286,13 +323,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		feePayer := from
+		if sponsored {
+			if err := ConsumeSponsorshipAndEmitEvent(ctx, md.paymasterKeeper, sponsorship, msgFees); err != nil {
+				return ctx, err
+			}
+			feePayer = sponsorship.GetPaymasterAddress().Bytes()
+
+			// the leftover gas is refunded to the paymaster that paid the fees
+			md.evmKeeper.SetTxFeePayerTransient(ctx, common.HexToHash(ethMsg.Hash), sponsorship.GetPaymasterAddress())
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
 				Evm: md.evmKeeper,
 			},
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
@@ -306,12 +354,15 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		)
 		decUtils.GasWanted = gasWanted
 
-		minPriority := GetMsgPriority(
+		msgPriority := GetMsgPriority(
 			txData,
-			decUtils.MinPriority,
+			math.MaxInt64,
 			decUtils.BaseFee,
 		)
-		decUtils.MinPriority = minPriority
+		if hasLane {
+			msgPriority = lane.ApplyPriority(msgPriority)
+		}
+		decUtils.MinPriority = min(decUtils.MinPriority, msgPriority)
 
 		// Update the fee to be paid for the tx adding the fee specified for the
 		// current message.
@@ -151,6 +151,7 @@ func (s *benchmarkSuite) generateHandlerOptions() ante.HandlerOptions {
 		DistributionKeeper:     s.network.App.DistrKeeper,
 		IBCKeeper:              s.network.App.IBCKeeper,
 		FeeMarketKeeper:        s.network.App.FeeMarketKeeper,
+		PaymasterKeeper:        s.network.App.PaymasterKeeper,
 		SignModeHandler:        encCfg.TxConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         1_000_000_000,
@@ -105,6 +105,21 @@ func TestValidateHandlerOptions(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"fail - empty paymaster keeper",
+			ante.HandlerOptions{
+				Cdc:                nw.App.AppCodec(),
+				AccountKeeper:      nw.App.AccountKeeper,
+				BankKeeper:         nw.App.BankKeeper,
+				DistributionKeeper: nw.App.DistrKeeper,
+				IBCKeeper:          nw.App.IBCKeeper,
+				StakingKeeper:      nw.App.StakingKeeper,
+				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
+				EvmKeeper:          nw.App.EvmKeeper,
+				PaymasterKeeper:    nil,
+			},
+			false,
+		},
 		{
 			"fail - empty signature gas consumer",
 			ante.HandlerOptions{
@@ -116,6 +131,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				PaymasterKeeper:    nw.App.PaymasterKeeper,
 				SigGasConsumer:     nil,
 			},
 			false,
@@ -131,6 +147,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				PaymasterKeeper:    nw.App.PaymasterKeeper,
 				SigGasConsumer:     ante.SigVerificationGasConsumer,
 				SignModeHandler:    nil,
 			},
@@ -147,6 +164,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				PaymasterKeeper:    nw.App.PaymasterKeeper,
 				SigGasConsumer:     ante.SigVerificationGasConsumer,
 				SignModeHandler:    nw.App.GetTxConfig().SignModeHandler(),
 				TxFeeChecker:       nil,
@@ -166,6 +184,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				FeegrantKeeper:         nw.App.FeeGrantKeeper,
 				IBCKeeper:              nw.App.IBCKeeper,
 				FeeMarketKeeper:        nw.App.FeeMarketKeeper,
+				PaymasterKeeper:        nw.App.PaymasterKeeper,
 				SignModeHandler:        nw.GetEncodingConfig().TxConfig.SignModeHandler(),
 				SigGasConsumer:         ante.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
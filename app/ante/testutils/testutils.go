@@ -123,6 +123,7 @@ func (suite *AnteTestSuite) SetupTest() {
 		IBCKeeper:              suite.network.App.IBCKeeper,
 		StakingKeeper:          suite.network.App.StakingKeeper,
 		FeeMarketKeeper:        suite.network.App.FeeMarketKeeper,
+		PaymasterKeeper:        suite.network.App.PaymasterKeeper,
 		SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		ExtensionOptionChecker: types.HasDynamicFeeExtensionOption,
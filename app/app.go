@@ -112,6 +112,9 @@ import (
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -139,9 +142,12 @@ import (
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
 
 	"github.com/evmos/evmos/v20/app/ante"
+	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
 	ethante "github.com/evmos/evmos/v20/app/ante/evm"
 	"github.com/evmos/evmos/v20/app/post"
 	v20 "github.com/evmos/evmos/v20/app/upgrades/v20"
+	v21 "github.com/evmos/evmos/v20/app/upgrades/v21"
+	icaprecompile "github.com/evmos/evmos/v20/precompiles/ica"
 	srvflags "github.com/evmos/evmos/v20/server/flags"
 	"github.com/evmos/evmos/v20/x/erc20"
 	erc20keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -149,6 +155,9 @@ import (
 	"github.com/evmos/evmos/v20/x/feemarket"
 	feemarketkeeper "github.com/evmos/evmos/v20/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/evmos/evmos/v20/x/paymaster"
+	paymasterkeeper "github.com/evmos/evmos/v20/x/paymaster/keeper"
+	paymastertypes "github.com/evmos/evmos/v20/x/paymaster/types"
 	"github.com/evmos/evmos/v20/x/staking"
 	stakingkeeper "github.com/evmos/evmos/v20/x/staking/keeper"
 	"github.com/evmos/evmos/v20/x/vesting"
@@ -156,8 +165,12 @@ import (
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
+	"github.com/evmos/evmos/v20/x/ibc/forward"
+	forwardkeeper "github.com/evmos/evmos/v20/x/ibc/forward/keeper"
+	forwardtypes "github.com/evmos/evmos/v20/x/ibc/forward/types"
 	"github.com/evmos/evmos/v20/x/ibc/transfer"
 	transferkeeper "github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+	evmostransfertypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
 
 	memiavlstore "github.com/crypto-org-chain/cronos/store"
 
@@ -202,6 +215,7 @@ var (
 		inflationtypes.ModuleName:      {authtypes.Minter},
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		ratelimittypes.ModuleName:      nil,
+		forwardtypes.ModuleName:        nil,
 	}
 )
 
@@ -244,10 +258,12 @@ type Evmos struct {
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	RateLimitKeeper       ratelimitkeeper.Keeper
+	ForwardKeeper         forwardkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -262,6 +278,7 @@ type Evmos struct {
 	Erc20Keeper     erc20keeper.Keeper
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
+	PaymasterKeeper paymasterkeeper.Keeper
 
 	// the module manager
 	mm                 *module.Manager
@@ -364,6 +381,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -438,6 +456,7 @@ func NewEvmos(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper,
 		app.GetSubspace(feemarkettypes.ModuleName),
 	)
 
@@ -446,7 +465,7 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper,
 		// FIX: Temporary solution to solve keeper interdependency while new precompile module
 		// is being developed.
-		&app.Erc20Keeper,
+		&app.Erc20Keeper, &app.PaymasterKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
 	)
 	app.EvmKeeper = evmKeeper
@@ -494,6 +513,10 @@ func NewEvmos(
 		app.AuthzKeeper, &app.TransferKeeper,
 	)
 
+	app.PaymasterKeeper = paymasterkeeper.NewKeeper(
+		keys[paymastertypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
 	// Create the rate limit keeper
 	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
 		appCodec,
@@ -506,7 +529,7 @@ func NewEvmos(
 	)
 
 	app.TransferKeeper = transferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		appCodec, keys[ibctransfertypes.StoreKey], keys[evmostransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
 		app.RateLimitKeeper, // ICS4 Wrapper: ratelimit IBC middleware
 		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
@@ -514,7 +537,28 @@ func NewEvmos(
 		authAddr,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	// Create the app.ICAControllerKeeper. The packet callbacks of the channels
+	// registered through the ICA precompile are routed to the precompile IBC module.
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, app.keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+		authAddr,
+	)
+
+	app.ForwardKeeper = forwardkeeper.NewKeeper(
+		keys[forwardtypes.StoreKey], appCodec,
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		app.TransferKeeper,
+		app.BankKeeper,
+	)
+
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName))
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
@@ -542,6 +586,13 @@ func NewEvmos(
 			app.GovKeeper,
 			app.SlashingKeeper,
 			app.EvidenceKeeper,
+			app.PaymasterKeeper,
+			app.FeeGrantKeeper,
+			app.ICAControllerKeeper,
+			app.EpochsKeeper,
+			app.InflationKeeper,
+			appCodec,
+			cosmosante.NewAuthzLimiterDecorator(ante.DisabledAuthzMsgTypes...),
 		),
 	)
 
@@ -565,10 +616,14 @@ func NewEvmos(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC stack with the ICA precompile as the underlying application
+	icaControllerStack := icacontroller.NewIBCMiddleware(icaprecompile.NewIBCModule(), app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Packet Forward Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 			- IBC Transfer
@@ -577,7 +632,7 @@ func NewEvmos(
 		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> forward.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -586,11 +641,17 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = forward.NewIBCMiddleware(
+		app.ForwardKeeper, transferStack,
+		forwardtypes.DefaultForwardRetries,
+		forwardtypes.DefaultForwardTimeout,
+	)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -633,7 +694,7 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		ibctm.NewAppModule(),
 		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
@@ -647,6 +708,8 @@ func NewEvmos(
 			app.GetSubspace(erc20types.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper.Keeper),
+		paymaster.NewAppModule(app.PaymasterKeeper),
+		forward.NewAppModule(app.ForwardKeeper, app.AccountKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -731,10 +794,15 @@ func NewEvmos(
 		feegrant.ModuleName,
 		upgradetypes.ModuleName,
 		// Evmos modules
+		// NOTE: vesting module must be initialized after auth module to index
+		// the genesis clawback vesting accounts by funder
+		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
 		ratelimittypes.ModuleName,
+		paymastertypes.ModuleName,
+		forwardtypes.ModuleName,
 	)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -860,6 +928,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		BankKeeper:             app.BankKeeper,
 		ExtensionOptionChecker: evmostypes.HasDynamicFeeExtensionOption,
 		EvmKeeper:              app.EvmKeeper,
+		PaymasterKeeper:        app.PaymasterKeeper,
 		StakingKeeper:          app.StakingKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		DistributionKeeper:     app.DistrKeeper,
@@ -882,6 +951,7 @@ func (app *Evmos) setPostHandler() {
 	options := post.HandlerOptions{
 		FeeCollectorName: authtypes.FeeCollectorName,
 		BankKeeper:       app.BankKeeper,
+		FeeMarketKeeper:  app.FeeMarketKeeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -1181,6 +1251,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	// FIX: do we need a keytable?
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
 	// ethermint subspaces
@@ -1203,6 +1274,16 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v21 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v21.UpgradeName,
+		v21.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.AccountKeeper,
+			app.ICAControllerKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1215,19 +1296,24 @@ func (app *Evmos) setupUpgradeHandlers() {
 		return
 	}
 
-	// var storeUpgrades *storetypes.StoreUpgrades
-
-	// switch upgradeInfo.Name {
-	// case v191.UpgradeName:
-	// 	storeUpgrades = &storetypes.StoreUpgrades{
-	// 		Added: []string{ratelimittypes.ModuleName},
-	// 	}
-	// default:
-	// // no-op
-	// }
-
-	// if storeUpgrades != nil {
-	// 	// configure store loader that checks if version == upgradeHeight and applies store upgrades
-	// 	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
-	// }
+	var storeUpgrades *storetypes.StoreUpgrades
+
+	switch upgradeInfo.Name {
+	case v21.UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{
+				icacontrollertypes.StoreKey,
+				forwardtypes.StoreKey,
+				paymastertypes.StoreKey,
+				evmostransfertypes.StoreKey,
+			},
+		}
+	default:
+		// no-op
+	}
+
+	if storeUpgrades != nil {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
 }
@@ -19,6 +19,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -26,7 +27,10 @@ import (
 	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	forwardtypes "github.com/evmos/evmos/v20/x/ibc/forward/types"
+	evmostransfertypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
+	paymastertypes "github.com/evmos/evmos/v20/x/paymaster/types"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
 
@@ -45,9 +49,9 @@ func StoreKeys() (
 		evidencetypes.StoreKey, capabilitytypes.StoreKey, consensusparamtypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, evmostransfertypes.StoreKey, forwardtypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
 		// ibc rate-limit keys
 		ratelimittypes.StoreKey,
 		// ethermint keys
@@ -55,6 +59,7 @@ func StoreKeys() (
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
+		paymastertypes.StoreKey,
 	}
 
 	keys := storetypes.NewKVStoreKeys(storeKeys...)
@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ sdk.PostDecorator = &FeeSplitDecorator{}
+
+// FeeMarketKeeper defines the expected fee market keeper used to split the
+// transaction fees.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) sdkmath.LegacyDec
+	IsFeeSplitEnabled(ctx sdk.Context) bool
+	DistributeFees(ctx sdk.Context, fees, baseFees sdk.Coins) error
+}
+
+// FeeSplitDecorator is the decorator that burns and distributes the transaction
+// fees from Cosmos transactions according to the fee market fee split. The
+// fallback decorator handles the fees if the fee split is not enabled.
+type FeeSplitDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+	fallback        sdk.PostDecorator
+}
+
+// NewFeeSplitDecorator creates a new instance of the FeeSplitDecorator.
+func NewFeeSplitDecorator(feeMarketKeeper FeeMarketKeeper, fallback sdk.PostDecorator) sdk.PostDecorator {
+	return &FeeSplitDecorator{
+		feeMarketKeeper: feeMarketKeeper,
+		fallback:        fallback,
+	}
+}
+
+// PostHandle splits the transaction fees from Cosmos transactions. The part of
+// the fees in the evm denom covering the base fee for the gas limit of the
+// transaction is split as base fee and the rest of the fees as tips. If an
+// Ethereum transaction is present, this logic is skipped since the fees of
+// Ethereum transactions are split by the EVM module after the gas refund. If
+// the fee split is not enabled, the fees are handled by the fallback decorator.
+func (fd FeeSplitDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if !fd.feeMarketKeeper.IsFeeSplitEnabled(ctx) {
+		return fd.fallback.PostHandle(ctx, tx, simulate, success, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	// skip logic if there is an Ethereum transaction
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return next(ctx, tx, simulate, success)
+		}
+	}
+
+	fees := feeTx.GetFee()
+
+	// safety check: ensure the fees are not empty and with positive amounts
+	// before splitting
+	if len(fees) == 0 || !fees.IsAllPositive() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	baseFees := sdk.Coins{}
+	if baseFee := fd.feeMarketKeeper.GetBaseFee(ctx); !baseFee.IsNil() {
+		gasLimit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+		baseFees = sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), baseFee.Mul(gasLimit).TruncateInt()))
+	}
+
+	if err := fd.feeMarketKeeper.DistributeFees(ctx, fees, baseFees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
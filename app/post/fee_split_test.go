@@ -0,0 +1,139 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/evmos/v20/app/post"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+func (s *PostTestSuite) TestFeeSplitPostHandle() {
+	testCases := []struct {
+		name       string
+		feeSplit   *feemarkettypes.FeeSplit
+		tx         func() sdk.Tx
+		postChecks func()
+	}{
+		{
+			name: "pass - noop with Ethereum message",
+			feeSplit: &feemarkettypes.FeeSplit{
+				BaseFeeBurnShare:      sdkmath.LegacyOneDec(),
+				TipProposerShare:      sdkmath.LegacyZeroDec(),
+				TipCommunityPoolShare: sdkmath.LegacyZeroDec(),
+				TipModuleShare:        sdkmath.LegacyZeroDec(),
+			},
+			tx: func() sdk.Tx {
+				s.MintCoinsForFeeCollector(sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 10)))
+				return s.BuildEthTx()
+			},
+			postChecks: func() {
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 10)), s.GetFeeCollectorBalance())
+				s.Require().True(s.unitNetwork.App.FeeMarketKeeper.GetBurnedFees(s.unitNetwork.GetContext()).IsZero())
+			},
+		},
+		{
+			name:     "pass - fees are burned if the fee split is not enabled",
+			feeSplit: nil,
+			tx: func() sdk.Tx {
+				feeAmount := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 300_000))
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			postChecks: func() {
+				s.Require().True(s.GetFeeCollectorBalance().IsZero())
+				s.Require().True(s.unitNetwork.App.FeeMarketKeeper.GetBurnedFees(s.unitNetwork.GetContext()).IsZero())
+			},
+		},
+		{
+			name: "pass - base fees are burned and tips are kept",
+			feeSplit: &feemarkettypes.FeeSplit{
+				BaseFeeBurnShare:      sdkmath.LegacyOneDec(),
+				TipProposerShare:      sdkmath.LegacyZeroDec(),
+				TipCommunityPoolShare: sdkmath.LegacyZeroDec(),
+				TipModuleShare:        sdkmath.LegacyZeroDec(),
+			},
+			tx: func() sdk.Tx {
+				feeAmount := sdk.NewCoins(
+					sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 300_000),
+					sdk.NewInt64Coin("btc", 10),
+				)
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			postChecks: func() {
+				s.Require().Equal(
+					sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 200_000), sdk.NewInt64Coin("btc", 10)),
+					s.GetFeeCollectorBalance(),
+				)
+				s.Require().Equal(
+					sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 100_000)),
+					s.unitNetwork.App.FeeMarketKeeper.GetBurnedFees(s.unitNetwork.GetContext()),
+				)
+			},
+		},
+		{
+			name: "pass - tips are sent to the community pool",
+			feeSplit: ptrFeeSplit(feemarkettypes.NewFeeSplit(
+				sdkmath.LegacyNewDecWithPrec(5, 1),
+				sdkmath.LegacyZeroDec(),
+				sdkmath.LegacyNewDecWithPrec(5, 1),
+				sdkmath.LegacyZeroDec(),
+				"",
+			)),
+			tx: func() sdk.Tx {
+				feeAmount := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 300_000))
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			postChecks: func() {
+				ctx := s.unitNetwork.GetContext()
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 150_000)), s.GetFeeCollectorBalance())
+				s.Require().Equal(
+					sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 50_000)),
+					s.unitNetwork.App.FeeMarketKeeper.GetBurnedFees(ctx),
+				)
+
+				feePool, err := s.unitNetwork.App.DistrKeeper.FeePool.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feePool.CommunityPool.AmountOf(evmtypes.GetEVMCoinDenom()).GTE(sdkmath.LegacyNewDec(100_000)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			err := s.unitNetwork.NextBlock()
+			s.Require().NoError(err)
+
+			// the base fee of 1 per gas covers 100,000 of the fees for the gas limit
+			ctx := s.unitNetwork.GetContext()
+			params := s.unitNetwork.App.FeeMarketKeeper.GetParams(ctx)
+			params.BaseFee = sdkmath.LegacyOneDec()
+			params.FeeSplit = tc.feeSplit
+			err = s.unitNetwork.App.FeeMarketKeeper.SetParams(ctx, params)
+			s.Require().NoError(err)
+
+			burnDecorator := post.NewBurnDecorator(authtypes.FeeCollectorName, s.unitNetwork.App.BankKeeper)
+			feeSplitDecorator := post.NewFeeSplitDecorator(s.unitNetwork.App.FeeMarketKeeper, burnDecorator)
+
+			terminator := sdk.ChainPostDecorators(sdk.Terminator{}) //nolint:staticcheck
+			_, err = feeSplitDecorator.PostHandle(ctx, tc.tx(), false, false, terminator)
+			s.Require().NoError(err)
+
+			tc.postChecks()
+		})
+	}
+}
+
+func ptrFeeSplit(feeSplit feemarkettypes.FeeSplit) *feemarkettypes.FeeSplit {
+	return &feeSplit
+}
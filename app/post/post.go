@@ -14,6 +14,7 @@ import (
 type HandlerOptions struct {
 	FeeCollectorName string
 	BankKeeper       bankkeeper.Keeper
+	FeeMarketKeeper  FeeMarketKeeper
 }
 
 func (h HandlerOptions) Validate() error {
@@ -25,13 +26,20 @@ func (h HandlerOptions) Validate() error {
 		return errors.New("bank keeper cannot be nil")
 	}
 
+	if h.FeeMarketKeeper == nil {
+		return errors.New("fee market keeper cannot be nil")
+	}
+
 	return nil
 }
 
 // NewPostHandler returns a new PostHandler decorators chain.
 func NewPostHandler(ho HandlerOptions) sdk.PostHandler {
 	postDecorators := []sdk.PostDecorator{
-		NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper),
+		NewFeeSplitDecorator(
+			ho.FeeMarketKeeper,
+			NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper),
+		),
 	}
 
 	return sdk.ChainPostDecorators(postDecorators...)
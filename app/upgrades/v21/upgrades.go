@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v21
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v21
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ak authkeeper.AccountKeeper,
+	icak icacontrollerkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// The ICA controller submodule is added to the existing ICA module, so
+		// its genesis is not run by the module migrations.
+		logger.Info("enabling the ICA controller")
+		EnableICAController(ctx, icak)
+
+		// The forward middleware credits the receiver account with the tokens
+		// of the forwarded packets, so it must exist before the first packet is
+		// received, regardless of the forward module genesis.
+		logger.Info("creating the forward receiver account")
+		forward.SetReceiverAccount(ctx, ak)
+
+		// NOTE: the modules added in this version are not in the version map,
+		// so RunMigrations runs their InitGenesis with the default genesis
+		// state, besides the store migrations of the existing modules.
+		logger.Info("running module migrations")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// EnableICAController sets the default params of the ICA controller submodule,
+// which enable it.
+func EnableICAController(ctx sdk.Context, icak icacontrollerkeeper.Keeper) {
+	icak.SetParams(ctx, icacontrollertypes.DefaultParams())
+}
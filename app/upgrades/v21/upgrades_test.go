@@ -0,0 +1,49 @@
+package v21_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/stretchr/testify/require"
+
+	v21 "github.com/evmos/evmos/v20/app/upgrades/v21"
+	testnetwork "github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/ibc/forward"
+	forwardtypes "github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+func TestEnableICAController(t *testing.T) {
+	network := testnetwork.NewUnitTestNetwork()
+	ctx := network.GetContext()
+	icak := network.App.ICAControllerKeeper
+
+	icak.SetParams(ctx, icacontrollertypes.NewParams(false))
+	require.False(t, icak.GetParams(ctx).ControllerEnabled)
+
+	v21.EnableICAController(ctx, icak)
+	require.True(t, icak.GetParams(ctx).ControllerEnabled)
+}
+
+func TestSetForwardReceiverAccount(t *testing.T) {
+	network := testnetwork.NewUnitTestNetwork()
+	ctx := network.GetContext()
+	ak := network.App.AccountKeeper
+
+	// remove the account created on genesis, as on chains that did not run
+	// the forward module genesis
+	acc := ak.GetAccount(ctx, forwardtypes.ReceiverAddress)
+	require.NotNil(t, acc)
+	ak.RemoveAccount(ctx, acc)
+
+	forward.SetReceiverAccount(ctx, ak)
+
+	acc = ak.GetAccount(ctx, forwardtypes.ReceiverAddress)
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	require.True(t, ok, "forward receiver is not a module account")
+	require.Equal(t, forwardtypes.ReceiverName, moduleAcc.Name)
+
+	// it is a no-op if the account exists
+	forward.SetReceiverAccount(ctx, ak)
+	require.Equal(t, acc, ak.GetAccount(ctx, forwardtypes.ReceiverAddress))
+}
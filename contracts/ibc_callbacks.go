@@ -0,0 +1,31 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package contracts
+
+import (
+	_ "embed"
+
+	contractutils "github.com/evmos/evmos/v20/contracts/utils"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	// IIBCCallbacksJSON are the compiled bytes of the IIBCCallbacks interface
+	//
+	//go:embed solidity/IIBCCallbacks.json
+	IIBCCallbacksJSON []byte
+
+	// IIBCCallbacksContract is the compiled IBC callbacks interface. It does not
+	// contain any binary data and is only used for its ABI.
+	IIBCCallbacksContract evmtypes.CompiledContract
+)
+
+func init() {
+	var err error
+	if IIBCCallbacksContract, err = contractutils.ConvertPrecompileHardhatBytesToCompiledContract(
+		IIBCCallbacksJSON,
+	); err != nil {
+		panic(err)
+	}
+}
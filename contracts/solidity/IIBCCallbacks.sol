@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.20;
+
+/// @author Evmos Core Team
+/// @title IBC Callbacks Interface
+/// @dev The interface that contracts have to implement to be called back by the
+/// ICS20 transfer middleware. A contract is called when tokens are received with
+/// an `evm.dest_callback` memo and when the acknowledgement or timeout of a packet
+/// sent with an `evm.src_callback` memo is processed.
+interface IIBCCallbacks {
+    /// @dev onRecv is called after the tokens of an incoming ICS20 transfer were
+    /// credited to the contract.
+    /// @param sourceChannel The channel on the counterparty chain the packet was sent from
+    /// @param sender The address of the sender on the counterparty chain
+    /// @param token The address of the ERC-20 token received
+    /// @param amount The amount of tokens received
+    /// @param data The calldata provided in the memo of the packet
+    function onRecv(
+        string calldata sourceChannel,
+        string calldata sender,
+        address token,
+        uint256 amount,
+        bytes calldata data
+    ) external;
+
+    /// @dev onAck is called after the acknowledgement of an outgoing ICS20 transfer
+    /// sent by the contract was processed. In case of an error acknowledgement the
+    /// tokens were already refunded to the contract.
+    /// @param sourceChannel The channel on this chain the packet was sent from
+    /// @param sequence The sequence of the packet
+    /// @param success Whether the transfer succeeded on the counterparty chain
+    function onAck(
+        string calldata sourceChannel,
+        uint64 sequence,
+        bool success
+    ) external;
+
+    /// @dev onTimeout is called after the timeout of an outgoing ICS20 transfer sent
+    /// by the contract was processed. The tokens were already refunded to the contract.
+    /// @param sourceChannel The channel on this chain the packet was sent from
+    /// @param sequence The sequence of the packet
+    function onTimeout(string calldata sourceChannel, uint64 sequence) external;
+}
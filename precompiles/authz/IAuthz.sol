@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData represents an authorization granted by a granter to a grantee.
+struct GrantData {
+    address granter;
+    address grantee;
+    string authorizationType;
+    string msgTypeUrl;
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts grant generic authorizations,
+/// revoke them and execute messages on behalf of other accounts using the Cosmos SDK
+/// authz module. The caller of the transactions is the granter of the grants and the
+/// grantee of the executed messages.
+/// Messages disabled for authz on Cosmos transactions can neither be granted nor executed.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IAuthz {
+    /// @dev Emitted when a generic authorization is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @param expiration The expiration of the grant as a unix timestamp, zero if it never expires
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl,
+        int64 expiration
+    );
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message authorization
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Emitted when messages are executed on behalf of their signers.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a generic authorization from the caller to the grantee to
+    /// execute messages of the given type.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message (e.g. '/cosmos.bank.v1beta1.MsgSend')
+    /// @param expiration The expiration of the grant as a unix timestamp, zero if it never expires
+    /// @return success Whether or not the grant was successful
+    function grant(
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorization granted by the caller to the grantee for the given message type.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message authorization
+    /// @return success Whether or not the revocation was successful
+    function revoke(
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes the messages with the caller as grantee. Each message is a
+    /// protobuf-encoded google.protobuf.Any wrapping a Cosmos SDK message.
+    /// @param msgs The protobuf-encoded messages to execute
+    /// @return results The results of the executed messages
+    function exec(bytes[] calldata msgs) external returns (bytes[] memory results);
+
+    /// QUERIES
+
+    /// @dev Returns the grants from the granter to the grantee. If the message type URL
+    /// is not empty, only the grant for that message type is returned.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message, empty to return all the grants
+    /// @param pagination The pagination options
+    /// @return grants The grants from the granter to the grantee
+    /// @return pageResponse The pagination information
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants given by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return grants The grants given by the granter
+    /// @return pageResponse The pagination information
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants received by the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return grants The grants received by the grantee
+    /// @return pageResponse The pagination information
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidExpiration is raised when the expiration of the grant is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid msgs: %v"
+	// ErrInvalidMsg is raised when a message to execute cannot be decoded.
+	ErrInvalidMsg = "invalid msg at index %d: %s"
+	// ErrInvalidAuthorization is raised when a grant contains an authorization that cannot be decoded.
+	ErrInvalidAuthorization = "invalid authorization type %s"
+)
@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant transaction.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeExec defines the event type for the authz Exec transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitGrantEvent creates a new event emitted on a Grant transaction.
+func (p Precompile) EmitGrantEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	msgTypeURL string,
+	expiration int64,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeGrant]
+	topics, err := makeTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(msgTypeURL, expiration)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	msgTypeURL string,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRevoke]
+	topics, err := makeTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(msgTypeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	grantee common.Address,
+	msgTypeURLs []string,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeExec]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(msgTypeURLs)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// makeTopics returns the event topics for the granter and the grantee.
+func makeTopics(event abi.Event, granter, grantee common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
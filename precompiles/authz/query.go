@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants from the granter to the grantee, optionally
+// filtered by message type URL.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantsResponse(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranterGrants returns the grants given by the granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranteeGrants returns the grants received by the grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			0,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - grant for msg type does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			0,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+		{
+			"success - grant for msg type",
+			func() []interface{} {
+				s.grant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - all grants",
+			func() []interface{} {
+				s.grant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+				s.grant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), "/cosmos.staking.v1beta1.MsgDelegate")
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Grants(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+				s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", grant.AuthorizationType)
+				s.Require().Positive(grant.Expiration)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterAndGranteeGrants() {
+	s.SetupTest()
+	s.grant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+
+	testCases := []struct {
+		name    string
+		method  string
+		account common.Address
+	}{
+		{"granter grants", authz.GranterGrantsMethod, s.keyring.GetAddr(0)},
+		{"grantee grants", authz.GranteeGrantsMethod, s.keyring.GetAddr(1)},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+			args := []interface{}{tc.account, query.PageRequest{CountTotal: true}}
+
+			var (
+				bz  []byte
+				err error
+			)
+			if tc.method == authz.GranterGrantsMethod {
+				bz, err = s.precompile.GranterGrants(ctx, &method, contract, args)
+			} else {
+				bz, err = s.precompile.GranteeGrants(ctx, &method, contract, args)
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.method, bz))
+			s.Require().Len(out.Grants, 1)
+			s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+			s.Require().Equal(s.keyring.GetAddr(1), out.Grants[0].Grantee)
+			s.Require().Equal(sendMsgTypeURL, out.Grants[0].MsgTypeUrl)
+			s.Require().Equal(uint64(1), out.PageResponse.Total)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/app/ante"
+	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	factory factory.TxFactory
+	keyring testkeyring.Keyring
+
+	precompile *authz.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	var err error
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.network = nw
+	s.factory = factory.New(nw, grpc.NewIntegrationHandler(nw))
+	s.keyring = keyring
+
+	if s.precompile, err = authz.NewPrecompile(
+		s.network.App.AuthzKeeper,
+		s.network.App.AppCodec(),
+		cosmosante.NewAuthzLimiterDecorator(ante.DisabledAuthzMsgTypes...),
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization from the caller to the grantee to execute
+// messages of the given type. Messages disabled for authz cannot be granted.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrant(args, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkDisabledMsgs(msg); err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration int64
+	if msg.Grant.Expiration != nil {
+		expiration = msg.Grant.Expiration.Unix()
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL(), expiration); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the authorization granted by the caller to the grantee for the
+// given message type.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevoke(args, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages with the caller as grantee. The messages
+// signed by other accounts require an authorization from their signer to the
+// caller. Messages disabled for authz cannot be executed.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.CallerAddress
+	msg, msgs, err := NewMsgExec(p.cdc, args, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkDisabledMsgs(msg); err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, m := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(m)
+	}
+
+	// execute the messages in a cached context, so that the balances before
+	// the execution can be compared with the ones after it for all the
+	// accounts found in the bank events
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := p.AuthzKeeper.Exec(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	for _, account := range balanceChangeAccounts(cacheCtx.EventManager().Events()) {
+		diff := new(big.Int).Sub(
+			cmn.GetEVMCoinBalance(cacheCtx, stateDB, account),
+			cmn.GetEVMCoinBalance(ctx, stateDB, account),
+		)
+		switch diff.Sign() {
+		case 1:
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(account, diff, cmn.Add))
+		case -1:
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(account, diff.Neg(diff), cmn.Sub))
+		}
+	}
+
+	writeCache()
+
+	if err = p.EmitExecEvent(ctx, stateDB, grantee, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// checkDisabledMsgs returns an error if the message grants or executes a
+// message type that is disabled for authz.
+func (p Precompile) checkDisabledMsgs(msg sdk.Msg) error {
+	if err := p.limiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s", err.Error())
+	}
+	return nil
+}
+
+// balanceChangeAccounts returns the accounts that spent or received coins on
+// the given bank events, without duplicates and in order of occurrence.
+func balanceChangeAccounts(events sdk.Events) []common.Address {
+	seen := make(map[common.Address]struct{})
+	accounts := make([]common.Address, 0)
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != key {
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(attr.Value)
+			if err != nil {
+				continue
+			}
+			account := common.BytesToAddress(addr)
+			if _, ok := seen[account]; ok {
+				continue
+			}
+			seen[account] = struct{}{}
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
@@ -0,0 +1,366 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - empty msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "", int64(0)}
+			},
+			func() {},
+			true,
+			"invalid msg type url",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgTypeURL, int64(-1)}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgTypeURL, int64(1)}
+			},
+			func() {},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - unknown msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "/cosmos.bank.v1beta1.MsgUnknown", int64(0)}
+			},
+			func() {},
+			true,
+			"doesn't exist",
+		},
+		{
+			"fail - msg type disabled for authz",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - generic authorization granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(expiration)
+				s.Require().IsType(&authztypes.GenericAuthorization{}, authorization)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - grant does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgTypeURL}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - grant revoked",
+			func() []interface{} {
+				s.grant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+				return []interface{}{s.keyring.GetAddr(1), sendMsgTypeURL}
+			},
+			func() {
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().Nil(authorization)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+
+	var balanceBefore sdk.Coin
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty msgs",
+			func() []interface{} {
+				return []interface{}{[][]byte{}}
+			},
+			func() {},
+			true,
+			"invalid msgs",
+		},
+		{
+			"fail - invalid msg encoding",
+			func() []interface{} {
+				return []interface{}{[][]byte{{0x1, 0x2}}}
+			},
+			func() {},
+			true,
+			"invalid msg at index 0",
+		},
+		{
+			"fail - msg type disabled for authz",
+			func() []interface{} {
+				msg := &evmtypes.MsgEthereumTx{From: s.keyring.GetAddr(1).Hex()}
+				return []interface{}{[][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - no authorization from the signer",
+			func() []interface{} {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				return []interface{}{[][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - msg executed on behalf of the granter",
+			func() []interface{} {
+				s.grant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL)
+				balanceBefore = s.network.App.BankKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				return []interface{}{[][]byte{s.marshalMsg(msg)}}
+			},
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+				s.Require().Equal(balanceBefore.Add(amount[0]), balance)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExecMirrorsBalanceChanges() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	granter, grantee := s.keyring.GetKey(1), s.keyring.GetKey(0)
+	recipient := utiltx.GenerateAddress()
+	amount := math.NewInt(1000)
+	s.grant(granter.AccAddr, grantee.AccAddr, sendMsgTypeURL)
+
+	msg := banktypes.NewMsgSend(
+		granter.AccAddr,
+		recipient.Bytes(),
+		sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)),
+	)
+	input, err := s.precompile.Pack(authz.ExecMethod, [][]byte{s.marshalMsg(msg)})
+	s.Require().NoError(err)
+
+	contract := vm.NewPrecompile(vm.AccountRef(grantee.Addr), s.precompile, big.NewInt(0), uint64(1e6))
+	contract.Input = input
+	precompileAddr := contract.Address()
+
+	coreMsg, err := s.factory.GenerateGethCoreMsg(grantee.Priv, evmtypes.EvmTxArgs{To: &precompileAddr})
+	s.Require().NoError(err)
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, coreMsg, cfg, nil, stateDB)
+	evm.WithPrecompiles(
+		map[common.Address]vm.PrecompiledContract{precompileAddr: s.precompile},
+		[]common.Address{precompileAddr},
+	)
+
+	// the recipient state object is dirty before the exec, as if it had
+	// received a value transfer earlier in the same transaction
+	stateDB.AddBalance(recipient, big.NewInt(1))
+	granterBalance := stateDB.GetBalance(granter.Addr)
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err)
+
+	expAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+	s.Require().Equal(new(big.Int).Add(expAmount, big.NewInt(1)), stateDB.GetBalance(recipient))
+	s.Require().Equal(new(big.Int).Sub(granterBalance, expAmount), stateDB.GetBalance(granter.Addr))
+
+	// the commit of the stateDB keeps the balances changed by the exec
+	s.Require().NoError(stateDB.Commit())
+	s.Require().Equal(new(big.Int).Add(expAmount, big.NewInt(1)), s.network.App.EvmKeeper.GetBalance(ctx, recipient))
+	s.Require().Equal(new(big.Int).Sub(granterBalance, expAmount), s.network.App.EvmKeeper.GetBalance(ctx, granter.Addr))
+}
+
+// grant stores a generic authorization from the granter to the grantee for the given msg type.
+func (s *PrecompileTestSuite) grant(granter, grantee sdk.AccAddress, msgTypeURL string) {
+	expiration := s.network.GetContext().BlockTime().Add(time.Hour)
+	err := s.network.App.AuthzKeeper.SaveGrant(
+		s.network.GetContext(), grantee, granter, authztypes.NewGenericAuthorization(msgTypeURL), &expiration,
+	)
+	s.Require().NoError(err)
+}
+
+// marshalMsg encodes the message as a protobuf Any.
+func (s *PrecompileTestSuite) marshalMsg(msg sdk.Msg) []byte {
+	bz, err := s.network.App.AppCodec().MarshalInterface(msg)
+	s.Require().NoError(err)
+	return bz
+}
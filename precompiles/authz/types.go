@@ -0,0 +1,314 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/utils"
+)
+
+// EventGrant defines the event data for the Grant transaction.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+	Expiration int64
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive,stylecheck
+}
+
+// GrantData defines the authorization granted by a granter to a grantee
+// as returned by the grant queries.
+type GrantData struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType string
+	MsgTypeUrl        string //nolint:revive,stylecheck
+	Expiration        int64
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+	Pagination query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsOutput defines the output for the grant queries.
+type GrantsOutput struct {
+	Grants       []GrantData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant instance granting a generic authorization
+// from the granter to the grantee. A zero expiration results in a grant without
+// expiration.
+func NewMsgGrant(args []interface{}, granter common.Address) (*authztypes.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	expirationUnix, ok := args[2].(int64)
+	if !ok || expirationUnix < 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[2])
+	}
+
+	var expiration *time.Time
+	if expirationUnix > 0 {
+		t := time.Unix(expirationUnix, 0).UTC()
+		expiration = &t
+	}
+
+	msg, err := authztypes.NewMsgGrant(
+		granter.Bytes(),
+		grantee.Bytes(),
+		authztypes.NewGenericAuthorization(msgTypeURL),
+		expiration,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance revoking the authorization
+// granted by the granter to the grantee for the given message type.
+func NewMsgRevoke(args []interface{}, granter common.Address) (*authztypes.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	msg := authztypes.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	return &msg, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance with the given grantee. The messages
+// are decoded from their protobuf-encoded Any representation using the codec.
+func NewMsgExec(cdc codec.Codec, args []interface{}, grantee common.Address) (*authztypes.MsgExec, []sdk.Msg, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	msgsBz, ok := args[0].([][]byte)
+	if !ok || len(msgsBz) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, args[0])
+	}
+
+	msgs := make([]sdk.Msg, len(msgsBz))
+	for i, bz := range msgsBz {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterface(bz, &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+		msgs[i] = msg
+	}
+
+	msg := authztypes.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, msgs, nil
+}
+
+// ParseGrantsArgs parses the arguments of the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments of the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	return &authztypes.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments of the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	return &authztypes.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the output with the grants from the granter
+// to the grantee of the request.
+func (o *GrantsOutput) FromGrantsResponse(req *authztypes.QueryGrantsRequest, res *authztypes.QueryGrantsResponse) (*GrantsOutput, error) {
+	granter, err := utils.Bech32ToHexAddr(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := utils.Bech32ToHexAddr(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Grants = make([]GrantData, len(res.Grants))
+	for i, grant := range res.Grants {
+		data, err := newGrantData(granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the output with the given grant authorizations.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*authztypes.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := utils.Bech32ToHexAddr(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := utils.Bech32ToHexAddr(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		data, err := newGrantData(granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newGrantData creates the grant data from the authorization and its expiration.
+func newGrantData(granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	if authorizationAny == nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidAuthorization, "")
+	}
+
+	authorization, ok := authorizationAny.GetCachedValue().(authztypes.Authorization)
+	if !ok {
+		return GrantData{}, fmt.Errorf(ErrInvalidAuthorization, authorizationAny.TypeUrl)
+	}
+
+	var expirationUnix int64
+	if expiration != nil {
+		expirationUnix = expiration.Unix()
+	}
+
+	return GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		Expiration:        expirationUnix,
+	}, nil
+}
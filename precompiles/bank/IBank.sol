@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,57 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Input specifies the sender address and the coins sent on a multiSend.
+struct Input {
+    /// addr defines the address of the sender.
+    address addr;
+    /// coins defines the native coins sent.
+    Coin[] coins;
+}
+
+/// @dev Output specifies the recipient address and the coins received on a multiSend.
+struct Output {
+    /// addr defines the address of the recipient.
+    address addr;
+    /// coins defines the native coins received.
+    Coin[] coins;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module
+ * and sending native coins.
  */
 interface IBank {
+    /// @dev Transfer defines an Event emitted when native coins are sent.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param amount the native coins sent
+    event Transfer(address indexed from, address indexed to, Coin[] amount);
+
+    /// @dev send defines a method for sending native coins from the caller
+    /// to the given address. The x/bank send enabled flags and blocked
+    /// addresses are respected.
+    /// @param to the address of the recipient
+    /// @param amount the native coins to send
+    /// @return success true if the send was successful
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller
+    /// to multiple recipients. Only a single input, which must be the caller,
+    /// is supported and its coins must equal the sum of the output coins.
+    /// @param inputs the sender of the coins
+    /// @param outputs the recipients of the coins
+    /// @return success true if the send was successful
+    function multiSend(
+        Input[] calldata inputs,
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
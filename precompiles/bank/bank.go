@@ -3,7 +3,7 @@
 //
 // The bank package contains the implementation of the x/bank module precompile.
 // The precompiles returns all bank's information in the original decimals
-// representation stored in the module.
+// representation stored in the module and allows sending native coins.
 
 package bank
 
@@ -32,6 +32,10 @@ const (
 	GasSupplyOf = 2_477
 )
 
+// txKVGasConfig defines the gas configuration used by the bank transactions,
+// while the queries are charged the flat costs defined above.
+var txKVGasConfig = storetypes.KVGasConfig()
+
 var _ vm.PrecompiledContract = &Precompile{}
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -97,12 +101,15 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case SendMethod, MultiSendMethod:
+		argsBz := input[4:]
+		return txKVGasConfig.WriteCostFlat + (txKVGasConfig.WriteCostPerByte * uint64(len(argsBz)))
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
@@ -113,7 +120,17 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	if p.IsTransaction(method) {
+		ctx = ctx.WithKVGasConfig(txKVGasConfig).
+			WithTransientKVGasConfig(storetypes.TransientGasConfig())
+	}
+
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -143,7 +160,11 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SendMethod, MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
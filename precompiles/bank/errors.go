@@ -0,0 +1,12 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bank
+
+const (
+	// ErrMultipleInputs is raised when a multiSend transaction doesn't define a single input.
+	ErrMultipleInputs = "multiSend supports a single input, got %d"
+	// ErrDifferentInputFromSender is raised when the multiSend input is not the caller.
+	ErrDifferentInputFromSender = "input address %s is not the same as the sender %s"
+	// ErrNoOutputs is raised when a multiSend transaction doesn't define any output.
+	ErrNoOutputs = "multiSend requires at least one output"
+)
@@ -0,0 +1,119 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends the given native coins from the caller to the recipient. The
+// transfer is executed through the x/bank message server, so the send enabled
+// flags and the blocked addresses are respected.
+func (p *Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+
+	msg, recipient, err := NewMsgSend(method, sender, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.setEVMCoinBalanceChangeEntries(sender, []common.Address{recipient}, []sdk.Coins{msg.Amount})
+
+	if err := p.EmitTransferEvent(ctx, stateDB, sender, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends native coins from the caller to multiple recipients. The
+// transfer is executed through the x/bank message server, so the send enabled
+// flags and the blocked addresses are respected.
+func (p *Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+
+	msg, recipients, err := NewMsgMultiSend(method, sender, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.MultiSend(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	amounts := make([]sdk.Coins, len(msg.Outputs))
+	for i, output := range msg.Outputs {
+		amounts[i] = output.Coins
+	}
+
+	p.setEVMCoinBalanceChangeEntries(sender, recipients, amounts)
+
+	for i, recipient := range recipients {
+		if err := p.EmitTransferEvent(ctx, stateDB, sender, recipient, amounts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setEVMCoinBalanceChangeEntries adds the balance changes of the EVM coin sent
+// to the stateDB journal in 18 decimals.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func (p *Precompile) setEVMCoinBalanceChangeEntries(sender common.Address, recipients []common.Address, amounts []sdk.Coins) {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	total := new(big.Int)
+
+	for i, recipient := range recipients {
+		amount := amounts[i].AmountOf(evmDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+		total.Add(total, convertedAmount)
+		p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(recipient, convertedAmount, cmn.Add))
+	}
+
+	if total.Sign() > 0 {
+		p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(sender, total, cmn.Sub))
+	}
+}
@@ -0,0 +1,239 @@
+package bank_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/bank"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v20/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.SendMethod]
+	recipient := evmosutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{recipient}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - empty coins",
+			func() []interface{} {
+				return []interface{}{recipient, []cmn.Coin{}}
+			},
+			false,
+			"empty coins",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{recipient, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(0)}}}
+			},
+			false,
+			"invalid coins",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{recipient, []cmn.Coin{{Denom: s.tokenDenom, Amount: abi.MaxUint256}}}
+			},
+			false,
+			"insufficient funds",
+		},
+		{
+			"fail - blocked recipient",
+			func() []interface{} {
+				blocked := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+				return []interface{}{blocked, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"fail - send disabled",
+			func() []interface{} {
+				s.network.App.BankKeeper.SetSendEnabled(ctx, s.tokenDenom, false)
+				return []interface{}{recipient, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			false,
+			"transfers are currently disabled",
+		},
+		{
+			"pass - send multiple coins",
+			func() []interface{} {
+				return []interface{}{recipient, []cmn.Coin{
+					{Denom: s.tokenDenom, Amount: big.NewInt(100)},
+					{Denom: s.bondDenom, Amount: big.NewInt(200)},
+				}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest()
+			ctx = s.mintAndSendXMPLCoin(ctx, s.keyring.GetAccAddr(0), math.NewInt(100))
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, args)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+
+				balances := s.network.App.BankKeeper.GetAllBalances(ctx, recipient.Bytes())
+				s.Require().Equal(sdk.NewCoins(
+					sdk.NewInt64Coin(s.tokenDenom, 100),
+					sdk.NewInt64Coin(s.bondDenom, 200),
+				), balances)
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[bank.EventTypeTransfer].ID, logs[0].Topics[0])
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	recipient1, recipient2 := evmosutiltx.GenerateAddress(), evmosutiltx.GenerateAddress()
+
+	coins := func(amount int64) []cmn.Coin {
+		return []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(amount)}}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func(sender common.Address) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(common.Address) []interface{} {
+				return []interface{}{[]bank.Input{}}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - multiple inputs",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: coins(1)}, {Addr: sender, Coins: coins(1)}},
+					[]bank.Output{{Addr: recipient1, Coins: coins(2)}},
+				}
+			},
+			false,
+			fmt.Sprintf(bank.ErrMultipleInputs, 2),
+		},
+		{
+			"fail - input different from sender",
+			func(common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: recipient2, Coins: coins(1)}},
+					[]bank.Output{{Addr: recipient1, Coins: coins(1)}},
+				}
+			},
+			false,
+			"is not the same as the sender",
+		},
+		{
+			"fail - no outputs",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: coins(1)}},
+					[]bank.Output{},
+				}
+			},
+			false,
+			bank.ErrNoOutputs,
+		},
+		{
+			"fail - inputs and outputs mismatch",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: coins(3)}},
+					[]bank.Output{{Addr: recipient1, Coins: coins(1)}, {Addr: recipient2, Coins: coins(1)}},
+				}
+			},
+			false,
+			"sum inputs != sum outputs",
+		},
+		{
+			"fail - blocked recipient",
+			func(sender common.Address) []interface{} {
+				blocked := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: coins(2)}},
+					[]bank.Output{{Addr: recipient1, Coins: coins(1)}, {Addr: blocked, Coins: coins(1)}},
+				}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"pass - send to multiple recipients",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: coins(300)}},
+					[]bank.Output{{Addr: recipient1, Coins: coins(100)}, {Addr: recipient2, Coins: coins(200)}},
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest()
+			sender := s.keyring.GetAddr(0)
+			args := tc.malleate(sender)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender, s.precompile, 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, args)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+
+				balance1 := s.network.App.BankKeeper.GetBalance(ctx, recipient1.Bytes(), s.bondDenom)
+				s.Require().Equal(int64(100), balance1.Amount.Int64())
+				balance2 := s.network.App.BankKeeper.GetBalance(ctx, recipient2.Bytes(), s.bondDenom)
+				s.Require().Equal(int64(200), balance2.Amount.Int64())
+
+				s.Require().Len(stateDB.Logs(), 2)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
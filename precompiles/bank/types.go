@@ -4,10 +4,16 @@
 package bank
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
@@ -45,3 +51,124 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// SendInput defines the input arguments of the bank send transaction.
+type SendInput struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// Input defines the sender of a bank multiSend transaction.
+type Input struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// Output defines a recipient of a bank multiSend transaction.
+type Output struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// MultiSendInput defines the input arguments of the bank multiSend transaction.
+type MultiSendInput struct {
+	Inputs  []Input
+	Outputs []Output
+}
+
+// NewMsgSend creates a new bank MsgSend of the given coins from the sender to
+// the recipient defined in the arguments.
+func NewMsgSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgSend, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input SendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SendInput: %s", err)
+	}
+
+	if input.To == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	amount, err := NewCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return banktypes.NewMsgSend(sender.Bytes(), input.To.Bytes(), amount), input.To, nil
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend from the inputs and outputs
+// defined in the arguments. The single input supported by the bank module
+// must be the sender.
+func NewMsgMultiSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgMultiSend, []common.Address, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to MultiSendInput: %s", err)
+	}
+
+	if len(input.Inputs) != 1 {
+		return nil, nil, fmt.Errorf(ErrMultipleInputs, len(input.Inputs))
+	}
+
+	if input.Inputs[0].Addr != sender {
+		return nil, nil, fmt.Errorf(ErrDifferentInputFromSender, input.Inputs[0].Addr, sender)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, nil, errors.New(ErrNoOutputs)
+	}
+
+	inputCoins, err := NewCoins(input.Inputs[0].Coins)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recipients := make([]common.Address, len(input.Outputs))
+	outputs := make([]banktypes.Output, len(input.Outputs))
+	for i, output := range input.Outputs {
+		coins, err := NewCoins(output.Coins)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		recipients[i] = output.Addr
+		outputs[i] = banktypes.NewOutput(output.Addr.Bytes(), coins)
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(sender.Bytes(), inputCoins)},
+		Outputs: outputs,
+	}
+
+	return msg, recipients, nil
+}
+
+// NewCoins converts the coins to the Cosmos SDK representation, returning an
+// error if they are not valid.
+func NewCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	if sdkCoins.Empty() {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, "empty coins")
+	}
+
+	return sdkCoins, nil
+}
@@ -204,6 +204,23 @@ func (p *Precompile) SetBalanceChangeEntries(entries ...balanceChangeEntry) {
 	p.journalEntries = entries
 }
 
+// AddBalanceChangeEntries appends the balanceChange entries
+// to the journalEntries field of the precompile.
+func (p *Precompile) AddBalanceChangeEntries(entries ...balanceChangeEntry) {
+	p.journalEntries = append(p.journalEntries, entries...)
+}
+
+// GetEVMCoinBalance returns the balance of the EVM coin in 18 decimals for the
+// given address as stored in the keeper, without the pending changes of the stateDB.
+// It is used to mirror the balance changes performed by Cosmos SDK messages to the stateDB.
+func GetEVMCoinBalance(ctx sdk.Context, stateDB *statedb.StateDB, addr common.Address) *big.Int {
+	account := stateDB.Keeper().GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
+
 func (p Precompile) Address() common.Address {
 	return p.address
 }
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IERC20Module contract's address.
+address constant ERC20_MODULE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080C;
+
+/// @dev The IERC20Module contract's instance.
+IERC20Module constant ERC20_MODULE_CONTRACT = IERC20Module(ERC20_MODULE_PRECOMPILE_ADDRESS);
+
+/// @dev TokenPair defines the mapping between an ERC20 contract and a Cosmos
+/// coin denomination.
+struct TokenPair {
+    // the address of the ERC20 contract
+    address erc20Address;
+    // the Cosmos coin denomination
+    string denom;
+    // whether the conversions of the token pair are enabled
+    bool enabled;
+    // the owner of the ERC20 contract: 1 for the module account, 2 for an external address
+    uint8 contractOwner;
+}
+
+/// @author Evmos Team
+/// @title ERC20 Module Precompiled Contract
+/// @dev The interface through which solidity contracts convert between the
+/// Cosmos coins and the ERC20 tokens of the token pairs registered in the
+/// erc20 module, and register new token pairs. The caller of the
+/// conversions is the owner of the converted funds.
+/// @custom:address 0x000000000000000000000000000000000000080C
+interface IERC20Module {
+    /// @dev Emitted when Cosmos coins are converted into ERC20 tokens.
+    /// @param sender The address that owned the Cosmos coins
+    /// @param receiver The address that received the ERC20 tokens
+    /// @param erc20Address The address of the ERC20 contract
+    /// @param denom The Cosmos coin denomination
+    /// @param amount The amount converted
+    event ConvertCoin(
+        address indexed sender,
+        address indexed receiver,
+        address indexed erc20Address,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev Emitted when ERC20 tokens are converted into Cosmos coins.
+    /// @param sender The address that owned the ERC20 tokens
+    /// @param receiver The address that received the Cosmos coins
+    /// @param erc20Address The address of the ERC20 contract
+    /// @param denom The Cosmos coin denomination
+    /// @param amount The amount converted
+    event ConvertERC20(
+        address indexed sender,
+        address indexed receiver,
+        address indexed erc20Address,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev Emitted when an ERC20 contract is registered as a token pair.
+    /// @param sender The address that registered the token pair
+    /// @param erc20Address The address of the ERC20 contract
+    /// @param denom The Cosmos coin denomination of the token pair
+    event RegisterERC20(
+        address indexed sender,
+        address indexed erc20Address,
+        string denom
+    );
+
+    /// @dev Converts the Cosmos coins of the caller into the ERC20 tokens of
+    /// a registered ERC20 token pair.
+    /// @param denom The Cosmos coin denomination of the token pair
+    /// @param amount The amount to convert
+    /// @param receiver The address that receives the ERC20 tokens
+    /// @return success Whether or not the conversion was successful
+    function convertCoin(
+        string memory denom,
+        uint256 amount,
+        address receiver
+    ) external returns (bool success);
+
+    /// @dev Converts the ERC20 tokens of the caller into the Cosmos coins of
+    /// a registered ERC20 token pair.
+    /// @param erc20Address The address of the ERC20 contract
+    /// @param amount The amount to convert
+    /// @param receiver The address that receives the Cosmos coins
+    /// @return success Whether or not the conversion was successful
+    function convertERC20(
+        address erc20Address,
+        uint256 amount,
+        address receiver
+    ) external returns (bool success);
+
+    /// @dev Registers the token pair of an ERC20 contract. It is only
+    /// available when permissionless registration is enabled by the erc20
+    /// module parameters.
+    /// @param erc20Address The address of the ERC20 contract
+    /// @return denom The Cosmos coin denomination of the new token pair
+    function registerERC20(
+        address erc20Address
+    ) external returns (string memory denom);
+
+    /// @dev Returns the token pair of the given ERC20 contract address or
+    /// Cosmos coin denomination. The token pair is empty if not registered.
+    /// @param token The hex address of the ERC20 contract or the Cosmos coin denomination
+    /// @return tokenPair The registered token pair
+    function tokenPair(
+        string memory token
+    ) external view returns (TokenPair memory tokenPair);
+
+    /// @dev Returns the registered token pairs.
+    /// @param pageRequest Defines the pagination parameters to for the request.
+    function tokenPairs(
+        PageRequest memory pageRequest
+    )
+        external
+        view
+        returns (
+            TokenPair[] memory tokenPairs,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+const (
+	// ErrInvalidDenom is raised when the coin denomination is invalid.
+	ErrInvalidDenom = "invalid denom: %v"
+	// ErrInvalidERC20Address is raised when the ERC20 contract address is invalid.
+	ErrInvalidERC20Address = "invalid ERC20 contract address: %v"
+	// ErrInvalidReceiver is raised when the receiver address is invalid.
+	ErrInvalidReceiver = "invalid receiver address: %v"
+	// ErrInvalidToken is raised when the token is not a string.
+	ErrInvalidToken = "invalid token: %v"
+	// ErrTokenAccessed is raised when the ERC20 contract was accessed earlier in
+	// the transaction of a conversion.
+	ErrTokenAccessed = "cannot convert ERC20 token %s accessed earlier in the transaction: %v"
+)
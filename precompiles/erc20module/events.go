@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeConvertCoin defines the event type for the erc20 module ConvertCoin transaction.
+	EventTypeConvertCoin = "ConvertCoin"
+	// EventTypeConvertERC20 defines the event type for the erc20 module ConvertERC20 transaction.
+	EventTypeConvertERC20 = "ConvertERC20"
+	// EventTypeRegisterERC20 defines the event type for the erc20 module RegisterERC20 transaction.
+	EventTypeRegisterERC20 = "RegisterERC20"
+)
+
+// EmitConvertEvent creates a new event emitted on a ConvertCoin or
+// ConvertERC20 transaction.
+func (p Precompile) EmitConvertEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	sender, receiver common.Address,
+	pair erc20types.TokenPair,
+	amount *big.Int,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics, err := makeTopics(event, sender, receiver, pair.GetERC20Contract())
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(pair.Denom, amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitRegisterERC20Event creates a new event emitted on a RegisterERC20 transaction.
+func (p Precompile) EmitRegisterERC20Event(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	sender common.Address,
+	pair erc20types.TokenPair,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRegisterERC20]
+	topics, err := makeTopics(event, sender, pair.GetERC20Contract())
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(pair.Denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// makeTopics returns the event topics for the given indexed addresses.
+func makeTopics(event abi.Event, addresses ...common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(addresses)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	for i, address := range addresses {
+		var err error
+		topics[i+1], err = cmn.MakeTopic(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,80 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// TokenPairMethod defines the ABI method name for the erc20 module
+	// TokenPair query.
+	TokenPairMethod = "tokenPair"
+	// TokenPairsMethod defines the ABI method name for the erc20 module
+	// TokenPairs query.
+	TokenPairsMethod = "tokenPairs"
+)
+
+// TokenPair returns the token pair registered for the given ERC20 contract hex
+// address or Cosmos coin denomination. The token pair is empty if not found.
+func (p Precompile) TokenPair(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, token))
+	if !found {
+		return method.Outputs.Pack(TokenPair{})
+	}
+
+	return method.Outputs.Pack(NewTokenPair(pair))
+}
+
+// TokenPairs returns the registered token pairs.
+func (p Precompile) TokenPairs(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var pageRequest PageRequest
+	if err := method.Inputs.Copy(&pageRequest, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PageRequest: %w", err)
+	}
+
+	res, err := p.erc20Keeper.TokenPairs(ctx, &erc20types.QueryTokenPairsRequest{
+		Pagination: &pageRequest.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]TokenPair, len(res.TokenPairs))
+	for i, pair := range res.TokenPairs {
+		pairs[i] = NewTokenPair(pair)
+	}
+
+	return method.Outputs.Pack(pairs, res.Pagination)
+}
@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestTokenPair() {
+	method := s.precompile.Methods[erc20module.TokenPairMethod]
+	contractAddr := s.deployERC20(big.NewInt(100))
+	denom := erc20types.CreateDenom(contractAddr.String())
+
+	s.enablePermissionlessRegistration()
+	ctx := s.network.GetContext()
+	_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(ctx, contractAddr)
+	s.Require().NoError(err)
+
+	expPair := erc20module.TokenPair{
+		Erc20Address:  contractAddr,
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: uint8(erc20types.OWNER_EXTERNAL),
+	}
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expPair     erc20module.TokenPair
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			erc20module.TokenPair{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid token",
+			[]interface{}{contractAddr},
+			erc20module.TokenPair{},
+			true,
+			"invalid token",
+		},
+		{
+			"success - token pair not found",
+			[]interface{}{"unregistered"},
+			erc20module.TokenPair{},
+			false,
+			"",
+		},
+		{
+			"success - token pair by contract address",
+			[]interface{}{contractAddr.Hex()},
+			expPair,
+			false,
+			"",
+		},
+		{
+			"success - token pair by denom",
+			[]interface{}{denom},
+			expPair,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.precompile.TokenPair(ctx, &method, nil, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var out struct {
+					TokenPair erc20module.TokenPair
+				}
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+				s.Require().Equal(tc.expPair, out.TokenPair)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTokenPairs() {
+	method := s.precompile.Methods[erc20module.TokenPairsMethod]
+	ctx := s.network.GetContext()
+	expTotal := uint64(len(s.network.App.Erc20Keeper.GetTokenPairs(ctx)))
+
+	contractAddr := s.deployERC20(big.NewInt(100))
+	s.enablePermissionlessRegistration()
+	ctx = s.network.GetContext()
+	_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(ctx, contractAddr)
+	s.Require().NoError(err)
+	expTotal++
+
+	bz, err := s.precompile.TokenPairs(ctx, &method, nil, []interface{}{
+		query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	var out struct {
+		TokenPairs   []erc20module.TokenPair
+		PageResponse query.PageResponse
+	}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+	s.Require().Equal(expTotal, out.PageResponse.Total)
+	s.Require().Len(out.TokenPairs, int(expTotal))
+	s.Require().Contains(out.TokenPairs, erc20module.TokenPair{
+		Erc20Address:  contractAddr,
+		Denom:         erc20types.CreateDenom(contractAddr.String()),
+		Enabled:       true,
+		ContractOwner: uint8(erc20types.OWNER_EXTERNAL),
+	})
+
+	_, err = s.precompile.TokenPairs(ctx, &method, nil, []interface{}{})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+}
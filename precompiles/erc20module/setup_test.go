@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	factory factory.TxFactory
+	keyring testkeyring.Keyring
+
+	precompile *erc20module.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	var err error
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.network = nw
+	s.factory = factory.New(nw, grpc.NewIntegrationHandler(nw))
+	s.keyring = keyring
+
+	if s.precompile, err = erc20module.NewPrecompile(
+		s.network.App.Erc20Keeper,
+	); err != nil {
+		panic(err)
+	}
+}
+
+// deployERC20 deploys an ERC20 contract and mints the given amount of tokens
+// to the first keyring account.
+func (s *PrecompileTestSuite) deployERC20(amount *big.Int) common.Address {
+	deployer := s.keyring.GetKey(0)
+	contractAddr, err := s.factory.DeployContract(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{},
+		factory.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"coin", "token", uint8(6)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	_, err = s.factory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		factory.CallArgs{
+			ContractABI: contracts.ERC20MinterBurnerDecimalsContract.ABI,
+			MethodName:  "mint",
+			Args:        []interface{}{deployer.Addr, amount},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	return contractAddr
+}
+
+// enablePermissionlessRegistration enables the permissionless registration of
+// token pairs on the erc20 module parameters.
+func (s *PrecompileTestSuite) enablePermissionlessRegistration() {
+	ctx := s.network.GetContext()
+	params := s.network.App.Erc20Keeper.GetParams(ctx)
+	params.PermissionlessRegistration = true
+	s.Require().NoError(s.network.App.Erc20Keeper.SetParams(ctx, params))
+}
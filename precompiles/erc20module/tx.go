@@ -0,0 +1,186 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+const (
+	// ConvertCoinMethod defines the ABI method name for the erc20 module
+	// ConvertCoin transaction.
+	ConvertCoinMethod = "convertCoin"
+	// ConvertERC20Method defines the ABI method name for the erc20 module
+	// ConvertERC20 transaction.
+	ConvertERC20Method = "convertERC20"
+	// RegisterERC20Method defines the ABI method name for the erc20 module
+	// RegisterERC20 transaction.
+	RegisterERC20Method = "registerERC20"
+)
+
+// ConvertCoin implements the convertCoin precompile transaction, which converts
+// the Cosmos coins of the caller into the ERC20 tokens of a native ERC20 token
+// pair. It fails if the ERC20 contract was accessed earlier in the transaction.
+func (p Precompile) ConvertCoin(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf(ErrInvalidDenom, args[0])
+	}
+
+	amount, receiver, err := parseAmountAndReceiver(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	sender := contract.CallerAddress
+	pair, err := p.erc20Keeper.MintingEnabled(ctx, sender.Bytes(), receiver.Bytes(), denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pair.IsNativeERC20() {
+		return nil, erc20types.ErrNativeConversionDisabled
+	}
+
+	if err := readTokenFromCacheContext(stateDB, pair.GetERC20Contract()); err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.ConvertCoinNativeERC20(
+		ctx, pair, math.NewIntFromBigInt(amount), receiver, sender.Bytes(),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitConvertEvent(
+		ctx, stateDB, EventTypeConvertCoin, sender, receiver, pair, amount,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ConvertERC20 implements the convertERC20 precompile transaction, which
+// converts the ERC20 tokens of the caller into the Cosmos coins of a native
+// ERC20 token pair. It fails if the ERC20 contract was accessed earlier in the
+// transaction.
+func (p Precompile) ConvertERC20(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB *statedb.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	erc20Address, ok := args[0].(common.Address)
+	if !ok || erc20Address == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidERC20Address, args[0])
+	}
+
+	amount, receiver, err := parseAmountAndReceiver(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	sender := contract.CallerAddress
+	msg := erc20types.NewMsgConvertERC20(
+		math.NewIntFromBigInt(amount),
+		sdk.AccAddress(receiver.Bytes()),
+		erc20Address,
+		sender,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := readTokenFromCacheContext(stateDB, erc20Address); err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.ConvertERC20(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// the token pair of a selfdestructed contract is deleted without conversion
+		return method.Outputs.Pack(false)
+	}
+
+	pair, _ := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetERC20Map(ctx, erc20Address))
+
+	if err := p.EmitConvertEvent(
+		ctx, stateDB, EventTypeConvertERC20, sender, receiver, pair, amount,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RegisterERC20 implements the registerERC20 precompile transaction, which
+// registers the token pair of an ERC20 contract on behalf of the caller when
+// permissionless registration is enabled.
+func (p Precompile) RegisterERC20(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	erc20Address, ok := args[0].(common.Address)
+	if !ok || erc20Address == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidERC20Address, args[0])
+	}
+
+	pair, err := p.erc20Keeper.RegisterERC20Permissionless(ctx, erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRegisterERC20Event(ctx, stateDB, contract.CallerAddress, *pair); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(pair.Denom)
+}
+
+// readTokenFromCacheContext makes the changes to the ERC20 contract storage
+// done by the conversion through a nested EVM call visible to the rest of the
+// transaction. The conversion is refused if the contract state was already
+// loaded, as it would be stale and overwrite the conversion on commit.
+func readTokenFromCacheContext(stateDB *statedb.StateDB, token common.Address) error {
+	if err := stateDB.ReadFromCacheContext(token); err != nil {
+		return fmt.Errorf(ErrTokenAccessed, token, err)
+	}
+	return nil
+}
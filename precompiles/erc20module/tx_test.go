@@ -0,0 +1,412 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/contracts"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterERC20() {
+	method := s.precompile.Methods[erc20module.RegisterERC20Method]
+	var contractAddr common.Address
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - zero contract address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			true,
+			"invalid ERC20 contract address",
+		},
+		{
+			"fail - permissionless registration disabled",
+			func() []interface{} {
+				return []interface{}{contractAddr}
+			},
+			true,
+			erc20types.ErrPermissionlessDisabled.Error(),
+		},
+		{
+			"fail - token pair already registered",
+			func() []interface{} {
+				s.enablePermissionlessRegistration()
+				_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(s.network.GetContext(), contractAddr)
+				s.Require().NoError(err)
+				return []interface{}{contractAddr}
+			},
+			true,
+			"token ERC20 contract already registered",
+		},
+		{
+			"success - token pair registered",
+			func() []interface{} {
+				s.enablePermissionlessRegistration()
+				return []interface{}{contractAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr = s.deployERC20(big.NewInt(100))
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.RegisterERC20(ctx, &method, s.network.GetStateDB(), contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(erc20types.CreateDenom(contractAddr.String()), out[0])
+
+				pair, found := s.network.App.Erc20Keeper.GetTokenPair(
+					ctx, s.network.App.Erc20Keeper.GetERC20Map(ctx, contractAddr),
+				)
+				s.Require().True(found)
+				s.Require().True(pair.Enabled)
+				s.Require().Equal(erc20types.OWNER_EXTERNAL, pair.ContractOwner)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertERC20() {
+	method := s.precompile.Methods[erc20module.ConvertERC20Method]
+	var contractAddr common.Address
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid contract address",
+			func() []interface{} {
+				return []interface{}{"", big.NewInt(10), s.keyring.GetAddr(1)}
+			},
+			true,
+			"invalid ERC20 contract address",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{contractAddr, big.NewInt(0), s.keyring.GetAddr(1)}
+			},
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - zero receiver address",
+			func() []interface{} {
+				return []interface{}{contractAddr, big.NewInt(10), common.Address{}}
+			},
+			true,
+			"invalid receiver address",
+		},
+		{
+			"fail - token pair not registered",
+			func() []interface{} {
+				return []interface{}{contractAddr, big.NewInt(10), s.keyring.GetAddr(1)}
+			},
+			true,
+			"not registered",
+		},
+		{
+			"success - tokens converted to the receiver",
+			func() []interface{} {
+				s.enablePermissionlessRegistration()
+				_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(s.network.GetContext(), contractAddr)
+				s.Require().NoError(err)
+				return []interface{}{contractAddr, big.NewInt(10), s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr = s.deployERC20(big.NewInt(100))
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.ConvertERC20(ctx, &method, s.network.GetStateDB(), contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+
+				balance := s.network.App.Erc20Keeper.BalanceOf(
+					ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, contractAddr, s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(big.NewInt(90), balance)
+
+				coin := s.network.App.BankKeeper.GetBalance(
+					ctx, s.keyring.GetAccAddr(1), erc20types.CreateDenom(contractAddr.String()),
+				)
+				s.Require().Equal(math.NewInt(10), coin.Amount)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertCoin() {
+	method := s.precompile.Methods[erc20module.ConvertCoinMethod]
+	var (
+		contractAddr common.Address
+		denom        string
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty denom",
+			func() []interface{} {
+				return []interface{}{"", big.NewInt(10), s.keyring.GetAddr(0)}
+			},
+			true,
+			"invalid denom",
+		},
+		{
+			"fail - invalid amount",
+			func() []interface{} {
+				return []interface{}{denom, "10", s.keyring.GetAddr(0)}
+			},
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - token pair not registered",
+			func() []interface{} {
+				return []interface{}{"unregistered", big.NewInt(10), s.keyring.GetAddr(0)}
+			},
+			true,
+			"not registered",
+		},
+		{
+			"fail - insufficient coins",
+			func() []interface{} {
+				return []interface{}{denom, big.NewInt(50), s.keyring.GetAddr(0)}
+			},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - coins converted to the receiver",
+			func() []interface{} {
+				return []interface{}{denom, big.NewInt(10), s.keyring.GetAddr(0)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr = s.deployERC20(big.NewInt(100))
+			denom = erc20types.CreateDenom(contractAddr.String())
+
+			// convert some tokens into coins held by the caller
+			s.enablePermissionlessRegistration()
+			ctx := s.network.GetContext()
+			_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(ctx, contractAddr)
+			s.Require().NoError(err)
+			_, err = s.network.App.Erc20Keeper.ConvertERC20(ctx, erc20types.NewMsgConvertERC20(
+				math.NewInt(30), s.keyring.GetAccAddr(1), contractAddr, s.keyring.GetAddr(0),
+			))
+			s.Require().NoError(err)
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(1),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.ConvertCoin(ctx, &method, s.network.GetStateDB(), contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+
+				balance := s.network.App.Erc20Keeper.BalanceOf(
+					ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, contractAddr, s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(big.NewInt(80), balance)
+
+				coin := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(1), denom)
+				s.Require().Equal(math.NewInt(20), coin.Amount)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertERC20InTransaction() {
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	var sender, receiver testkeyring.Key
+
+	testCases := []struct {
+		name        string
+		malleate    func(evm *vm.EVM, token common.Address)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - token accessed earlier in the transaction",
+			func(evm *vm.EVM, token common.Address) {
+				s.callERC20(evm, sender.Addr, token, "transfer", receiver.Addr, big.NewInt(5))
+			},
+			true,
+			"accessed earlier in the transaction",
+		},
+		{
+			"success - token transferred after the conversion",
+			func(*vm.EVM, common.Address) {},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender, receiver = s.keyring.GetKey(0), s.keyring.GetKey(1)
+			token := s.deployERC20(big.NewInt(100))
+			s.enablePermissionlessRegistration()
+			_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(s.network.GetContext(), token)
+			s.Require().NoError(err)
+			ctx := s.network.GetContext()
+
+			input, err := s.precompile.Pack(erc20module.ConvertERC20Method, token, big.NewInt(10), receiver.Addr)
+			s.Require().NoError(err)
+			contract := vm.NewPrecompile(vm.AccountRef(sender.Addr), s.precompile, big.NewInt(0), uint64(1e7))
+			contract.Input = input
+			precompileAddr := contract.Address()
+
+			coreMsg, err := s.factory.GenerateGethCoreMsg(sender.Priv, evmtypes.EvmTxArgs{To: &precompileAddr})
+			s.Require().NoError(err)
+			cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err)
+
+			stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.network.App.EvmKeeper.NewEVM(ctx, coreMsg, cfg, nil, stateDB)
+			evm.WithPrecompiles(
+				map[common.Address]vm.PrecompiledContract{precompileAddr: s.precompile},
+				[]common.Address{precompileAddr},
+			)
+
+			tc.malleate(evm, token)
+
+			_, err = s.precompile.Run(evm, contract, false)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the conversion is visible to the token calls in the same transaction
+			s.Require().Equal(big.NewInt(90), s.callERC20(evm, sender.Addr, token, "balanceOf", sender.Addr)[0])
+			s.callERC20(evm, sender.Addr, token, "transfer", receiver.Addr, big.NewInt(5))
+
+			// the commit of the stateDB keeps the balances changed by the conversion
+			s.Require().NoError(stateDB.Commit())
+			s.Require().Equal(big.NewInt(85), s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, token, sender.Addr))
+			s.Require().Equal(big.NewInt(5), s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, token, receiver.Addr))
+			s.Require().Equal(big.NewInt(10), s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, token, erc20types.ModuleAddress))
+
+			coin := s.network.App.BankKeeper.GetBalance(ctx, receiver.AccAddr, erc20types.CreateDenom(token.String()))
+			s.Require().Equal(math.NewInt(10), coin.Amount)
+		})
+	}
+}
+
+// callERC20 calls the given ERC20 method through the EVM and returns the
+// unpacked outputs.
+func (s *PrecompileTestSuite) callERC20(evm *vm.EVM, caller, token common.Address, method string, args ...interface{}) []interface{} {
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	input, err := erc20ABI.Pack(method, args...)
+	s.Require().NoError(err)
+
+	ret, _, err := evm.Call(vm.AccountRef(caller), token, input, uint64(1e6), big.NewInt(0))
+	s.Require().NoError(err)
+
+	out, err := erc20ABI.Unpack(method, ret)
+	s.Require().NoError(err)
+	return out
+}
@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// TokenPair defines the ABI representation of an erc20 module token pair.
+type TokenPair struct {
+	Erc20Address  common.Address
+	Denom         string
+	Enabled       bool
+	ContractOwner uint8
+}
+
+// NewTokenPair converts the given erc20 module token pair to its ABI
+// representation.
+func NewTokenPair(pair erc20types.TokenPair) TokenPair {
+	return TokenPair{
+		Erc20Address:  pair.GetERC20Contract(),
+		Denom:         pair.Denom,
+		Enabled:       pair.Enabled,
+		ContractOwner: uint8(pair.ContractOwner), //nolint:gosec // G115 -- owner enum is a small value
+	}
+}
+
+// PageRequest defines the data for the page request.
+type PageRequest struct {
+	PageRequest query.PageRequest
+}
+
+// parseAmountAndReceiver parses the amount and receiver arguments of the
+// ConvertCoin and ConvertERC20 transactions.
+func parseAmountAndReceiver(amountArg, receiverArg interface{}) (*big.Int, common.Address, error) {
+	amount, ok := amountArg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, amountArg)
+	}
+
+	receiver, ok := receiverArg.(common.Address)
+	if !ok || receiver == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, receiverArg)
+	}
+
+	return amount, receiver, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080A;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev BasicAllowance is an allowance with a one-time spend limit and an
+/// optional expiration.
+struct BasicAllowance {
+    // the maximum amount of fees that can be paid, empty for no limit
+    Coin[] spendLimit;
+    // the expiration of the allowance as a unix timestamp, zero if it never expires
+    int64 expiration;
+}
+
+/// @dev PeriodicAllowance extends a BasicAllowance with a spend limit that
+/// is reset after each period.
+struct PeriodicAllowance {
+    // the basic allowance that applies for the whole life of the allowance
+    BasicAllowance basic;
+    // the duration of each period in seconds
+    int64 period;
+    // the maximum amount of fees that can be paid within each period
+    Coin[] periodSpendLimit;
+    // the amount of fees left to be paid in the current period
+    Coin[] periodCanSpend;
+    // the unix timestamp at which the current period ends
+    int64 periodReset;
+}
+
+/// @dev AllowanceData represents a fee allowance granted by a granter to a grantee.
+/// Basic allowances only populate the basic field of the allowance.
+struct AllowanceData {
+    address granter;
+    address grantee;
+    string allowanceType;
+    PeriodicAllowance allowance;
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts grant fee allowances to
+/// pay the fees of Cosmos transactions on behalf of their grantees.
+/// The caller of the transactions is the granter of the allowances.
+/// @custom:address 0x000000000000000000000000000000000000080A
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param allowanceType The type URL of the granted allowance
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        string allowanceType
+    );
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a basic fee allowance from the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param allowance The basic allowance to grant
+    /// @return success Whether or not the grant was successful
+    function grantAllowance(
+        address grantee,
+        BasicAllowance calldata allowance
+    ) external returns (bool success);
+
+    /// @dev Grants a periodic fee allowance from the caller to the grantee.
+    /// The periodCanSpend and periodReset fields are ignored and set to the
+    /// period spend limit and the end of the first period respectively.
+    /// @param grantee The address of the grantee
+    /// @param allowance The periodic allowance to grant
+    /// @return success Whether or not the grant was successful
+    function grantPeriodicAllowance(
+        address grantee,
+        PeriodicAllowance calldata allowance
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance granted by the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @return success Whether or not the revocation was successful
+    function revokeAllowance(address grantee) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Returns the fee allowance granted by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev Returns the fee allowances granted to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances granted to the grantee
+    /// @return pageResponse The pagination information
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            AllowanceData[] memory allowances,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the fee allowances granted by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances granted by the granter
+    /// @return pageResponse The pagination information
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            AllowanceData[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidExpiration is raised when the expiration of the allowance is not valid.
+	ErrInvalidExpiration = "invalid expiration: %d"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not valid.
+	ErrInvalidPeriod = "invalid period: %d"
+	// ErrInvalidAllowance is raised when an allowance cannot be decoded.
+	ErrInvalidAllowance = "invalid allowance type %s"
+)
@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant GrantAllowance
+	// and GrantPeriodicAllowance transactions.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+)
+
+// EmitGrantAllowanceEvent creates a new event emitted on a GrantAllowance or
+// GrantPeriodicAllowance transaction.
+func (p Precompile) EmitGrantAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	allowanceType string,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeGrantAllowance]
+	topics, err := makeTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(allowanceType)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitRevokeAllowanceEvent creates a new event emitted on a RevokeAllowance transaction.
+func (p Precompile) EmitRevokeAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRevokeAllowance]
+	topics, err := makeTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        []byte{},
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// makeTopics returns the event topics for the granter and the grantee.
+func makeTopics(event abi.Event, granter, grantee common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant
+	// AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance returns the fee allowance granted by the granter to the grantee.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewAllowanceData(p.cdc, res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances returns the fee allowances received by the grantee.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromResponse(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
+
+// AllowancesByGranter returns the fee allowances given by the granter.
+func (p Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesByGranterArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromResponse(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
@@ -0,0 +1,165 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	"fmt"
+	"time"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(allowance feegrant.AllowanceData)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				expiration := s.network.GetContext().BlockTime().Add(time.Hour)
+				s.grantAllowance(&feegranttypes.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom(), 100)),
+					Expiration: &expiration,
+				})
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", allowance.AllowanceType)
+				s.Require().Len(allowance.Allowance.Basic.SpendLimit, 1)
+				s.Require().Equal(int64(100), allowance.Allowance.Basic.SpendLimit[0].Amount.Int64())
+				s.Require().Positive(allowance.Allowance.Basic.Expiration)
+				s.Require().Zero(allowance.Allowance.Period)
+				s.Require().Empty(allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+		{
+			"success - allowed msg allowance wrapping a periodic allowance",
+			func() []interface{} {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom(), 100))
+				allowance, err := feegranttypes.NewAllowedMsgAllowance(&feegranttypes.PeriodicAllowance{
+					Period:           time.Hour,
+					PeriodSpendLimit: coins,
+					PeriodCanSpend:   coins,
+					PeriodReset:      s.network.GetContext().BlockTime().Add(time.Hour),
+				}, []string{"/cosmos.bank.v1beta1.MsgSend"})
+				s.Require().NoError(err)
+				s.grantAllowance(allowance)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal("/cosmos.feegrant.v1beta1.AllowedMsgAllowance", allowance.AllowanceType)
+				s.Require().Equal(int64(3600), allowance.Allowance.Period)
+				s.Require().Len(allowance.Allowance.PeriodSpendLimit, 1)
+				s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out feegrant.AllowanceOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+			s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+			s.Require().Equal(s.keyring.GetAddr(1), out.Allowance.Grantee)
+			tc.postCheck(out.Allowance)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesAndAllowancesByGranter() {
+	s.SetupTest()
+	s.grantAllowance(&feegranttypes.BasicAllowance{})
+
+	testCases := []struct {
+		name    string
+		method  string
+		account common.Address
+	}{
+		{"allowances", feegrant.AllowancesMethod, s.keyring.GetAddr(1)},
+		{"allowances by granter", feegrant.AllowancesByGranterMethod, s.keyring.GetAddr(0)},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+			args := []interface{}{tc.account, query.PageRequest{CountTotal: true}}
+
+			var (
+				bz  []byte
+				err error
+			)
+			if tc.method == feegrant.AllowancesMethod {
+				bz, err = s.precompile.Allowances(ctx, &method, contract, args)
+			} else {
+				bz, err = s.precompile.AllowancesByGranter(ctx, &method, contract, args)
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.method, bz))
+			s.Require().Len(out.Allowances, 1)
+			s.Require().Equal(uint64(1), out.PageResponse.Total)
+			s.Require().Equal(s.keyring.GetAddr(0), out.Allowances[0].Granter)
+			s.Require().Equal(s.keyring.GetAddr(1), out.Allowances[0].Grantee)
+			s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", out.Allowances[0].AllowanceType)
+		})
+	}
+}
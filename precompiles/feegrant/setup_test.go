@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *feegrant.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	var err error
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.network = nw
+	s.keyring = keyring
+
+	if s.precompile, err = feegrant.NewPrecompile(
+		s.network.App.FeeGrantKeeper,
+		s.network.App.AppCodec(),
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	feegranttypes "cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant
+	// GrantPeriodicAllowance transaction.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance grants a basic fee allowance from the caller to the grantee.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrantAllowance(method, args, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, stateDB, method, msg, granter, grantee)
+}
+
+// GrantPeriodicAllowance grants a periodic fee allowance from the caller to the
+// grantee. The first period starts at the current block time.
+func (p Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrantPeriodicAllowance(method, args, granter, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, stateDB, method, msg, granter, grantee)
+}
+
+// RevokeAllowance revokes the fee allowance granted by the caller to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevokeAllowance(args, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance executes the given grant allowance message through the
+// x/feegrant message server and emits the corresponding event.
+func (p Precompile) grantAllowance(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegranttypes.MsgGrantAllowance,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee, msg.Allowance.TypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,319 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, feegrant.BasicAllowance{}}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{Expiration: -1}}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{Expiration: 1}}
+			},
+			func() {},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - invalid spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{
+					SpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(0)}},
+				}}
+			},
+			func() {},
+			true,
+			"invalid coins",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantAllowance(&feegranttypes.BasicAllowance{})
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{}}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - unlimited allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{}}
+			},
+			func() {
+				allowance := s.getAllowance()
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Nil(basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - allowance with spend limit and expiration",
+			func() []interface{} {
+				expiration := s.network.GetContext().BlockTime().Add(time.Hour).Unix()
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{
+					SpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(1e18)}},
+					Expiration: expiration,
+				}}
+			},
+			func() {
+				allowance := s.getAllowance()
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom(), 1e18)), basic.SpendLimit)
+				s.Require().NotNil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.GrantAllowance(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - zero period",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.PeriodicAllowance{
+					PeriodSpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(100)}},
+				}}
+			},
+			func() {},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - period spend limit with different denom than spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.PeriodicAllowance{
+					Basic: feegrant.BasicAllowance{
+						SpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(100)}},
+					},
+					Period:           3600,
+					PeriodSpendLimit: []cmn.Coin{{Denom: "other", Amount: big.NewInt(100)}},
+				}}
+			},
+			func() {},
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.PeriodicAllowance{
+					Basic: feegrant.BasicAllowance{
+						SpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(1000)}},
+					},
+					Period:           3600,
+					PeriodSpendLimit: []cmn.Coin{{Denom: s.bondDenom(), Amount: big.NewInt(100)}},
+				}}
+			},
+			func() {
+				allowance := s.getAllowance()
+				periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom(), 100)), periodic.PeriodSpendLimit)
+				s.Require().Equal(periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+				s.Require().Equal(s.network.GetContext().BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.GrantPeriodicAllowance(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - allowance does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func() []interface{} {
+				s.grantAllowance(&feegranttypes.BasicAllowance{})
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(
+					s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+				)
+				s.Require().Error(err)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grantAllowance grants the given allowance from the first to the second keyring account.
+func (s *PrecompileTestSuite) grantAllowance(allowance feegranttypes.FeeAllowanceI) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), allowance,
+	)
+	s.Require().NoError(err)
+}
+
+// getAllowance returns the allowance granted by the first to the second keyring account.
+func (s *PrecompileTestSuite) getAllowance() feegranttypes.FeeAllowanceI {
+	allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(
+		s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+	)
+	s.Require().NoError(err)
+	return allowance
+}
+
+func (s *PrecompileTestSuite) bondDenom() string {
+	bondDenom, err := s.network.App.StakingKeeper.BondDenom(s.network.GetContext())
+	s.Require().NoError(err)
+	return bondDenom
+}
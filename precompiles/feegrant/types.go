@@ -0,0 +1,400 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/utils"
+)
+
+// EventGrantAllowance defines the event data for the GrantAllowance and
+// GrantPeriodicAllowance transactions.
+type EventGrantAllowance struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// BasicAllowance defines the ABI representation of a basic fee allowance.
+type BasicAllowance struct {
+	SpendLimit []cmn.Coin
+	Expiration int64
+}
+
+// PeriodicAllowance defines the ABI representation of a periodic fee allowance.
+type PeriodicAllowance struct {
+	Basic            BasicAllowance
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+}
+
+// AllowanceData defines a fee allowance granted by a granter to a grantee as
+// returned by the allowance queries.
+type AllowanceData struct {
+	Granter         common.Address
+	Grantee         common.Address
+	AllowanceType   string
+	Allowance       PeriodicAllowance
+	AllowedMessages []string
+}
+
+// GrantAllowanceInput defines the input for the GrantAllowance transaction.
+type GrantAllowanceInput struct {
+	Grantee   common.Address
+	Allowance BasicAllowance
+}
+
+// GrantPeriodicAllowanceInput defines the input for the GrantPeriodicAllowance transaction.
+type GrantPeriodicAllowanceInput struct {
+	Grantee   common.Address
+	Allowance PeriodicAllowance
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance AllowanceData
+}
+
+// AllowancesOutput defines the output for the Allowances and AllowancesByGranter queries.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance granting a
+// basic allowance from the granter to the grantee.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}, granter common.Address) (*feegranttypes.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	basic, err := input.Allowance.toSDKType()
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := feegranttypes.NewMsgGrantAllowance(basic, granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance instance granting a
+// periodic allowance from the granter to the grantee. The amount that can be spent
+// in the first period is the period spend limit and the period ends at the
+// given block time plus the period duration.
+func NewMsgGrantPeriodicAllowance(
+	method *abi.Method,
+	args []interface{},
+	granter common.Address,
+	blockTime time.Time,
+) (*feegranttypes.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GrantPeriodicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantPeriodicAllowanceInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	basic, err := input.Allowance.Basic.toSDKType()
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if input.Allowance.Period <= 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Allowance.Period)
+	}
+
+	periodSpendLimit, err := newSpendLimit(input.Allowance.PeriodSpendLimit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	period := time.Duration(input.Allowance.Period) * time.Second
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period),
+	}
+
+	msg, err := feegranttypes.NewMsgGrantAllowance(periodic, granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance revoking the
+// allowance granted by the granter to the grantee.
+func NewMsgRevokeAllowance(args []interface{}, granter common.Address) (*feegranttypes.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msg := feegranttypes.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments of the Allowance query.
+func ParseAllowanceArgs(args []interface{}) (*feegranttypes.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &feegranttypes.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments of the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}) (*feegranttypes.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	return &feegranttypes.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments of the AllowancesByGranter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}) (*feegranttypes.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	return &feegranttypes.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the output with the given grants.
+func (o *AllowancesOutput) FromResponse(cdc codec.Codec, grants []*feegranttypes.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(grants))
+	for i, grant := range grants {
+		data, err := NewAllowanceData(cdc, grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = data
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewAllowanceData creates the ABI representation of the given fee allowance grant.
+// Allowed msg allowances are represented by the allowance they wrap and the list
+// of allowed messages.
+func NewAllowanceData(cdc codec.Codec, grant *feegranttypes.Grant) (AllowanceData, error) {
+	granter, err := utils.Bech32ToHexAddr(grant.Granter)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	grantee, err := utils.Bech32ToHexAddr(grant.Grantee)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	if grant.Allowance == nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidAllowance, "")
+	}
+
+	data := AllowanceData{
+		Granter:         granter,
+		Grantee:         grantee,
+		AllowanceType:   grant.Allowance.TypeUrl,
+		AllowedMessages: []string{},
+	}
+
+	allowanceAny := grant.Allowance
+	var allowance feegranttypes.FeeAllowanceI
+	if err := cdc.UnpackAny(allowanceAny, &allowance); err != nil {
+		return AllowanceData{}, errorsmod.Wrapf(err, ErrInvalidAllowance, allowanceAny.TypeUrl)
+	}
+
+	if allowed, ok := allowance.(*feegranttypes.AllowedMsgAllowance); ok {
+		data.AllowedMessages = allowed.AllowedMessages
+		if err := cdc.UnpackAny(allowed.Allowance, &allowance); err != nil {
+			return AllowanceData{}, errorsmod.Wrapf(err, ErrInvalidAllowance, allowed.Allowance.TypeUrl)
+		}
+	}
+
+	switch a := allowance.(type) {
+	case *feegranttypes.BasicAllowance:
+		data.Allowance = PeriodicAllowance{
+			Basic:            newBasicAllowance(a),
+			PeriodSpendLimit: []cmn.Coin{},
+			PeriodCanSpend:   []cmn.Coin{},
+		}
+	case *feegranttypes.PeriodicAllowance:
+		data.Allowance = PeriodicAllowance{
+			Basic:            newBasicAllowance(&a.Basic),
+			Period:           int64(a.Period.Seconds()),
+			PeriodSpendLimit: cmn.NewCoinsResponse(a.PeriodSpendLimit),
+			PeriodCanSpend:   cmn.NewCoinsResponse(a.PeriodCanSpend),
+			PeriodReset:      a.PeriodReset.Unix(),
+		}
+	default:
+		return AllowanceData{}, fmt.Errorf(ErrInvalidAllowance, allowanceAny.TypeUrl)
+	}
+
+	return data, nil
+}
+
+// toSDKType converts the basic allowance to its Cosmos SDK representation.
+// An empty spend limit results in an allowance without spend limit and a
+// zero expiration in an allowance that never expires.
+func (a BasicAllowance) toSDKType() (*feegranttypes.BasicAllowance, error) {
+	spendLimit, err := newSpendLimit(a.SpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, a.Expiration)
+	}
+
+	var expiration *time.Time
+	if a.Expiration > 0 {
+		t := time.Unix(a.Expiration, 0).UTC()
+		expiration = &t
+	}
+
+	return &feegranttypes.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}, nil
+}
+
+// newBasicAllowance creates the ABI representation of the basic allowance.
+func newBasicAllowance(a *feegranttypes.BasicAllowance) BasicAllowance {
+	var expiration int64
+	if a.Expiration != nil {
+		expiration = a.Expiration.Unix()
+	}
+
+	return BasicAllowance{
+		SpendLimit: cmn.NewCoinsResponse(a.SpendLimit),
+		Expiration: expiration,
+	}
+}
+
+// newSpendLimit converts the given coins to a spend limit. It returns nil
+// if no coins are provided.
+func newSpendLimit(coins []cmn.Coin) (sdk.Coins, error) {
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	spendLimit := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		spendLimit[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	spendLimit = spendLimit.Sort()
+	if err := spendLimit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	return spendLimit, nil
+}
@@ -110,8 +110,65 @@ interface IGov {
         WeightedVoteOption[] options
     );
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the submitted proposal
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is made to a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the id of the proposal
+    /// @param amount the coins deposited
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the canceled proposal
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
     /// TRANSACTIONS
 
+    /// @dev submitProposal defines a method to submit a governance proposal.
+    /// @param proposer The address of the proposer
+    /// @param messages The JSON-encoded messages to execute if the proposal passes,
+    /// including their '@type' field (e.g. '/cosmos.distribution.v1beta1.MsgCommunityPoolSpend')
+    /// @param initialDeposit The initial deposit of the proposal
+    /// @param metadata The metadata of the proposal
+    /// @param title The title of the proposal
+    /// @param summary The summary of the proposal
+    /// @param expedited Whether the proposal is expedited or not
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        string[] calldata messages,
+        Coin[] calldata initialDeposit,
+        string memory metadata,
+        string memory title,
+        string memory summary,
+        bool expedited
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit on a specific proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The proposal id
+    /// @param amount The coins to deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal before
+    /// the voting period ends.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The proposal id
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
     /// @dev vote defines a method to add a vote on a specific proposal.
     /// @param voter The address of the voter
     /// @param proposalId the proposal of id
@@ -25,4 +25,12 @@ const (
 	ErrInvalidWeightedVoteOptionWeight = "invalid weighted vote option weight %s "
 	// ErrInvalidDepositor invalid depositor.
 	ErrInvalidDepositor = "invalid depositor %s "
+	// ErrInvalidProposer invalid proposer.
+	ErrInvalidProposer = "invalid proposer %s "
+	// ErrInvalidProposalMessage is raised when a proposal message cannot be decoded from its JSON representation.
+	ErrInvalidProposalMessage = "invalid proposal message at index %d: %s"
+	// ErrDifferentOriginFromProposer is raised when the origin address is not the same as the proposer address.
+	ErrDifferentOriginFromProposer = "tx origin address %s does not match the proposer address %s"
+	// ErrDifferentOriginFromDepositor is raised when the origin address is not the same as the depositor address.
+	ErrDifferentOriginFromDepositor = "tx origin address %s does not match the depositor address %s"
 )
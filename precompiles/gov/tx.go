@@ -5,6 +5,7 @@ package gov
 
 import (
 	"fmt"
+	"math/big"
 
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
@@ -12,7 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 const (
@@ -20,6 +24,12 @@ const (
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 )
 
 // Vote defines a method to add a vote on a specific proposal.
@@ -87,3 +97,157 @@ func (p Precompile) VoteWeighted(
 
 	return method.Outputs.Pack(true)
 }
+
+// SubmitProposal defines a method to submit a new governance proposal.
+// The proposal messages are provided as JSON-encoded Cosmos SDK messages.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(method, p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.setDepositBalanceChangeEntry(proposerHexAddr, msg.InitialDeposit)
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr && contract.CallerAddress != origin
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromDepositor, origin.String(), depositorHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.setDepositBalanceChangeEntry(depositorHexAddr, msg.Amount)
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal before its voting period ends.
+// The remaining deposits are refunded to the depositors according to the
+// proposal cancel ratio defined in the gov module parameters.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	// get the depositors and their balances before the refunds take place
+	deposits, err := p.govKeeper.GetDeposits(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	depositors := make([]common.Address, len(deposits))
+	balancesBefore := make([]*big.Int, len(deposits))
+	for i, deposit := range deposits {
+		depositors[i] = common.BytesToAddress(sdk.MustAccAddressFromBech32(deposit.Depositor))
+		balancesBefore[i] = evmBalance(ctx, stateDB, depositors[i])
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the refunded deposits are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	for i, depositor := range depositors {
+		refund := new(big.Int).Sub(evmBalance(ctx, stateDB, depositor), balancesBefore[i])
+		if refund.Sign() > 0 {
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, refund, cmn.Add))
+		}
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setDepositBalanceChangeEntry adds the deposited amount of the EVM coin
+// to the stateDB journal in 18 decimals.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func (p *Precompile) setDepositBalanceChangeEntry(depositor common.Address, amount sdk.Coins) {
+	evmAmount := amount.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !evmAmount.IsPositive() {
+		return
+	}
+
+	scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(evmAmount.BigInt())
+	p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, scaledAmt, cmn.Sub))
+}
+
+// evmBalance returns the balance of the EVM coin in 18 decimals for the given
+// address as stored in the bank keeper.
+func evmBalance(ctx sdk.Context, stateDB *statedb.StateDB, addr common.Address) *big.Int {
+	account := stateDB.Keeper().GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
@@ -2,16 +2,21 @@ package gov_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/gov"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -276,3 +281,349 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	const metadata = "ipfs://CID"
+	const title = "test prop"
+	const summary = "test prop summary"
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sendMsg := fmt.Sprintf(
+		`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"1"}]}`,
+		govAddr, s.keyring.GetAccAddr(1).String(), evmostypes.BaseDenom,
+	)
+	initialDeposit := []cmn.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(100)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					[]string{sendMsg},
+					initialDeposit,
+					metadata,
+					title,
+					summary,
+					false,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					[]string{sendMsg},
+					initialDeposit,
+					metadata,
+					title,
+					summary,
+					false,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - invalid proposal message",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]string{`{"@type":"/cosmos.bank.v1beta1.MsgUnknown"}`},
+					initialDeposit,
+					metadata,
+					title,
+					summary,
+					false,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposal message at index 0",
+		},
+		{
+			"success - submit proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]string{sendMsg},
+					initialDeposit,
+					metadata,
+					title,
+					summary,
+					false,
+				}
+			},
+			func(bz []byte) {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(title, proposal.Title)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(initialDeposit[0].Amount.String(), proposal.TotalDeposit[0].Amount.String())
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	newDepositorAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 1
+	amount := []cmn.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(50)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid depositor",
+		},
+		{
+			"fail - using a different depositor address",
+			func() []interface{} {
+				return []interface{}{
+					newDepositorAddr,
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal does not exist",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(10),
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - deposit on proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					amount,
+				}
+			},
+			func() {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(150), sdk.Coins(deposit.Amount).AmountOf(evmostypes.BaseDenom))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+	const proposalID uint64 = 1
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - proposer is not the proposal's proposer",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+				}
+			},
+			func() {
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().Error(err, "expected proposal to be deleted")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
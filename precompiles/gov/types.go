@@ -6,6 +6,7 @@ package gov
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +32,43 @@ type EventVoteWeighted struct {
 	Options    WeightedVoteOptions
 }
 
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// EventDeposit defines the event data for the Deposit transaction.
+type EventDeposit struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     []cmn.Coin
+}
+
+// EventCancelProposal defines the event data for the CancelProposal transaction.
+type EventCancelProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// SubmitProposalInput defines the input for the SubmitProposal transaction.
+type SubmitProposalInput struct {
+	Proposer       common.Address
+	Messages       []string
+	InitialDeposit []cmn.Coin
+	Metadata       string
+	Title          string
+	Summary        string
+	Expedited      bool
+}
+
+// DepositTxInput defines the input for the Deposit transaction.
+type DepositTxInput struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     []cmn.Coin
+}
+
 // VotesInput defines the input for the Votes query.
 type VotesInput struct {
 	ProposalId uint64 //nolint:revive,stylecheck
@@ -144,6 +182,104 @@ func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
 	return msg, voterAddress, nil
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance. The proposal
+// messages are decoded from their JSON representation using the codec.
+func NewMsgSubmitProposal(method *abi.Method, cdc codec.Codec, args []interface{}) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	var input SubmitProposalInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SubmitProposalInput: %s", err)
+	}
+
+	if input.Proposer == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	msgs := make([]sdk.Msg, len(input.Messages))
+	for i, jsonMsg := range input.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMessage, i, err)
+		}
+		msgs[i] = msg
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		newSDKCoins(input.InitialDeposit),
+		sdk.AccAddress(input.Proposer.Bytes()).String(),
+		input.Metadata,
+		input.Title,
+		input.Summary,
+		input.Expedited,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Proposer, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input DepositTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to DepositTxInput: %s", err)
+	}
+
+	if input.Depositor == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	msg := govv1.NewMsgDeposit(
+		input.Depositor.Bytes(),
+		input.ProposalId,
+		newSDKCoins(input.Amount),
+	)
+
+	return msg, input.Depositor, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := govv1.NewMsgCancelProposal(
+		proposalID,
+		sdk.AccAddress(proposerAddress.Bytes()).String(),
+	)
+
+	return msg, proposerAddress, nil
+}
+
+// newSDKCoins converts the given coins to the Cosmos SDK representation.
+func newSDKCoins(coins []cmn.Coin) sdk.Coins {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		sdkCoins[i] = coin.ToSDKType()
+	}
+	return sdkCoins.Sort()
+}
+
 // NewMsgVoteWeighted creates a new MsgVoteWeighted instance.
 func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteWeighted, common.Address, WeightedVoteOptions, error) {
 	if len(args) != 4 {
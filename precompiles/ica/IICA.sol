@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080B;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Interchain Accounts Precompiled Contract
+/// @dev The interface through which solidity contracts control interchain accounts
+/// on other chains through the ICS27 controller module. The caller of the precompile
+/// is the owner of the interchain account.
+/// @custom:address 0x000000000000000000000000000000000000080B
+interface IICA {
+    /// @dev RegisterInterchainAccount defines an Event emitted when the registration
+    /// of an interchain account is initiated.
+    /// @param owner The owner of the interchain account
+    /// @param connectionId The connection to the host chain
+    /// @param portId The controller port of the owner
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId
+    );
+
+    /// @dev SendTx defines an Event emitted when a batch of messages is sent to
+    /// the interchain account.
+    /// @param owner The owner of the interchain account
+    /// @param connectionId The connection to the host chain
+    /// @param channelId The channel the packet was sent on
+    /// @param sequence The sequence of the packet
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        string channelId,
+        uint64 sequence
+    );
+
+    /// @dev Acknowledgement defines an Event emitted when the acknowledgement of a
+    /// packet sent to the interchain account is received.
+    /// @param owner The owner of the interchain account
+    /// @param channelId The channel the packet was sent on
+    /// @param sequence The sequence of the packet
+    /// @param success Whether the messages were executed on the host chain
+    /// @param result The proto-encoded TxMsgData of the executed messages on success
+    /// or the error on failure
+    event Acknowledgement(
+        address indexed owner,
+        string channelId,
+        uint64 sequence,
+        bool success,
+        bytes result
+    );
+
+    /// @dev Timeout defines an Event emitted when a packet sent to the interchain
+    /// account timed out.
+    /// @param owner The owner of the interchain account
+    /// @param channelId The channel the packet was sent on
+    /// @param sequence The sequence of the packet
+    event Timeout(address indexed owner, string channelId, uint64 sequence);
+
+    /// TRANSACTIONS
+
+    /// @dev registerInterchainAccount initiates the registration of an interchain
+    /// account owned by the caller on the host chain of the given connection.
+    /// The account address is available once the channel handshake is completed.
+    /// @param connectionId The connection to the host chain
+    /// @param version The ICS27 version metadata, the default metadata is used if empty
+    /// @return success Whether the transaction was successful or not
+    function registerInterchainAccount(
+        string calldata connectionId,
+        string calldata version
+    ) external returns (bool success);
+
+    /// @dev sendTx sends the given messages to be executed by the interchain account
+    /// of the caller on the host chain of the given connection.
+    /// @param connectionId The connection to the host chain
+    /// @param msgs The messages to execute, each one proto-encoded as a google.protobuf.Any
+    /// @param memo The memo of the packet
+    /// @param relativeTimeout The timeout of the packet in nanoseconds from the current block time
+    /// @return sequence The sequence of the packet
+    function sendTx(
+        string calldata connectionId,
+        bytes[] calldata msgs,
+        string calldata memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// QUERIES
+
+    /// @dev interchainAccount returns the address of the interchain account of the
+    /// owner on the host chain of the given connection.
+    /// @param owner The owner of the interchain account
+    /// @param connectionId The connection to the host chain
+    /// @return accountAddress The address on the host chain, empty if not registered yet
+    function interchainAccount(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
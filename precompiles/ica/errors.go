@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+const (
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection id: %v"
+	// ErrInvalidVersion is raised when the version metadata is not a string.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidOwner is raised when the owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidMsgs is raised when the messages to send are not valid.
+	ErrInvalidMsgs = "invalid msgs: %v"
+	// ErrInvalidMsg is raised when a message cannot be decoded as a protobuf Any.
+	ErrInvalidMsg = "invalid msg at index %d: %v"
+	// ErrInvalidMemo is raised when the memo is not a string.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidTimeout is raised when the relative timeout is not valid.
+	ErrInvalidTimeout = "invalid relative timeout: %v"
+	// ErrActiveChannelNotFound is raised when there is no open active channel for the owner.
+	ErrActiveChannelNotFound = "no open active channel on connection %s for port %s"
+	// ErrUnsupportedEncoding is raised when the channel encoding is not supported by the precompile.
+	ErrUnsupportedEncoding = "unsupported channel encoding %s, only %s is supported"
+)
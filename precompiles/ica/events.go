@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICA
+	// RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICA SendTx transaction.
+	EventTypeSendTx = "SendTx"
+	// EventTypeAcknowledgement defines the event type emitted when the acknowledgement
+	// of a packet sent to an interchain account is received.
+	EventTypeAcknowledgement = "Acknowledgement"
+	// EventTypeTimeout defines the event type emitted when a packet sent to an
+	// interchain account timed out.
+	EventTypeTimeout = "Timeout"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a
+// RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID string,
+) error {
+	log, err := newLog(ctx, p.ABI.Events[EventTypeRegisterInterchainAccount], p.Address(), owner, connectionID, portID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	return nil
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, channelID string,
+	sequence uint64,
+) error {
+	log, err := newLog(ctx, p.ABI.Events[EventTypeSendTx], p.Address(), owner, connectionID, channelID, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	return nil
+}
+
+// newLog returns the log of the given event, with the owner as the only indexed
+// argument and the remaining arguments packed as the Data field.
+func newLog(
+	ctx sdk.Context,
+	event abi.Event,
+	address, owner common.Address,
+	data ...interface{},
+) (*ethtypes.Log, error) {
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the arguments to be used as the Data field
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	}, nil
+}
@@ -0,0 +1,162 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the underlying application of the ICS27 controller middleware for
+// the channels registered through the precompile. It surfaces the packet lifecycle
+// to the EVM as logs emitted from the precompile address.
+type IBCModule struct {
+	abi     abi.ABI
+	address common.Address
+}
+
+// NewIBCModule creates a new IBCModule instance. It panics if the precompile ABI
+// cannot be loaded.
+func NewIBCModule() IBCModule {
+	abi, err := LoadABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return IBCModule{
+		abi:     abi,
+		address: common.HexToAddress(evmtypes.ICAPrecompileAddress),
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version has already
+// been validated by the controller middleware.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (IBCModule) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(errortypes.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Controller channels do not
+// receive packets.
+func (IBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It emits an
+// Acknowledgement log for the owner of the controller port.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	owner, ok := OwnerFromPortID(packet.SourcePort)
+	if !ok {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS27 packet acknowledgement: %v", err)
+	}
+
+	result := ack.GetResult()
+	if !ack.Success() {
+		result = []byte(ack.GetError())
+	}
+
+	return im.emitLog(ctx, EventTypeAcknowledgement, owner, packet.SourceChannel, packet.Sequence, ack.Success(), result)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It emits a Timeout log
+// for the owner of the controller port.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	owner, ok := OwnerFromPortID(packet.SourcePort)
+	if !ok {
+		return nil
+	}
+
+	return im.emitLog(ctx, EventTypeTimeout, owner, packet.SourceChannel, packet.Sequence)
+}
+
+// emitLog emits the given precompile event outside of an EVM transaction as a
+// tx_log event, so that it is indexed by the JSON-RPC like any other EVM log.
+func (im IBCModule) emitLog(ctx sdk.Context, eventType string, owner common.Address, data ...interface{}) error {
+	log, err := newLog(ctx, im.abi.Events[eventType], im.address, owner, data...)
+	if err != nil {
+		return err
+	}
+	log.TxHash = common.BytesToHash(tmhash.Sum(ctx.TxBytes()))
+
+	bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			evmtypes.EventTypeTxLog,
+			sdk.NewAttribute(evmtypes.AttributeKeyTxLog, string(bz)),
+		),
+	)
+
+	return nil
+}
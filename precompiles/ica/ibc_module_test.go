@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ica"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestOnAcknowledgementPacket() {
+	owner := s.keyring.GetAddr(0)
+	portID, err := ica.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		sourcePort string
+		ack        []byte
+		expError   bool
+		expLog     bool
+		expSuccess bool
+		expResult  []byte
+	}{
+		{
+			"no-op - port not owned by an EVM account",
+			"icacontroller-cosmos1owner",
+			channeltypes.NewResultAcknowledgement([]byte{0x1}).Acknowledgement(),
+			false,
+			false,
+			false,
+			nil,
+		},
+		{
+			"fail - invalid acknowledgement",
+			portID,
+			[]byte("invalid"),
+			true,
+			false,
+			false,
+			nil,
+		},
+		{
+			"success - result acknowledgement",
+			portID,
+			channeltypes.NewResultAcknowledgement([]byte{0x1}).Acknowledgement(),
+			false,
+			true,
+			true,
+			[]byte{0x1},
+		},
+		{
+			"success - error acknowledgement",
+			portID,
+			channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement(),
+			false,
+			true,
+			false,
+			[]byte("ABCI code: 1: error handling packet: see events for details"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+			packet := channeltypes.Packet{Sequence: 1, SourcePort: tc.sourcePort, SourceChannel: "channel-0"}
+
+			err := ica.NewIBCModule().OnAcknowledgementPacket(ctx, packet, tc.ack, nil)
+			if tc.expError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			logs := txLogs(ctx)
+			if !tc.expLog {
+				s.Require().Empty(logs)
+				return
+			}
+
+			s.Require().Len(logs, 1)
+			s.checkLogOwner(logs[0], ica.EventTypeAcknowledgement, owner)
+
+			var event ica.EventAcknowledgement
+			s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, ica.EventTypeAcknowledgement, *logs[0].ToEthereum()))
+			s.Require().Equal("channel-0", event.ChannelID)
+			s.Require().Equal(uint64(1), event.Sequence)
+			s.Require().Equal(tc.expSuccess, event.Success)
+			s.Require().Equal(tc.expResult, event.Result)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestOnTimeoutPacket() {
+	owner := s.keyring.GetAddr(0)
+	portID, err := ica.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+	packet := channeltypes.Packet{Sequence: 2, SourcePort: portID, SourceChannel: "channel-0"}
+
+	s.Require().NoError(ica.NewIBCModule().OnTimeoutPacket(ctx, packet, nil))
+
+	logs := txLogs(ctx)
+	s.Require().Len(logs, 1)
+	s.checkLogOwner(logs[0], ica.EventTypeTimeout, owner)
+
+	var event ica.EventTimeout
+	s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, ica.EventTypeTimeout, *logs[0].ToEthereum()))
+	s.Require().Equal("channel-0", event.ChannelID)
+	s.Require().Equal(uint64(2), event.Sequence)
+}
+
+func (s *PrecompileTestSuite) TestOnRecvPacket() {
+	ack := ica.NewIBCModule().OnRecvPacket(s.network.GetContext(), channeltypes.Packet{}, nil)
+	s.Require().False(ack.Success())
+}
+
+// checkLogOwner checks the log was emitted from the precompile for the given event and owner.
+func (s *PrecompileTestSuite) checkLogOwner(log *evmtypes.Log, eventType string, owner common.Address) {
+	s.Require().Equal(s.precompile.Address().String(), log.Address)
+	s.Require().Equal(s.precompile.ABI.Events[eventType].ID.String(), log.Topics[0])
+	s.Require().Equal(common.BytesToHash(owner.Bytes()).String(), log.Topics[1])
+}
+
+// txLogs returns the EVM logs emitted as tx_log events on the context.
+func txLogs(ctx sdk.Context) []*evmtypes.Log {
+	var logs []*evmtypes.Log
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				panic(err)
+			}
+			logs = append(logs, &log)
+		}
+	}
+	return logs
+}
@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// InterchainAccountMethod defines the ABI method name for the ICA
+	// InterchainAccount query.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount returns the address of the interchain account of the owner
+// on the host chain of the given connection. An empty address is returned if
+// the account is not registered yet.
+func (p Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := ParseInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	return method.Outputs.Pack(address)
+}
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ica"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestInterchainAccount() {
+	method := s.precompile.Methods[ica.InterchainAccountMethod]
+	hostAddress := "cosmos1ica"
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAddress  string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			"",
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid owner address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, connectionID}
+			},
+			"",
+			true,
+			"invalid owner address",
+		},
+		{
+			"success - account not registered",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), connectionID}
+			},
+			"",
+			false,
+			"",
+		},
+		{
+			"success - account registered",
+			func() []interface{} {
+				portID, err := ica.NewControllerPortID(s.keyring.GetAddr(0))
+				s.Require().NoError(err)
+				s.network.App.ICAControllerKeeper.SetInterchainAccountAddress(s.network.GetContext(), connectionID, portID, hostAddress)
+				return []interface{}{s.keyring.GetAddr(0), connectionID}
+			},
+			hostAddress,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.InterchainAccount(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(ica.InterchainAccountMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAddress, out[0])
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the channel handshake to register an
+// interchain account owned by the caller on the host chain of the given connection.
+// The packet callbacks of the channel are routed to the precompile IBC module.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.CallerAddress
+	connectionID, version, err := ParseRegisterInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:staticcheck // the legacy API routes the packet callbacks to the precompile IBC module
+	if err := p.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner.Hex(), version); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, connectionID, portID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SendTx sends the given messages to be executed by the interchain account of
+// the caller on the host chain of the given connection.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.CallerAddress
+	input, err := ParseSendTxArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, found := p.icaControllerKeeper.GetOpenActiveChannel(ctx, input.ConnectionID, portID)
+	if !found {
+		return nil, fmt.Errorf(ErrActiveChannelNotFound, input.ConnectionID, portID)
+	}
+
+	// the messages are encoded as protobuf, so the channel must use the same encoding
+	if version, found := p.icaControllerKeeper.GetAppVersion(ctx, portID, channelID); found {
+		metadata, err := icatypes.MetadataFromVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		if metadata.Encoding != icatypes.EncodingProtobuf {
+			return nil, fmt.Errorf(ErrUnsupportedEncoding, metadata.Encoding, icatypes.EncodingProtobuf)
+		}
+	}
+
+	timeout := uint64(ctx.BlockTime().UnixNano()) + input.RelativeTimeout //nolint:gosec // G115
+	//nolint:staticcheck // the legacy API routes the packet callbacks to the precompile IBC module
+	sequence, err := p.icaControllerKeeper.SendTx(ctx, nil, input.ConnectionID, portID, input.PacketData, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSendTxEvent(ctx, stateDB, owner, input.ConnectionID, channelID, sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
@@ -0,0 +1,162 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ica"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+const connectionID = "connection-0"
+
+var relativeTimeout = uint64(time.Hour.Nanoseconds())
+
+func (s *PrecompileTestSuite) TestRegisterInterchainAccount() {
+	method := s.precompile.Methods[ica.RegisterInterchainAccountMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid connection id",
+			func() []interface{} {
+				return []interface{}{"", ""}
+			},
+			"invalid connection id",
+		},
+		{
+			"fail - invalid version type",
+			func() []interface{} {
+				return []interface{}{connectionID, 1}
+			},
+			"invalid version",
+		},
+		{
+			"fail - connection does not exist",
+			func() []interface{} {
+				return []interface{}{connectionID, ""}
+			},
+			"connection not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.RegisterInterchainAccount(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			s.Require().ErrorContains(err, tc.errContains)
+			s.Require().Empty(bz)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTx() {
+	method := s.precompile.Methods[ica.SendTxMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty msgs",
+			func() []interface{} {
+				return []interface{}{connectionID, [][]byte{}, "", relativeTimeout}
+			},
+			"invalid msgs",
+		},
+		{
+			"fail - invalid msg encoding",
+			func() []interface{} {
+				return []interface{}{connectionID, [][]byte{{0x1, 0x2}}, "", relativeTimeout}
+			},
+			"invalid msg at index 0",
+		},
+		{
+			"fail - msg without type url",
+			func() []interface{} {
+				bz, err := (&codectypes.Any{Value: []byte{0x1}}).Marshal()
+				s.Require().NoError(err)
+				return []interface{}{connectionID, [][]byte{bz}, "", relativeTimeout}
+			},
+			"empty type url",
+		},
+		{
+			"fail - zero relative timeout",
+			func() []interface{} {
+				return []interface{}{connectionID, [][]byte{s.marshalMsg()}, "", uint64(0)}
+			},
+			"invalid relative timeout",
+		},
+		{
+			"fail - no active channel",
+			func() []interface{} {
+				return []interface{}{connectionID, [][]byte{s.marshalMsg()}, "", relativeTimeout}
+			},
+			"no open active channel",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.SendTx(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+			s.Require().ErrorContains(err, tc.errContains)
+			s.Require().Empty(bz)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseSendTxArgs() {
+	msg := s.marshalMsg()
+	input, err := ica.ParseSendTxArgs([]interface{}{connectionID, [][]byte{msg, msg}, "memo", relativeTimeout})
+	s.Require().NoError(err)
+	s.Require().Equal(connectionID, input.ConnectionID)
+	s.Require().Equal(relativeTimeout, input.RelativeTimeout)
+	s.Require().Equal("memo", input.PacketData.Memo)
+	s.Require().NoError(input.PacketData.ValidateBasic())
+}
+
+// marshalMsg encodes a bank send message as a protobuf Any.
+func (s *PrecompileTestSuite) marshalMsg() []byte {
+	msg := banktypes.NewMsgSend(
+		s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+		sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))),
+	)
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+
+	bz, err := msgAny.Marshal()
+	s.Require().NoError(err)
+	return bz
+}
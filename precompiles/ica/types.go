@@ -0,0 +1,185 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// EventRegisterInterchainAccount defines the event data for the RegisterInterchainAccount transaction.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address
+	ConnectionID string `abi:"connectionId"`
+	PortID       string `abi:"portId"`
+}
+
+// EventSendTx defines the event data for the SendTx transaction.
+type EventSendTx struct {
+	Owner        common.Address
+	ConnectionID string `abi:"connectionId"`
+	ChannelID    string `abi:"channelId"`
+	Sequence     uint64 `abi:"sequence"`
+}
+
+// EventAcknowledgement defines the event data for the Acknowledgement event.
+type EventAcknowledgement struct {
+	Owner     common.Address
+	ChannelID string `abi:"channelId"`
+	Sequence  uint64 `abi:"sequence"`
+	Success   bool   `abi:"success"`
+	Result    []byte `abi:"result"`
+}
+
+// EventTimeout defines the event data for the Timeout event.
+type EventTimeout struct {
+	Owner     common.Address
+	ChannelID string `abi:"channelId"`
+	Sequence  uint64 `abi:"sequence"`
+}
+
+// SendTxInput defines the parsed arguments of the SendTx transaction.
+type SendTxInput struct {
+	ConnectionID    string
+	PacketData      icatypes.InterchainAccountPacketData
+	RelativeTimeout uint64
+}
+
+// NewControllerPortID returns the controller port identifier of the given owner.
+func NewControllerPortID(owner common.Address) (string, error) {
+	return icatypes.NewControllerPortID(owner.Hex())
+}
+
+// OwnerFromPortID returns the owner of the given controller port identifier.
+// It returns false if the port was not bound by the precompile.
+func OwnerFromPortID(portID string) (common.Address, bool) {
+	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
+		return common.Address{}, false
+	}
+
+	owner := strings.TrimPrefix(portID, icatypes.ControllerPortPrefix)
+	if !common.IsHexAddress(owner) {
+		return common.Address{}, false
+	}
+
+	return common.HexToAddress(owner), true
+}
+
+// ParseRegisterInterchainAccountArgs parses the arguments of the RegisterInterchainAccount
+// transaction and returns the connection identifier and the version metadata.
+func ParseRegisterInterchainAccountArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	version, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidVersion, args[1])
+	}
+
+	return connectionID, version, nil
+}
+
+// ParseSendTxArgs parses the arguments of the SendTx transaction. Each message is
+// decoded as a protobuf Any without resolving its type, since the messages are
+// executed on the host chain and need not be registered on this chain.
+func ParseSendTxArgs(args []interface{}) (*SendTxInput, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, ok := args[1].([][]byte)
+	if !ok || len(msgs) == 0 {
+		return nil, fmt.Errorf(ErrInvalidMsgs, args[1])
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, bz := range msgs {
+		msgAny := &codectypes.Any{}
+		if err := msgAny.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+		if msgAny.TypeUrl == "" {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, "empty type url")
+		}
+		anys[i] = msgAny
+	}
+
+	memo, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidMemo, args[2])
+	}
+
+	relativeTimeout, ok := args[3].(uint64)
+	if !ok || relativeTimeout == 0 {
+		return nil, fmt.Errorf(ErrInvalidTimeout, args[3])
+	}
+
+	data, err := proto.Marshal(&icatypes.CosmosTx{Messages: anys})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SendTxInput{
+		ConnectionID: connectionID,
+		PacketData: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		},
+		RelativeTimeout: relativeTimeout,
+	}, nil
+}
+
+// ParseInterchainAccountArgs parses the arguments of the InterchainAccount query
+// and returns the owner and the connection identifier.
+func ParseInterchainAccountArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, err := parseConnectionID(args[1])
+	if err != nil {
+		return common.Address{}, "", err
+	}
+
+	return owner, connectionID, nil
+}
+
+// parseConnectionID parses and validates a connection identifier argument.
+func parseConnectionID(arg interface{}) (string, error) {
+	connectionID, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidConnectionID, arg)
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, err)
+	}
+
+	return connectionID, nil
+}
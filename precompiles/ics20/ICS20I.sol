@@ -20,6 +20,50 @@ struct DenomTrace {
     string baseDenom;
 }
 
+/// @dev TransferData defines the parameters of a single transfer of a
+/// batched IBC transfer.
+struct TransferData {
+    // the port on which the packet will be sent
+    string sourcePort;
+    // the channel by which the packet will be sent
+    string sourceChannel;
+    // the denomination of the Coin to be transferred to the receiver
+    string denom;
+    // the amount of the Coin to be transferred to the receiver
+    uint256 amount;
+    // the bech32 address of the receiver
+    string receiver;
+    // the timeout height relative to the current block height
+    Height timeoutHeight;
+    // the timeout timestamp in absolute nanoseconds since unix epoch
+    uint64 timeoutTimestamp;
+    // optional memo
+    string memo;
+}
+
+/// @dev PendingPacket defines an outbound transfer packet that has not been
+/// acknowledged nor timed out yet.
+struct PendingPacket {
+    // the port on which the packet was sent
+    string sourcePort;
+    // the channel by which the packet was sent
+    string sourceChannel;
+    // the sequence number of the packet
+    uint64 sequence;
+    // the denomination of the Coin transferred
+    string denom;
+    // the amount of the Coin transferred
+    uint256 amount;
+    // the bech32 address of the receiver
+    string receiver;
+    // the timeout height of the packet
+    Height timeoutHeight;
+    // the timeout timestamp of the packet
+    uint64 timeoutTimestamp;
+    // the packet memo
+    string memo;
+}
+
 /// @author Evmos Team
 /// @title ICS20 Transfer Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
@@ -68,6 +112,20 @@ interface ICS20I is IICS20Authorization {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferMulti defines a method for performing several IBC transfers
+    /// from the same sender in a single call. The transfers are atomic: if any
+    /// of them fails, none of them is performed. When the caller is not the
+    /// sender, each transfer is checked against and spends the allowance
+    /// granted by the sender to the caller.
+    /// @param sender the hex address of the sender
+    /// @param transfers the transfers to perform
+    /// @return nextSequences sequence numbers of the transfer packets sent, in
+    /// the order of the transfers
+    function transferMulti(
+        address sender,
+        TransferData[] memory transfers
+    ) external returns (uint64[] memory nextSequences);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -85,6 +143,21 @@ interface ICS20I is IICS20Authorization {
         string memory hash
     ) external view returns (DenomTrace memory denomTrace);
 
+    /// @dev PendingPackets defines a method for returning the outbound transfer
+    /// packets sent by the sender that have not been acknowledged nor timed out yet.
+    /// @param sender the hex address of the sender
+    /// @param pageRequest Defines the pagination parameters to for the request.
+    function pendingPackets(
+        address sender,
+        PageRequest memory pageRequest
+    )
+        external
+        view
+        returns (
+            PendingPacket[] memory packets,
+            PageResponse memory pageResponse
+        );
+
     /// @dev DenomHash defines a method for returning a hash of the denomination trace info.
     function denomHash(
         string memory trace
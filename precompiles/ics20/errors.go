@@ -22,6 +22,10 @@ const (
 	ErrNoMatchingAllocation = "no matching allocation found for source port: %s, source channel: %s, and denom: %s"
 	// ErrDifferentOriginFromSender is raised when the origin address is not the same as the sender address.
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
+	// ErrEmptyTransfers is raised when no transfers are provided to the TransferMulti transaction.
+	ErrEmptyTransfers = "transfers cannot be empty"
+	// ErrTransferFailed is raised when one of the transfers of the TransferMulti transaction fails.
+	ErrTransferFailed = "transfer %d failed: %w"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
 )
@@ -112,6 +112,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferMultiMethod:
+		bz, err = p.TransferMulti(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -119,6 +121,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DenomTraces(ctx, contract, method, args)
 	case DenomHashMethod:
 		bz, err = p.DenomHash(ctx, contract, method, args)
+	case PendingPacketsMethod:
+		bz, err = p.PendingPackets(ctx, contract, method, args)
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, args)
 	default:
@@ -146,6 +150,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferMulti
 //
 // Available authorization transactions are:
 //   - Approve
@@ -155,6 +160,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
+		TransferMultiMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
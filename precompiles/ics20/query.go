@@ -25,6 +25,9 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// PendingPacketsMethod defines the ABI method name for the ICS20
+	// PendingPackets query.
+	PendingPacketsMethod = "pendingPackets"
 )
 
 // DenomTrace returns the requested denomination trace information.
@@ -95,6 +98,27 @@ func (p Precompile) DenomHash(
 	return method.Outputs.Pack(res.Hash)
 }
 
+// PendingPackets returns the outbound transfer packets sent by the given sender
+// that have not been acknowledged nor timed out yet.
+func (p Precompile) PendingPackets(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender, pageRequest, err := NewPendingPacketsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	packets, pageResponse, err := p.transferKeeper.GetPendingPackets(ctx, sender.Bytes(), pageRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewPendingPackets(packets), pageResponse)
+}
+
 // Allowance returns the remaining allowance of for a combination of grantee - granter.
 // The grantee is the smart contract that was authorized by the granter to spend.
 func (p Precompile) Allowance(
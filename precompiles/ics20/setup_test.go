@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ics20_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+)
+
+// channelID is the transfer channel opened on the localhost connection of
+// the test network.
+const channelID = "channel-0"
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *ics20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	precompile, err := ics20.NewPrecompile(
+		nw.App.StakingKeeper,
+		nw.App.TransferKeeper,
+		nw.App.IBCKeeper.ChannelKeeper,
+		nw.App.AuthzKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.network = nw
+	s.keyring = keyring
+	s.precompile = precompile
+
+	s.openTransferChannel()
+}
+
+// openTransferChannel opens a transfer channel on the localhost connection
+// and hands its capability to the transfer module, so that packets can be
+// sent on it.
+func (s *PrecompileTestSuite) openTransferChannel() {
+	ctx := s.network.GetContext()
+	app := s.network.App
+
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, channelID),
+		[]string{exported.LocalhostConnectionID},
+		transfertypes.Version,
+	)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channel)
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, channelID, 1)
+
+	capName := host.ChannelCapabilityPath(transfertypes.PortID, channelID)
+	capability, err := app.ScopedIBCKeeper.NewCapability(ctx, capName)
+	s.Require().NoError(err)
+	s.Require().NoError(app.ScopedTransferKeeper.ClaimCapability(ctx, capability, capName))
+}
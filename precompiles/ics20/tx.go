@@ -24,6 +24,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferMultiMethod defines the ABI method name for the ICS20
+	// TransferMulti transaction.
+	TransferMultiMethod = "transferMulti"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -40,9 +43,92 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	sequence, err := p.transfer(ctx, origin, contract, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = EmitIBCTransferEvent(
+		ctx,
+		stateDB,
+		p.ABI.Events[EventTypeIBCTransfer],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.Token,
+		msg.Memo,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferMulti implements the ICS20 batched transfer transactions. The
+// transfers are performed on a cached context that is only written when all
+// of them succeed, so that the batch is atomic. The precompile context alone
+// is not enough, as its changes are not reverted when the call fails.
+func (p *Precompile) TransferMulti(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, sender, err := NewMsgTransfers(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	sequences := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		sequences[i], err = p.transfer(cacheCtx, origin, contract, sender, msg)
+		if err != nil {
+			return nil, fmt.Errorf(ErrTransferFailed, i, err)
+		}
+	}
+
+	writeCache()
+
+	for _, msg := range msgs {
+		if err = EmitIBCTransferEvent(
+			ctx,
+			stateDB,
+			p.ABI.Events[EventTypeIBCTransfer],
+			p.Address(),
+			sender,
+			msg.Receiver,
+			msg.SourcePort,
+			msg.SourceChannel,
+			msg.Token,
+			msg.Memo,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(sequences)
+}
+
+// transfer performs the given ICS20 transfer on behalf of the sender and
+// returns the sequence of the packet sent. When the contract caller is not the
+// sender, the transfer is checked against and spends the authorization granted
+// by the sender to the caller.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	sender common.Address,
+	msg *transfertypes.MsgTransfer,
+) (uint64, error) {
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
-		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
 	}
 
 	// isCallerSender is true when the contract caller is the same as the sender
@@ -50,23 +136,23 @@ func (p *Precompile) Transfer(
 
 	// If the contract caller is not the same as the sender, the sender must be the origin
 	if !isCallerSender && origin != sender {
-		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
+		return 0, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	res, err := p.transferKeeper.Transfer(ctx, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err := UpdateGrantIfNeeded(ctx, contract, p.AuthzKeeper, origin, expiration, resp); err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if contract.CallerAddress != origin && msg.Token.Denom == evmtypes.GetEVMCoinDenom() {
@@ -78,26 +164,11 @@ func (p *Precompile) Transfer(
 		// when calling the precompile from another smart contract.
 		// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
 		amt := msg.Token.Amount.BigInt()
-		p.SetBalanceChangeEntries(
+		p.AddBalanceChangeEntries(
 			cmn.NewBalanceChangeEntry(sender, amt, cmn.Sub),
 			cmn.NewBalanceChangeEntry(escrowHexAddr, amt, cmn.Add),
 		)
 	}
 
-	if err = EmitIBCTransferEvent(
-		ctx,
-		stateDB,
-		p.ABI.Events[EventTypeIBCTransfer],
-		p.Address(),
-		sender,
-		msg.Receiver,
-		msg.SourcePort,
-		msg.SourceChannel,
-		msg.Token,
-		msg.Memo,
-	); err != nil {
-		return nil, err
-	}
-
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ics20_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestTransferMulti() {
+	testCases := []struct {
+		name         string
+		transfers    func() []ics20.TransferData
+		expSequences []uint64
+		expError     bool
+		errContains  string
+	}{
+		{
+			"success - all transfers sent",
+			func() []ics20.TransferData {
+				denom := evmtypes.GetEVMCoinDenom()
+				return []ics20.TransferData{
+					transferData(transfertypes.PortID, channelID, denom, 10),
+					transferData(transfertypes.PortID, channelID, denom, 20),
+				}
+			},
+			[]uint64{1, 2},
+			false,
+			"",
+		},
+		{
+			"fail - the transfers sent before a failed transfer are reverted",
+			func() []ics20.TransferData {
+				denom := evmtypes.GetEVMCoinDenom()
+				return []ics20.TransferData{
+					transferData(transfertypes.PortID, channelID, denom, 10),
+					transferData(transfertypes.PortID, "channel-99", denom, 20),
+				}
+			},
+			nil,
+			true,
+			"transfer 1 failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			app := s.network.App
+			sender := s.keyring.GetAddr(0)
+			escrowAddr := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+
+			input, err := s.precompile.Pack(ics20.TransferMultiMethod, sender, tc.transfers())
+			s.Require().NoError(err)
+
+			precompileAddr := s.precompile.Address()
+			stateDB := statedb.New(ctx, app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			cfg, err := app.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err)
+			msg := ethtypes.NewMessage(sender, &precompileAddr, 0, big.NewInt(0), uint64(1e7), big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+			evm := app.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+			evm.WithPrecompiles(
+				map[common.Address]vm.PrecompiledContract{precompileAddr: s.precompile},
+				[]common.Address{precompileAddr},
+			)
+
+			ret, _, err := evm.Call(vm.AccountRef(sender), precompileAddr, input, uint64(1e7), big.NewInt(0))
+			s.Require().NoError(stateDB.Commit())
+
+			escrowed := app.BankKeeper.GetBalance(ctx, escrowAddr, evmtypes.GetEVMCoinDenom())
+			pending, _, pendingErr := app.TransferKeeper.GetPendingPackets(ctx, s.keyring.GetAccAddr(0), nil)
+			s.Require().NoError(pendingErr)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+
+				// nothing is escrowed nor indexed for the first transfer
+				s.Require().True(escrowed.Amount.IsZero())
+				s.Require().Empty(pending)
+				nextSequence, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, transfertypes.PortID, channelID)
+				s.Require().True(found)
+				s.Require().Equal(uint64(1), nextSequence)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(ics20.TransferMultiMethod, ret)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expSequences, out[0])
+
+			s.Require().Equal(math.NewInt(30), escrowed.Amount)
+			s.Require().Len(pending, len(tc.expSequences))
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	ibctransfertypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
 )
 
 const (
@@ -73,6 +74,46 @@ type DenomTracesResponse struct {
 	PageResponse query.PageResponse
 }
 
+// TransferData defines a single transfer of the TransferMulti transaction.
+type TransferData struct {
+	SourcePort       string
+	SourceChannel    string
+	Denom            string
+	Amount           *big.Int
+	Receiver         string
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// transferMultiInput is a struct used to parse the arguments of the
+// TransferMulti transaction.
+type transferMultiInput struct {
+	Sender    common.Address
+	Transfers []TransferData
+}
+
+// PendingPacket defines the data of an outbound transfer packet that has not
+// been acknowledged nor timed out yet.
+type PendingPacket struct {
+	SourcePort       string
+	SourceChannel    string
+	Sequence         uint64
+	Denom            string
+	Amount           *big.Int
+	Receiver         string
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// pendingPacketsInput is a struct used to parse the arguments of the
+// PendingPackets query.
+type pendingPacketsInput struct {
+	Sender      common.Address
+	PageRequest query.PageRequest
+}
+
 // height is a struct used to parse the TimeoutHeight parameter
 // used as input in the transfer method
 type height struct {
@@ -166,6 +207,46 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgTransfers returns the transfer messages of the TransferMulti
+// transaction from the given arguments.
+func NewMsgTransfers(method *abi.Method, args []interface{}) ([]*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input transferMultiInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to transferMultiInput struct: %s", err)
+	}
+
+	if len(input.Transfers) == 0 {
+		return nil, common.Address{}, errors.New(ErrEmptyTransfers)
+	}
+
+	sender := sdk.AccAddress(input.Sender.Bytes()).String()
+	msgs := make([]*transfertypes.MsgTransfer, len(input.Transfers))
+	for i, t := range input.Transfers {
+		if t.Amount == nil {
+			return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, t.Amount)
+		}
+
+		// Use instance to prevent errors on denom or amount
+		token := sdk.Coin{
+			Denom:  t.Denom,
+			Amount: math.NewIntFromBigInt(t.Amount),
+		}
+
+		msg, err := CreateAndValidateMsgTransfer(t.SourcePort, t.SourceChannel, token, sender, t.Receiver, t.TimeoutHeight, t.TimeoutTimestamp, t.Memo)
+		if err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrTransferFailed, i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, input.Sender, nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -246,6 +327,42 @@ func NewDenomHashRequest(args []interface{}) (*transfertypes.QueryDenomHashReque
 	return req, nil
 }
 
+// NewPendingPacketsRequest returns the sender and the page request of the
+// PendingPackets query from the given arguments.
+func NewPendingPacketsRequest(method *abi.Method, args []interface{}) (common.Address, *query.PageRequest, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input pendingPacketsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return common.Address{}, nil, fmt.Errorf("error while unpacking args to pendingPacketsInput struct: %s", err)
+	}
+
+	return input.Sender, &input.PageRequest, nil
+}
+
+// NewPendingPackets converts the pending packets of the transfer keeper to
+// their ABI representation.
+func NewPendingPackets(packets []ibctransfertypes.PendingPacket) []PendingPacket {
+	res := make([]PendingPacket, len(packets))
+	for i, packet := range packets {
+		res[i] = PendingPacket{
+			SourcePort:       packet.SourcePort,
+			SourceChannel:    packet.SourceChannel,
+			Sequence:         packet.Sequence,
+			Denom:            packet.Token.Denom,
+			Amount:           packet.Token.Amount.BigInt(),
+			Receiver:         packet.Receiver,
+			TimeoutHeight:    packet.TimeoutHeight(),
+			TimeoutTimestamp: packet.TimeoutTimestamp,
+			Memo:             packet.Memo,
+		}
+	}
+
+	return res
+}
+
 // checkRevokeArgs checks if the given arguments are valid for the Revoke tx.
 func checkRevokeArgs(args []interface{}) (common.Address, error) {
 	if len(args) != 1 {
@@ -0,0 +1,102 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ics20_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestNewMsgTransfers() {
+	method := s.precompile.Methods[ics20.TransferMultiMethod]
+	sender := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expMsgs     int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			[]interface{}{sender},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - invalid transfers type",
+			[]interface{}{sender, "transfers"},
+			0,
+			true,
+			"error while unpacking args to transferMultiInput struct",
+		},
+		{
+			"fail - empty transfers",
+			[]interface{}{sender, []ics20.TransferData{}},
+			0,
+			true,
+			ics20.ErrEmptyTransfers,
+		},
+		{
+			"fail - invalid transfer amount is reported with its index",
+			[]interface{}{sender, []ics20.TransferData{transferData(transfertypes.PortID, channelID, "aevmos", 10), transferData(transfertypes.PortID, channelID, "aevmos", 0)}},
+			0,
+			true,
+			"transfer 1 failed",
+		},
+		{
+			"pass - transfers parsed in order",
+			[]interface{}{sender, []ics20.TransferData{transferData(transfertypes.PortID, channelID, "aevmos", 10), transferData(transfertypes.PortID, channelID, "aevmos", 20)}},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msgs, parsedSender, err := ics20.NewMsgTransfers(&method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sender, parsedSender)
+			s.Require().Len(msgs, tc.expMsgs)
+			for i, msg := range msgs {
+				s.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), msg.Sender)
+				s.Require().Equal(receiver, msg.Receiver)
+				s.Require().Equal(int64(10*(i+1)), msg.Token.Amount.Int64())
+			}
+		})
+	}
+}
+
+// receiver is the receiver of the transfers on the counterparty chain.
+const receiver = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+
+// transferData returns the ABI data of a transfer of the given amount and
+// denom on the given port and channel.
+func transferData(portID, channelID, denom string, amount int64) ics20.TransferData {
+	return ics20.TransferData{
+		SourcePort:       portID,
+		SourceChannel:    channelID,
+		Denom:            denom,
+		Amount:           big.NewInt(amount),
+		Receiver:         receiver,
+		TimeoutHeight:    ics20.DefaultTimeoutHeight,
+		TimeoutTimestamp: math.MaxUint64,
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IInflation contract's address.
+address constant INFLATION_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080D;
+
+/// @dev The IInflation contract's instance.
+IInflation constant INFLATION_CONTRACT = IInflation(INFLATION_PRECOMPILE_ADDRESS);
+
+/// @dev EpochInfo defines the state of an epoch of the epochs module.
+struct EpochInfo {
+    // the identifier of the epoch
+    string identifier;
+    // the unix timestamp in seconds of the start of the first epoch
+    int64 startTime;
+    // the duration of the epoch in seconds
+    int64 duration;
+    // the number of the current epoch
+    int64 currentEpoch;
+    // the unix timestamp in seconds of the start of the current epoch
+    int64 currentEpochStartTime;
+    // whether the counting of the epoch has started
+    bool epochCountingStarted;
+    // the block height of the start of the current epoch
+    int64 currentEpochStartHeight;
+}
+
+/// @author Evmos Team
+/// @title Inflation Precompiled Contract
+/// @dev The interface through which solidity contracts read the epochs of the
+/// epochs module and the inflation schedule of the inflation module.
+/// @custom:address 0x000000000000000000000000000000000000080D
+interface IInflation {
+    /// @dev Returns the current epoch number of the given epoch identifier.
+    /// @param identifier The identifier of the epoch (e.g. "day" or "week")
+    /// @return currentEpoch The number of the current epoch
+    function currentEpoch(
+        string memory identifier
+    ) external view returns (int64 currentEpoch);
+
+    /// @dev Returns the state of all the running epochs.
+    /// @return epochs The running epochs
+    function epochInfos() external view returns (EpochInfo[] memory epochs);
+
+    /// @dev Returns the inflation rate of the current period as a percentage.
+    /// @return inflationRate The inflation rate of the current period
+    function inflationRate() external view returns (Dec memory inflationRate);
+
+    /// @dev Returns the amount of coins minted at the end of each inflation epoch.
+    /// @return epochMintProvision The amount minted per epoch in the mint denomination
+    function epochMintProvision()
+        external
+        view
+        returns (DecCoin memory epochMintProvision);
+
+    /// @dev Returns the total supply in circulation of the mint denomination.
+    /// @return circulatingSupply The circulating supply in the mint denomination
+    function circulatingSupply()
+        external
+        view
+        returns (DecCoin memory circulatingSupply);
+
+    /// @dev Returns the current period of the inflation schedule.
+    /// @return period The number of the current period
+    function period() external view returns (uint64 period);
+}
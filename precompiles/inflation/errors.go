@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+const (
+	// ErrInvalidIdentifier is raised when the epoch identifier is invalid.
+	ErrInvalidIdentifier = "invalid epoch identifier: %v"
+)
@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	epochskeeper "github.com/evmos/evmos/v20/x/epochs/keeper"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	inflationkeeper "github.com/evmos/evmos/v20/x/inflation/v1/keeper"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the read-only precompiled contract for the epochs and
+// inflation modules.
+type Precompile struct {
+	cmn.Precompile
+	epochsKeeper    epochskeeper.Keeper
+	inflationKeeper inflationkeeper.Keeper
+}
+
+// LoadABI loads the inflation ABI from the embedded abi.json file
+// for the inflation precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new inflation Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	epochsKeeper epochskeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		epochsKeeper:    epochsKeeper,
+		inflationKeeper: inflationKeeper,
+	}
+
+	// SetAddress defines the address of the inflation precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.InflationPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract inflation methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// epochs queries
+	case CurrentEpochMethod:
+		bz, err = p.CurrentEpoch(ctx, method, contract, args)
+	case EpochInfosMethod:
+		bz, err = p.EpochInfos(ctx, method, contract, args)
+	// inflation queries
+	case InflationRateMethod:
+		bz, err = p.InflationRate(ctx, method, contract, args)
+	case EpochMintProvisionMethod:
+		bz, err = p.EpochMintProvision(ctx, method, contract, args)
+	case CirculatingSupplyMethod:
+		bz, err = p.CirculatingSupply(ctx, method, contract, args)
+	case PeriodMethod:
+		bz, err = p.Period(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// The inflation precompile only exposes queries.
+func (Precompile) IsTransaction(_ *abi.Method) bool {
+	return false
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "inflation")
+}
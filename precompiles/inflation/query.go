@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+const (
+	// CurrentEpochMethod defines the ABI method name for the epochs
+	// CurrentEpoch query.
+	CurrentEpochMethod = "currentEpoch"
+	// EpochInfosMethod defines the ABI method name for the epochs
+	// EpochInfos query.
+	EpochInfosMethod = "epochInfos"
+	// InflationRateMethod defines the ABI method name for the inflation
+	// InflationRate query.
+	InflationRateMethod = "inflationRate"
+	// EpochMintProvisionMethod defines the ABI method name for the inflation
+	// EpochMintProvision query.
+	EpochMintProvisionMethod = "epochMintProvision"
+	// CirculatingSupplyMethod defines the ABI method name for the inflation
+	// CirculatingSupply query.
+	CirculatingSupplyMethod = "circulatingSupply"
+	// PeriodMethod defines the ABI method name for the inflation Period query.
+	PeriodMethod = "period"
+)
+
+// CurrentEpoch returns the current epoch number of the given epoch identifier.
+func (p Precompile) CurrentEpoch(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	identifier, ok := args[0].(string)
+	if !ok || identifier == "" {
+		return nil, fmt.Errorf(ErrInvalidIdentifier, args[0])
+	}
+
+	res, err := p.epochsKeeper.CurrentEpoch(ctx, &epochstypes.QueryCurrentEpochRequest{
+		Identifier: identifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.CurrentEpoch)
+}
+
+// EpochInfos returns the state of all the running epochs.
+func (p Precompile) EpochInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.epochsKeeper.EpochInfos(ctx, &epochstypes.QueryEpochsInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]EpochInfo, len(res.Epochs))
+	for i, epoch := range res.Epochs {
+		epochs[i] = NewEpochInfo(epoch)
+	}
+
+	return method.Outputs.Pack(epochs)
+}
+
+// InflationRate returns the inflation rate of the current period.
+func (p Precompile) InflationRate(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.InflationRate(ctx, &inflationtypes.QueryInflationRateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.Dec{
+		Value:     res.InflationRate.BigInt(),
+		Precision: math.LegacyPrecision,
+	})
+}
+
+// EpochMintProvision returns the amount of coins minted at the end of each
+// inflation epoch.
+func (p Precompile) EpochMintProvision(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.EpochMintProvision(ctx, &inflationtypes.QueryEpochMintProvisionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.EpochMintProvision))
+}
+
+// CirculatingSupply returns the total supply in circulation of the mint
+// denomination.
+func (p Precompile) CirculatingSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.CirculatingSupply(ctx, &inflationtypes.QueryCirculatingSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.CirculatingSupply))
+}
+
+// Period returns the current period of the inflation schedule.
+func (p Precompile) Period(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.Period(ctx, &inflationtypes.QueryPeriodRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Period)
+}
@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/inflation"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+func (s *PrecompileTestSuite) TestCurrentEpoch() {
+	method := s.precompile.Methods[inflation.CurrentEpochMethod]
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty identifier",
+			[]interface{}{""},
+			true,
+			"invalid epoch identifier",
+		},
+		{
+			"fail - epoch not found",
+			[]interface{}{"month"},
+			true,
+			"epoch info not found",
+		},
+		{
+			"success - current day epoch",
+			[]interface{}{epochstypes.DayEpochID},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.network.GetContext()
+			bz, err := s.precompile.CurrentEpoch(ctx, &method, nil, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				epoch, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+				s.Require().True(found)
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(epoch.CurrentEpoch, out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestEpochInfos() {
+	method := s.precompile.Methods[inflation.EpochInfosMethod]
+	ctx := s.network.GetContext()
+
+	_, err := s.precompile.EpochInfos(ctx, &method, nil, []interface{}{"day"})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+
+	bz, err := s.precompile.EpochInfos(ctx, &method, nil, []interface{}{})
+	s.Require().NoError(err)
+
+	var out struct {
+		Epochs []inflation.EpochInfo
+	}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+
+	epochs := s.network.App.EpochsKeeper.AllEpochInfos(ctx)
+	s.Require().Len(out.Epochs, len(epochs))
+	for i, epoch := range epochs {
+		s.Require().Equal(inflation.NewEpochInfo(epoch), out.Epochs[i])
+	}
+}
+
+func (s *PrecompileTestSuite) TestInflationRate() {
+	method := s.precompile.Methods[inflation.InflationRateMethod]
+	ctx := s.network.GetContext()
+
+	_, err := s.precompile.InflationRate(ctx, &method, nil, []interface{}{"rate"})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+
+	bz, err := s.precompile.InflationRate(ctx, &method, nil, []interface{}{})
+	s.Require().NoError(err)
+
+	var out struct {
+		InflationRate cmn.Dec
+	}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+
+	mintDenom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+	expRate := s.network.App.InflationKeeper.GetInflationRate(ctx, mintDenom)
+	s.Require().Equal(expRate.BigInt(), out.InflationRate.Value)
+	s.Require().Equal(uint8(math.LegacyPrecision), out.InflationRate.Precision)
+}
+
+func (s *PrecompileTestSuite) TestEpochMintProvision() {
+	method := s.precompile.Methods[inflation.EpochMintProvisionMethod]
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.EpochMintProvision(ctx, &method, nil, []interface{}{})
+	s.Require().NoError(err)
+
+	var out struct {
+		EpochMintProvision cmn.DecCoin
+	}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+
+	params := s.network.App.InflationKeeper.GetParams(ctx)
+	expProvision := s.network.App.InflationKeeper.GetEpochMintProvision(ctx)
+	s.Require().Equal(params.MintDenom, out.EpochMintProvision.Denom)
+	s.Require().Equal(expProvision.BigInt(), out.EpochMintProvision.Amount)
+	s.Require().Equal(uint8(math.LegacyPrecision), out.EpochMintProvision.Precision)
+}
+
+func (s *PrecompileTestSuite) TestCirculatingSupply() {
+	method := s.precompile.Methods[inflation.CirculatingSupplyMethod]
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.CirculatingSupply(ctx, &method, nil, []interface{}{})
+	s.Require().NoError(err)
+
+	var out struct {
+		CirculatingSupply cmn.DecCoin
+	}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, method.Name, bz))
+
+	mintDenom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+	expSupply := s.network.App.InflationKeeper.GetCirculatingSupply(ctx, mintDenom)
+	s.Require().Equal(mintDenom, out.CirculatingSupply.Denom)
+	s.Require().Equal(expSupply.BigInt(), out.CirculatingSupply.Amount)
+	s.Require().Equal(1, out.CirculatingSupply.Amount.Cmp(big.NewInt(0)))
+}
+
+func (s *PrecompileTestSuite) TestPeriod() {
+	method := s.precompile.Methods[inflation.PeriodMethod]
+	ctx := s.network.GetContext()
+	s.network.App.InflationKeeper.SetPeriod(ctx, 3)
+
+	bz, err := s.precompile.Period(ctx, &method, nil, []interface{}{})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), out[0])
+}
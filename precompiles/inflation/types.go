@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+// EpochInfo defines the ABI representation of an epochs module epoch. Times
+// are unix timestamps and durations are expressed in seconds.
+type EpochInfo struct {
+	Identifier              string
+	StartTime               int64
+	Duration                int64
+	CurrentEpoch            int64
+	CurrentEpochStartTime   int64
+	EpochCountingStarted    bool
+	CurrentEpochStartHeight int64
+}
+
+// NewEpochInfo converts the given epochs module epoch to its ABI
+// representation.
+func NewEpochInfo(epoch epochstypes.EpochInfo) EpochInfo {
+	return EpochInfo{
+		Identifier:              epoch.Identifier,
+		StartTime:               epoch.StartTime.Unix(),
+		Duration:                int64(epoch.Duration.Seconds()),
+		CurrentEpoch:            epoch.CurrentEpoch,
+		CurrentEpochStartTime:   epoch.CurrentEpochStartTime.Unix(),
+		EpochCountingStarted:    epoch.EpochCountingStarted,
+		CurrentEpochStartHeight: epoch.CurrentEpochStartHeight,
+	}
+}
+
+// NewDecCoin converts the given decimal coin to its ABI representation, where
+// the amount is scaled by the decimal precision.
+func NewDecCoin(coin sdk.DecCoin) cmn.DecCoin {
+	return cmn.DecCoin{
+		Denom:     coin.Denom,
+		Amount:    coin.Amount.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IPaymaster contract's address.
+address constant PAYMASTER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IPaymaster contract's instance.
+IPaymaster constant PAYMASTER_CONTRACT = IPaymaster(PAYMASTER_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Paymaster Precompiled Contract
+/// @dev The interface through which accounts and solidity contracts register
+/// as paymasters to pay the transaction fees of the senders they sponsor.
+/// The caller of the transactions is the paymaster of the sponsorship.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IPaymaster {
+    /// @dev Emitted when a paymaster sponsors a sender or updates its spend limit.
+    /// @param paymaster The address of the paymaster
+    /// @param sender The address of the sponsored sender
+    /// @param spendLimit The maximum amount of fees paid for the sender
+    event Sponsor(
+        address indexed paymaster,
+        address indexed sender,
+        uint256 spendLimit
+    );
+
+    /// @dev Emitted when a paymaster revokes the sponsorship of a sender.
+    /// @param paymaster The address of the paymaster
+    /// @param sender The address of the sender
+    event Revoke(address indexed paymaster, address indexed sender);
+
+    /// @dev Emitted when a sender selects the paymaster that pays its fees.
+    /// @param sender The address of the sender
+    /// @param paymaster The address of the selected paymaster, or the zero
+    /// address if the selection was cleared
+    event SelectPaymaster(address indexed sender, address indexed paymaster);
+
+    /// @dev Sponsors the transaction fees of the sender up to the spend limit.
+    /// If the sponsorship already exists, the spend limit is updated and the
+    /// fees already paid are kept.
+    /// @param sender The address of the sponsored sender
+    /// @param spendLimit The maximum amount of fees paid for the sender
+    /// @return success Whether or not the sponsorship was successful
+    function sponsor(
+        address sender,
+        uint256 spendLimit
+    ) external returns (bool success);
+
+    /// @dev Revokes the sponsorship of the sender.
+    /// @param sender The address of the sponsored sender
+    /// @return success Whether or not the revocation was successful
+    function revoke(address sender) external returns (bool success);
+
+    /// @dev Opts the caller in to have the fees of its transactions paid by
+    /// the sponsorship of the given paymaster. Sponsorships of paymasters that
+    /// were not selected are only used for calls to the paymaster itself.
+    /// @param paymaster The address of the paymaster, or the zero address to
+    /// clear the selection
+    /// @return success Whether or not the selection was successful
+    function selectPaymaster(address paymaster) external returns (bool success);
+
+    /// @dev Returns the sponsorship granted by the paymaster to the sender.
+    /// @param paymaster The address of the paymaster
+    /// @param sender The address of the sponsored sender
+    /// @return spendLimit The maximum amount of fees paid for the sender
+    /// @return spent The amount of fees already paid for the sender
+    function getSponsorship(
+        address paymaster,
+        address sender
+    ) external view returns (uint256 spendLimit, uint256 spent);
+
+    /// @dev Returns the paymaster selected by the sender.
+    /// @param sender The address of the sender
+    /// @return paymaster The address of the selected paymaster, or the zero
+    /// address if there is none
+    function getSelectedPaymaster(
+        address sender
+    ) external view returns (address paymaster);
+}
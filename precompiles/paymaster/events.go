@@ -0,0 +1,124 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package paymaster
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeSponsor defines the event type for the paymaster Sponsor transaction.
+	EventTypeSponsor = "Sponsor"
+	// EventTypeRevoke defines the event type for the paymaster Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeSelectPaymaster defines the event type for the paymaster
+	// SelectPaymaster transaction.
+	EventTypeSelectPaymaster = "SelectPaymaster"
+)
+
+// EmitSponsorEvent creates a new event emitted on a Sponsor transaction.
+func (p Precompile) EmitSponsorEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	paymaster, sender common.Address,
+	spendLimit *big.Int,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSponsor]
+	topics, err := makeTopics(event, paymaster, sender)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(spendLimit)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	paymaster, sender common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRevoke]
+	topics, err := makeTopics(event, paymaster, sender)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSelectPaymasterEvent creates a new event emitted on a SelectPaymaster
+// transaction.
+func (p Precompile) EmitSelectPaymasterEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	sender, paymaster common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSelectPaymaster]
+	topics, err := makeTopics(event, sender, paymaster)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// makeTopics returns the event topics for the two indexed addresses of the
+// event, in order.
+func makeTopics(event abi.Event, first, second common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(first)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(second)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
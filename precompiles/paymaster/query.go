@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package paymaster
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GetSponsorshipMethod defines the ABI method name for the paymaster
+	// GetSponsorship query.
+	GetSponsorshipMethod = "getSponsorship"
+	// GetSelectedPaymasterMethod defines the ABI method name for the paymaster
+	// GetSelectedPaymaster query.
+	GetSelectedPaymasterMethod = "getSelectedPaymaster"
+)
+
+// GetSponsorship returns the spend limit and the fees spent of the sponsorship
+// granted by the paymaster to the sender. Both values are zero if the
+// sponsorship does not exist.
+func (p Precompile) GetSponsorship(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	paymaster, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid paymaster hex address")
+	}
+
+	sender, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid sender hex address")
+	}
+
+	sponsorship, found := p.paymasterKeeper.GetSponsorship(ctx, sender, paymaster)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+	}
+
+	return method.Outputs.Pack(sponsorship.SpendLimit.BigInt(), sponsorship.Spent.BigInt())
+}
+
+// GetSelectedPaymaster returns the paymaster selected by the sender to pay the
+// fees of its transactions. The zero address is returned if there is none.
+func (p Precompile) GetSelectedPaymaster(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	sender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid sender hex address")
+	}
+
+	paymaster, _ := p.paymasterKeeper.GetSelectedPaymaster(ctx, sender)
+	return method.Outputs.Pack(paymaster)
+}
@@ -0,0 +1,125 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package paymaster
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// SponsorMethod defines the ABI method name for the paymaster Sponsor
+	// transaction.
+	SponsorMethod = "sponsor"
+	// RevokeMethod defines the ABI method name for the paymaster Revoke
+	// transaction.
+	RevokeMethod = "revoke"
+	// SelectPaymasterMethod defines the ABI method name for the paymaster
+	// SelectPaymaster transaction.
+	SelectPaymasterMethod = "selectPaymaster"
+)
+
+// Sponsor implements the sponsor precompile transaction, which registers the
+// caller as the paymaster of the given sender up to the spend limit.
+func (p Precompile) Sponsor(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	sender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid sender hex address")
+	}
+
+	spendLimit, ok := args[1].(*big.Int)
+	if !ok || spendLimit == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	paymaster := contract.CallerAddress
+	if _, err := p.paymasterKeeper.Sponsor(ctx, paymaster, sender, math.NewIntFromBigInt(spendLimit)); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSponsorEvent(ctx, stateDB, paymaster, sender, spendLimit); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke implements the revoke precompile transaction, which removes the
+// sponsorship of the caller to the given sender.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	sender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid sender hex address")
+	}
+
+	paymaster := contract.CallerAddress
+	if err := p.paymasterKeeper.Revoke(ctx, paymaster, sender); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeEvent(ctx, stateDB, paymaster, sender); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SelectPaymaster implements the selectPaymaster precompile transaction, which
+// opts the caller in to have the fees of its transactions paid by the
+// sponsorship of the given paymaster. The zero address clears the selection.
+func (p Precompile) SelectPaymaster(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	paymaster, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid paymaster hex address")
+	}
+
+	sender := contract.CallerAddress
+	if err := p.paymasterKeeper.SelectPaymaster(ctx, sender, paymaster); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSelectPaymasterEvent(ctx, stateDB, sender, paymaster); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
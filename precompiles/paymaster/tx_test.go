@@ -0,0 +1,279 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package paymaster_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/paymaster"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestSponsor() {
+	method := s.precompile.Methods[paymaster.SponsorMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid sender address",
+			func() []interface{} {
+				return []interface{}{"", big.NewInt(100)}
+			},
+			func() {},
+			true,
+			"invalid sender hex address",
+		},
+		{
+			"fail - invalid spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "100"}
+			},
+			func() {},
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - paymaster sponsors itself",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(100)}
+			},
+			func() {},
+			true,
+			"paymaster cannot sponsor itself",
+		},
+		{
+			"fail - zero spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), big.NewInt(0)}
+			},
+			func() {},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"success - sponsorship created",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), big.NewInt(100)}
+			},
+			func() {
+				sponsorship, found := s.network.App.PaymasterKeeper.GetSponsorship(
+					s.network.GetContext(), s.keyring.GetAddr(1), s.keyring.GetAddr(0),
+				)
+				s.Require().True(found)
+				s.Require().Equal(math.NewInt(100), sponsorship.SpendLimit)
+				s.Require().Equal(math.ZeroInt(), sponsorship.Spent)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.Sponsor(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[paymaster.RevokeMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid sender address",
+			func() []interface{} {
+				return []interface{}{""}
+			},
+			true,
+			"invalid sender hex address",
+		},
+		{
+			"fail - sponsorship not found",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			true,
+			"sponsorship not found",
+		},
+		{
+			"success - sponsorship revoked",
+			func() []interface{} {
+				_, err := s.network.App.PaymasterKeeper.Sponsor(
+					s.network.GetContext(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), math.NewInt(100),
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.Revoke(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+
+				_, found := s.network.App.PaymasterKeeper.GetSponsorship(
+					s.network.GetContext(), s.keyring.GetAddr(1), s.keyring.GetAddr(0),
+				)
+				s.Require().False(found)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSelectPaymaster() {
+	method := s.precompile.Methods[paymaster.SelectPaymasterMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expSelected bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid paymaster address",
+			func() []interface{} {
+				return []interface{}{""}
+			},
+			false,
+			true,
+			"invalid paymaster hex address",
+		},
+		{
+			"fail - sender selects itself",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			false,
+			true,
+			"sender cannot select itself as paymaster",
+		},
+		{
+			"success - paymaster selected",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			true,
+			false,
+			"",
+		},
+		{
+			"success - selection cleared",
+			func() []interface{} {
+				err := s.network.App.PaymasterKeeper.SelectPaymaster(
+					s.network.GetContext(), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				)
+				s.Require().NoError(err)
+				return []interface{}{common.Address{}}
+			},
+			false,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200000,
+			)
+
+			res, err := s.precompile.SelectPaymaster(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+
+				selected, found := s.network.App.PaymasterKeeper.GetSelectedPaymaster(
+					s.network.GetContext(), s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(tc.expSelected, found)
+				if tc.expSelected {
+					s.Require().Equal(s.keyring.GetAddr(1), selected)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ string constant MSG_FUND_VESTING_ACCOUNT = "/evmos.vesting.v2.MsgFundVestingAcco
 string constant MSG_CLAWBACK = "/evmos.vesting.v2.MsgClawback";
 string constant MSG_CONVERT_VESTING_ACCOUNT = "/evmos.vesting.v2.MsgConvertVestingAccount";
 string constant MSG_UPDATE_VESTING_FUNDER = "/evmos.vesting.v2.MsgUpdateVestingFunder";
+string constant MSG_UPDATE_VESTING_SCHEDULE = "/evmos.vesting.v2.MsgUpdateVestingSchedule";
 
 // Period defines a length of time and amount of coins that will vest.
 struct Period {
@@ -22,6 +23,23 @@ struct Period {
     Coin[] amount;
 }
 
+/// @dev ScheduleEvent defines the coins unlocked and vested at a time of the schedule of a vesting account.
+struct ScheduleEvent {
+    uint64 time;
+    Coin[] unlocked;
+    Coin[] vested;
+    Coin[] totalUnlocked;
+    Coin[] totalVested;
+}
+
+/// @dev VestingStream defines coins vesting linearly every second after a cliff.
+struct VestingStream {
+    int64 startTime;
+    int64 cliff;
+    int64 duration;
+    Coin[] amount;
+}
+
 /// @author Evmos Team
 /// @title Vesting Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with vesting.
@@ -83,6 +101,18 @@ interface VestingI {
         address  newFunderAddress
     );
 
+    /// @dev Defines an event that is emitted when the schedules of a vesting account are updated.
+    /// @param funderAddress The address of the account that funded the vesting account.
+    /// @param vestingAddress The address of the vesting account.
+    /// @param lockupPeriods The new lockup periods of the vesting account.
+    /// @param vestingPeriods The new vesting periods of the vesting account.
+    event UpdateVestingSchedule(
+        address indexed funderAddress,
+        address indexed vestingAddress,
+        Period[] lockupPeriods,
+        Period[] vestingPeriods
+    );
+
     /// @dev Defines an event that is emitted when a vesting account is converted to a clawback vesting account.
     /// @param vestingAddress The address of the vesting account.
     event ConvertVestingAccount(
@@ -142,6 +172,19 @@ interface VestingI {
         address vestingAddress
     ) external returns (bool success);
 
+    /// @dev Defines a method for updating the schedules of a vesting account. Empty schedules are left
+    /// unchanged. The new schedules can only top up the account, extend the lockup or accelerate the vesting.
+    /// @param funderAddress The address of the account that funded the vesting account.
+    /// @param vestingAddress The address of the vesting account.
+    /// @param lockupPeriods The new lockup periods of the vesting account.
+    /// @param vestingPeriods The new vesting periods of the vesting account.
+    function updateVestingSchedule(
+        address funderAddress,
+        address vestingAddress,
+        Period[] calldata lockupPeriods,
+        Period[] calldata vestingPeriods
+    ) external returns (bool success);
+
     /// @dev Defines a method for converting a clawback vesting account to an eth account
     /// @param vestingAddress The address of the vesting account.
     function convertVestingAccount(
@@ -155,4 +198,24 @@ interface VestingI {
     function balances(
         address vestingAddress
     ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+
+    /// @dev Defines a query for getting the vesting accounts funded by a funder.
+    /// @param funderAddress The address of the funder.
+    /// @param pageRequest The pagination request.
+    function vestingAccountsByFunder(
+        address funderAddress,
+        PageRequest calldata pageRequest
+    ) external view returns (address[] memory vestingAddresses, PageResponse memory pageResponse);
+
+    /// @dev Defines a query for getting the unlocking and vesting timeline of a vesting account,
+    /// merged across its lockup and vesting periods and vesting streams.
+    /// @param vestingAddress The address of the vesting account.
+    function schedule(
+        address vestingAddress
+    ) external view returns (
+        uint64 startTime,
+        uint64 endTime,
+        ScheduleEvent[] memory events,
+        VestingStream[] memory vestingStreams
+    );
 }
@@ -20,6 +20,8 @@ var (
 	FundVestingAccountMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgFundVestingAccount{})
 	// UpdateVestingFunderMsgURL defines the vesting authorization type for MsgUpdateVestingFunder
 	UpdateVestingFunderMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgUpdateVestingFunder{})
+	// UpdateVestingScheduleMsgURL defines the vesting authorization type for MsgUpdateVestingSchedule
+	UpdateVestingScheduleMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgUpdateVestingSchedule{})
 	// ClawbackMsgURL defines the vesting authorization type for MsgClawback
 	ClawbackMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgClawback{})
 )
@@ -38,7 +40,7 @@ func (p Precompile) Approve(
 	}
 
 	switch typeURL {
-	case FundVestingAccountMsgURL, ClawbackMsgURL, UpdateVestingFunderMsgURL:
+	case FundVestingAccountMsgURL, ClawbackMsgURL, UpdateVestingFunderMsgURL, UpdateVestingScheduleMsgURL:
 		if err := CreateGenericAuthz(ctx, p.AuthzKeeper, grantee, origin, typeURL); err != nil {
 			return nil, err
 		}
@@ -24,6 +24,8 @@ const (
 	EventTypeClawback = "Clawback"
 	// EventTypeUpdateVestingFunder defines the event type for the vesting UpdateVestingFunder transaction.
 	EventTypeUpdateVestingFunder = "UpdateVestingFunder"
+	// EventTypeUpdateVestingSchedule defines the event type for the vesting UpdateVestingSchedule transaction.
+	EventTypeUpdateVestingSchedule = "UpdateVestingSchedule"
 	// EventTypeConvertVestingAccount defines the event type for the vesting ConvertVestingAccount transaction.
 	EventTypeConvertVestingAccount = "ConvertVestingAccount"
 )
@@ -235,6 +237,50 @@ func (p Precompile) EmitUpdateVestingFunderEvent(
 	return nil
 }
 
+// EmitUpdateVestingScheduleEvent creates a new update vesting schedule event emitted on a UpdateVestingSchedule transaction.
+func (p Precompile) EmitUpdateVestingScheduleEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funderAddr, vestingAddr common.Address,
+	lockupPeriods *LockupPeriods,
+	vestingPeriods *VestingPeriods,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeUpdateVestingSchedule]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funderAddr)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(lockupPeriods.LockupPeriods, vestingPeriods.VestingPeriods)
+	if err != nil {
+		return err
+	}
+
+	// Create the event
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
 // EmitConvertVestingAccountEvent creates a new convert vesting account event emitted on a ConvertVestingAccount transaction.
 func (p Precompile) EmitConvertVestingAccountEvent(ctx sdk.Context, stateDB vm.StateDB, vestingAddr common.Address) error {
 	// Prepare the event topics
@@ -10,6 +10,10 @@ import (
 const (
 	// BalancesMethod defines the ABI method name for the Balances query.
 	BalancesMethod = "balances"
+	// VestingAccountsByFunderMethod defines the ABI method name for the VestingAccountsByFunder query.
+	VestingAccountsByFunderMethod = "vestingAccountsByFunder"
+	// ScheduleMethod defines the ABI method name for the Schedule query.
+	ScheduleMethod = "schedule"
 )
 
 // Balances queries the balances of a clawback vesting account.
@@ -32,3 +36,48 @@ func (p Precompile) Balances(
 
 	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested)
 }
+
+// VestingAccountsByFunder queries the clawback vesting accounts funded by a funder.
+func (p Precompile) VestingAccountsByFunder(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVestingAccountsByFunderRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.vestingKeeper.VestingAccountsByFunder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(VestingAccountsByFunderOutput).FromResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.VestingAddresses, out.PageResponse)
+}
+
+// Schedule queries the unlocking and vesting timeline of a clawback vesting account.
+func (p Precompile) Schedule(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewScheduleRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.vestingKeeper.Schedule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ScheduleOutput).FromResponse(response)
+
+	return method.Outputs.Pack(out.StartTime, out.EndTime, out.Events, out.VestingStreams)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/vesting"
 )
@@ -96,3 +98,157 @@ func (s *PrecompileTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestVestingAccountsByFunder() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - no vesting accounts",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{},
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingAccountsByFunderOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingAccountsByFunderMethod, data)
+				s.Require().NoError(err)
+				s.Require().Empty(out.VestingAddresses)
+			},
+			false,
+			"",
+		},
+		{
+			"success - should return the vesting accounts of the funder",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(ctx, s.keyring.GetAddr(0), toAddr)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{CountTotal: true},
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingAccountsByFunderOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingAccountsByFunderMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal([]common.Address{toAddr}, out.VestingAddresses)
+				s.Require().Equal(uint64(1), out.PageResponse.Total)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(2) // reset
+			ctx = s.network.GetContext()
+			method := s.precompile.Methods[vesting.VestingAccountsByFunderMethod]
+
+			bz, err := s.precompile.VestingAccountsByFunder(ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSchedule() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - account is not a vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+				}
+			},
+			func([]byte) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - should return the vesting account schedule",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(ctx, s.keyring.GetAddr(0), toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr,
+				}
+			},
+			func(data []byte) {
+				var out vesting.ScheduleOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.ScheduleMethod, data)
+				s.Require().NoError(err)
+				// the lockup period ends between the second and third vesting periods
+				s.Require().Len(out.Events, len(vestingPeriods)+len(lockupPeriods))
+				s.Require().Equal(out.StartTime+2000, out.Events[0].Time)
+				s.Require().Equal(quarter, out.Events[0].Vested)
+				s.Require().Equal(out.StartTime+5000, out.Events[2].Time)
+				s.Require().Equal(balances, out.Events[2].Unlocked)
+				s.Require().Equal(out.StartTime+8000, out.EndTime)
+				s.Require().Empty(out.VestingStreams)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(2) // reset
+			ctx = s.network.GetContext()
+			method := s.precompile.Methods[vesting.ScheduleMethod]
+
+			bz, err := s.precompile.Schedule(ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
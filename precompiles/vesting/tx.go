@@ -26,6 +26,8 @@ const (
 	ClawbackMethod = "clawback"
 	// UpdateVestingFunderMethod defines the ABI method name for the vesting UpdateVestingFunder transaction.
 	UpdateVestingFunderMethod = "updateVestingFunder"
+	// UpdateVestingScheduleMethod defines the ABI method name for the vesting UpdateVestingSchedule transaction.
+	UpdateVestingScheduleMethod = "updateVestingSchedule"
 	// ConvertVestingAccountMethod defines the ABI method name for the vesting ConvertVestingAccount transaction.
 	ConvertVestingAccountMethod = "convertVestingAccount"
 )
@@ -268,6 +270,73 @@ func (p *Precompile) UpdateVestingFunder(
 	return method.Outputs.Pack(true)
 }
 
+// UpdateVestingSchedule updates the lockup and vesting schedules of a clawback vesting account
+func (p *Precompile) UpdateVestingSchedule(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderAddr, vestingAddr, lockupPeriods, vestingPeriods, err := NewMsgUpdateVestingSchedule(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	isContractCaller := contract.CallerAddress != origin
+
+	// funder can only be the origin or the contract.Caller
+	isContractFunder := contract.CallerAddress == funderAddr && isContractCaller
+
+	if !isContractFunder && origin != funderAddr {
+		return nil, fmt.Errorf(ErrDifferentFunderOrigin, origin, funderAddr)
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder_address: %s, vesting_address: %s, lockup_periods: %s, vesting_periods: %s }",
+			msg.FunderAddress, msg.VestingAddress, msg.LockupPeriods, msg.VestingPeriods,
+		),
+	)
+
+	// in case the contract is the funder
+	// don't check for auth.
+	// The smart contract (funder) should handle who is authorized to make this call
+	if isContractCaller && !isContractFunder {
+		// if calling from a contract and the contract is not the funder (origin == funderAddr)
+		// check that an authorization exists
+		_, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, funderAddr, UpdateVestingScheduleMsgURL)
+		if err != nil {
+			return nil, fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, UpdateVestingScheduleMsgURL, contract.CallerAddress)
+		}
+	}
+
+	response, err := p.vestingKeeper.UpdateVestingSchedule(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	evmDenomAmt := response.TopUp.AmountOf(evmtypes.GetEVMCoinDenom())
+	if isContractCaller && evmDenomAmt.IsPositive() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		// Need to scale the amount to 18 decimals for the EVM balance change entry
+		amt := evmtypes.ConvertAmountTo18DecimalsBigInt(evmDenomAmt.BigInt())
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(funderAddr, amt, cmn.Sub),
+			cmn.NewBalanceChangeEntry(vestingAddr, amt, cmn.Add),
+		)
+	}
+
+	if err = p.EmitUpdateVestingScheduleEvent(ctx, stateDB, funderAddr, vestingAddr, lockupPeriods, vestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 // ConvertVestingAccount converts a clawback vesting account to a base account once the vesting period is over.
 func (p *Precompile) ConvertVestingAccount(
 	ctx sdk.Context,
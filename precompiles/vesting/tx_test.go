@@ -391,6 +391,99 @@ func (s *PrecompileTestSuite) TestUpdateVestingFunder() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestUpdateVestingSchedule() {
+	var ctx sdk.Context
+	extendedLockupPeriods := []vesting.Period{{Length: 10000, Amount: balances}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name: "fail - different origin than funder address",
+			malleate: func() []interface{} {
+				differentAddr := evmosutiltx.GenerateAddress()
+				return []interface{}{
+					differentAddr,
+					toAddr,
+					extendedLockupPeriods,
+					[]vesting.Period{},
+				}
+			},
+			gas:         200000,
+			expError:    true,
+			errContains: "does not match the funder address",
+		},
+		{
+			"success - extend the lockup",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(ctx, s.keyring.GetAddr(0), toAddr)
+				fundMsg := vestingtypes.NewMsgFundVestingAccount(s.keyring.GetAccAddr(0), toAddr.Bytes(), ctx.BlockTime(), sdkLockupPeriods, sdkVestingPeriods)
+				_, err := s.network.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					toAddr,
+					extendedLockupPeriods,
+					[]vesting.Period{},
+				}
+			},
+			20000,
+			func(data []byte) {
+				success, err := s.precompile.Unpack(vesting.UpdateVestingScheduleMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(success[0], true)
+
+				// Check if the lockup of the vesting account was extended
+				vestingAcc := s.network.App.AccountKeeper.GetAccount(ctx, toAddr.Bytes())
+				va, ok := vestingAcc.(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Len(va.LockupPeriods, 1)
+				s.Require().Equal(int64(10000), va.LockupPeriods[0].Length)
+				s.Require().Equal(balancesSdkCoins, va.OriginalVesting)
+				s.Require().Len(va.VestingPeriods, len(sdkVestingPeriods))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(2)
+			ctx = s.network.GetContext()
+			method := s.precompile.Methods[vesting.UpdateVestingScheduleMethod]
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.UpdateVestingSchedule(ctx, contract, s.keyring.GetAddr(0), s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestConvertVestingAccount() {
 	var ctx sdk.Context
 
@@ -3,6 +3,7 @@
 package vesting
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	cosmosvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -131,6 +133,39 @@ func NewMsgFundVestingAccount(args []interface{}, method *abi.Method) (*vestingt
 	return msg, funderAddress, vestingAddress, &lockupPeriodsInput, &vestingPeriodsInput, nil
 }
 
+// NewMsgUpdateVestingSchedule creates a new MsgUpdateVestingSchedule instance.
+func NewMsgUpdateVestingSchedule(args []interface{}, method *abi.Method) (*vestingtypes.MsgUpdateVestingSchedule, common.Address, common.Address, *LockupPeriods, *VestingPeriods, error) {
+	funderAddress, vestingAddress, err := validateBasicArgs(args, 4)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, nil, nil, err
+	}
+
+	var lockupPeriodsInput LockupPeriods
+	lockupPeriod := abi.Arguments{method.Inputs[2]}
+	if err := lockupPeriod.Copy(&lockupPeriodsInput, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, nil, fmt.Errorf("error while unpacking args to lockupPeriods struct: %s", err)
+	}
+
+	var vestingPeriodsInput VestingPeriods
+	vestingPeriod := abi.Arguments{method.Inputs[3]}
+	if err := vestingPeriod.Copy(&vestingPeriodsInput, []interface{}{args[3]}); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, nil, fmt.Errorf("error while unpacking args to vestingPeriods struct: %s", err)
+	}
+
+	msg := &vestingtypes.MsgUpdateVestingSchedule{
+		FunderAddress:  sdk.AccAddress(funderAddress.Bytes()).String(),
+		VestingAddress: sdk.AccAddress(vestingAddress.Bytes()).String(),
+		LockupPeriods:  createCosmosPeriodsFromPeriod(lockupPeriodsInput.LockupPeriods),
+		VestingPeriods: createCosmosPeriodsFromPeriod(vestingPeriodsInput.VestingPeriods),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, nil, err
+	}
+
+	return msg, funderAddress, vestingAddress, &lockupPeriodsInput, &vestingPeriodsInput, nil
+}
+
 // NewMsgClawback creates a new MsgClawback instance.
 func NewMsgClawback(args []interface{}) (*vestingtypes.MsgClawback, common.Address, common.Address, common.Address, error) {
 	funderAddress, accountAddress, err := validateBasicArgs(args, 3)
@@ -230,6 +265,50 @@ func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest,
 	return msg, nil
 }
 
+// VestingAccountsByFunderInput is a struct to represent the input information for
+// the vestingAccountsByFunder query. Needed to unpack arguments into the PageRequest struct.
+type VestingAccountsByFunderInput struct {
+	FunderAddress common.Address
+	PageRequest   query.PageRequest
+}
+
+// NewVestingAccountsByFunderRequest creates a new QueryVestingAccountsByFunderRequest instance.
+func NewVestingAccountsByFunderRequest(method *abi.Method, args []interface{}) (*vestingtypes.QueryVestingAccountsByFunderRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input VestingAccountsByFunderInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to VestingAccountsByFunderInput struct: %s", err)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &vestingtypes.QueryVestingAccountsByFunderRequest{
+		FunderAddress: sdk.AccAddress(input.FunderAddress.Bytes()).String(),
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// NewScheduleRequest creates a new QueryScheduleRequest instance.
+func NewScheduleRequest(args []interface{}) (*vestingtypes.QueryScheduleRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	}
+
+	return &vestingtypes.QueryScheduleRequest{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+	}, nil
+}
+
 // validateBasicArgs validates the basic arguments and length of the provided arguments.
 func validateBasicArgs(args []interface{}, expectedLength int) (common.Address, common.Address, error) {
 	if len(args) != expectedLength {
@@ -292,3 +371,83 @@ func (co *ClawbackOutput) FromResponse(res *vestingtypes.MsgClawbackResponse) *C
 	co.Coins = cmn.NewCoinsResponse(res.Coins)
 	return co
 }
+
+// VestingAccountsByFunderOutput represents the clawback vesting accounts of a funder
+type VestingAccountsByFunderOutput struct {
+	VestingAddresses []common.Address
+	PageResponse     query.PageResponse
+}
+
+// FromResponse populates the VestingAccountsByFunderOutput from a QueryVestingAccountsByFunderResponse.
+func (vo *VestingAccountsByFunderOutput) FromResponse(res *vestingtypes.QueryVestingAccountsByFunderResponse) (*VestingAccountsByFunderOutput, error) {
+	vo.VestingAddresses = make([]common.Address, len(res.VestingAddresses))
+	for i, addr := range res.VestingAddresses {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		vo.VestingAddresses[i] = common.BytesToAddress(accAddr.Bytes())
+	}
+
+	if res.Pagination != nil {
+		vo.PageResponse.Total = res.Pagination.Total
+		vo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return vo, nil
+}
+
+// ScheduleEvent represents the coins unlocked and vested at a time of the
+// schedule of a ClawbackVestingAccount
+type ScheduleEvent struct {
+	Time          uint64
+	Unlocked      []cmn.Coin
+	Vested        []cmn.Coin
+	TotalUnlocked []cmn.Coin
+	TotalVested   []cmn.Coin
+}
+
+// VestingStream represents a continuous vesting stream of a ClawbackVestingAccount
+type VestingStream struct {
+	StartTime int64
+	Cliff     int64
+	Duration  int64
+	Amount    []cmn.Coin
+}
+
+// ScheduleOutput represents the unlocking and vesting timeline of a ClawbackVestingAccount
+type ScheduleOutput struct {
+	StartTime      uint64
+	EndTime        uint64
+	Events         []ScheduleEvent
+	VestingStreams []VestingStream
+}
+
+// FromResponse populates the ScheduleOutput from a QueryScheduleResponse.
+func (so *ScheduleOutput) FromResponse(res *vestingtypes.QueryScheduleResponse) *ScheduleOutput {
+	so.StartTime = uint64(res.StartTime.Unix()) //nolint:gosec // G115
+	so.EndTime = uint64(res.EndTime.Unix())     //nolint:gosec // G115
+
+	so.Events = make([]ScheduleEvent, len(res.Events))
+	for i, event := range res.Events {
+		so.Events[i] = ScheduleEvent{
+			Time:          uint64(event.Time.Unix()), //nolint:gosec // G115
+			Unlocked:      cmn.NewCoinsResponse(event.Unlocked),
+			Vested:        cmn.NewCoinsResponse(event.Vested),
+			TotalUnlocked: cmn.NewCoinsResponse(event.TotalUnlocked),
+			TotalVested:   cmn.NewCoinsResponse(event.TotalVested),
+		}
+	}
+
+	so.VestingStreams = make([]VestingStream, len(res.VestingStreams))
+	for i, stream := range res.VestingStreams {
+		so.VestingStreams[i] = VestingStream{
+			StartTime: stream.StartTime,
+			Cliff:     stream.Cliff,
+			Duration:  stream.Duration,
+			Amount:    cmn.NewCoinsResponse(stream.Amount),
+		}
+	}
+
+	return so
+}
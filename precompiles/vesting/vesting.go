@@ -105,11 +105,17 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Clawback(ctx, contract, evm.Origin, stateDB, method, args)
 	case UpdateVestingFunderMethod:
 		bz, err = p.UpdateVestingFunder(ctx, contract, evm.Origin, stateDB, method, args)
+	case UpdateVestingScheduleMethod:
+		bz, err = p.UpdateVestingSchedule(ctx, contract, evm.Origin, stateDB, method, args)
 	case ConvertVestingAccountMethod:
 		bz, err = p.ConvertVestingAccount(ctx, stateDB, method, args)
 	// Vesting queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
+	case VestingAccountsByFunderMethod:
+		bz, err = p.VestingAccountsByFunder(ctx, method, args)
+	case ScheduleMethod:
+		bz, err = p.Schedule(ctx, method, args)
 	}
 
 	if err != nil {
@@ -136,6 +142,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - FundVestingAccount
 //   - Clawback
 //   - UpdateVestingFunder
+//   - UpdateVestingSchedule
 //   - ConvertVestingAccount
 //   - Approve
 func (Precompile) IsTransaction(method *abi.Method) bool {
@@ -144,6 +151,7 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		FundVestingAccountMethod,
 		ClawbackMethod,
 		UpdateVestingFunderMethod,
+		UpdateVestingScheduleMethod,
 		ConvertVestingAccountMethod,
 		authorization.ApproveMethod:
 		return true
@@ -10,7 +10,9 @@ import (
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -188,6 +190,17 @@ func (b *Backend) FeeHistory(
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
 
+	// without rewards, the base fees and gas used ratios are read from the fee
+	// market base fee history when it covers the whole block range, instead of
+	// fetching every block and its results
+	if !calculateRewards && b.baseFeeHistory(blockStart, blockEnd, thisBaseFee, thisGasUsedRatio) {
+		return &rpctypes.FeeHistoryResult{
+			OldestBlock:  oldestBlock,
+			BaseFee:      thisBaseFee,
+			GasUsedRatio: thisGasUsedRatio,
+		}, nil
+	}
+
 	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G701 G115
@@ -243,6 +256,58 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// baseFeeHistory fills the base fees and gas used ratios of the given block
+// range from the fee market base fee history. The next base fee of the last
+// block is calculated from the latest header, as done when processing the
+// blocks. It returns false if the history does not cover the whole range or
+// lacks the gas limit of a block.
+func (b *Backend) baseFeeHistory(blockStart, blockEnd int64, baseFees []*hexutil.Big, gasUsedRatios []float64) bool {
+	blocks := blockEnd + 1 - blockStart
+	res, err := b.queryClient.FeeMarket.BaseFeeHistory(
+		rpctypes.ContextWithHeight(blockEnd),
+		&feemarkettypes.QueryBaseFeeHistoryRequest{
+			StartHeight: blockStart,
+			EndHeight:   blockEnd,
+			Pagination:  &query.PageRequest{Limit: uint64(blocks)}, //nolint:gosec // G115 -- blocks is positive
+		},
+	)
+	if err != nil || int64(len(res.Entries)) != blocks {
+		return false
+	}
+
+	for i, entry := range res.Entries {
+		// entries recorded without the block gas limit are left to the per
+		// block path
+		if entry.Height != blockStart+int64(i) || entry.GasLimit == 0 {
+			return false
+		}
+
+		gasLimit := entry.GasLimit
+		if gasLimit == -1 {
+			// same gas limit as the one reported for blocks without a block
+			// gas limit, see BlockMaxGasFromConsensusParams
+			gasLimit = int64(^uint32(0))
+		}
+
+		baseFee := evmtypes.ConvertAmountTo18DecimalsLegacy(entry.BaseFee).TruncateInt().BigInt()
+		baseFees[i] = (*hexutil.Big)(baseFee)
+		gasUsedRatios[i] = float64(entry.GasUsed) / float64(gasLimit)
+	}
+
+	nextBaseFee := new(big.Int)
+	cfg := b.ChainConfig()
+	if cfg.IsLondon(big.NewInt(blockEnd + 1)) {
+		header, err := b.CurrentHeader()
+		if err != nil {
+			return false
+		}
+		nextBaseFee = misc.CalcBaseFee(cfg, header)
+	}
+	baseFees[blocks] = (*hexutil.Big)(nextBaseFee)
+
+	return true
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
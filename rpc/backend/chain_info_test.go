@@ -19,6 +19,7 @@ import (
 	rpc "github.com/evmos/evmos/v20/rpc/types"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 func (suite *BackendTestSuite) TestBaseFee() {
@@ -452,3 +453,119 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestFeeHistoryFromBaseFeeHistory() {
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		expFeeHistory *rpc.FeeHistoryResult
+	}{
+		{
+			"pass - base fees and gas used ratios read from the base fee history",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBaseFeeHistory(feeMarketClient, 1, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.LegacyNewDec(2), GasUsed: 1000, GasLimit: 10000},
+				})
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterParams(queryClient, &header, 1)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0.1},
+			},
+		},
+		{
+			"pass - unlimited block gas read from the base fee history",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBaseFeeHistory(feeMarketClient, 1, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.LegacyNewDec(2), GasUsed: 1000, GasLimit: -1},
+				})
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterParams(queryClient, &header, 1)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock: (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:     []*hexutil.Big{(*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(1))},
+				// a block without block gas limit is reported with the max uint32
+				GasUsedRatio: []float64{1000 / float64(^uint32(0))},
+			},
+		},
+		{
+			"pass - blocks processed when the base fee history lacks the block gas limit",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBaseFeeHistory(feeMarketClient, 1, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.LegacyNewDec(2), GasUsed: 1000},
+				})
+				RegisterConsensusParams(client, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterValidatorAccount(queryClient, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+				RegisterParams(queryClient, &header, 1)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0},
+			},
+		},
+		{
+			"pass - blocks processed when the base fee history does not cover the range",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBaseFeeHistory(feeMarketClient, 1, []feemarkettypes.BaseFeeHistoryEntry{})
+				RegisterConsensusParams(client, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterValidatorAccount(queryClient, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+				RegisterParams(queryClient, &header, 1)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+			tc.registerMock()
+
+			feeHistory, err := suite.backend.FeeHistory(1, 1, nil)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFeeHistory, feeHistory)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package backend
 
 import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v20/rpc/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -19,3 +21,9 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BaseFeeHistory
+func RegisterBaseFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height int64, entries []feemarkettypes.BaseFeeHistoryEntry) {
+	feeMarketClient.On("BaseFeeHistory", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryBaseFeeHistoryRequest")).
+		Return(&feemarkettypes.QueryBaseFeeHistoryResponse{Entries: entries}, nil)
+}
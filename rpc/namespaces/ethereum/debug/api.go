@@ -16,6 +16,8 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 
+	srvflags "github.com/evmos/evmos/v20/server/flags"
+	"github.com/evmos/evmos/v20/x/evm/profiler"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 
 	stderrors "github.com/pkg/errors"
@@ -155,6 +157,31 @@ func (a *API) MemStats() *runtime.MemStats {
 	return s
 }
 
+// EvmProfile returns the gas consumed per contract, function selector and
+// opcode by the EVM transactions executed since the node started or the last
+// reset, sorted by gas consumed. If limit is positive, at most limit entries
+// are returned per category. If reset is true, the profile is discarded once
+// returned. The node must run with the profile EVM tracer.
+func (a *API) EvmProfile(limit *int, reset *bool) (*profiler.Profile, error) {
+	a.logger.Debug("debug_evmProfile", "limit", limit, "reset", reset)
+	if tracer := a.ctx.Viper.GetString(srvflags.EVMTracer); tracer != evmtypes.TracerProfile {
+		return nil, fmt.Errorf("EVM profiling is disabled, start the node with --%s=%s", srvflags.EVMTracer, evmtypes.TracerProfile)
+	}
+
+	var n int
+	if limit != nil {
+		n = *limit
+	}
+
+	if reset != nil && *reset {
+		profile := profiler.DefaultProfiler.ProfileAndReset(n)
+		return &profile, nil
+	}
+
+	profile := profiler.DefaultProfiler.Profile(n)
+	return &profile, nil
+}
+
 // SetBlockProfileRate sets the rate of goroutine block profile data collection.
 // rate 0 disables block profiling.
 func (a *API) SetBlockProfileRate(rate int) {
@@ -153,7 +153,7 @@ const (
 // DefaultRosettaGasPrices defines the default list of prices to suggest
 var DefaultRosettaGasPrices = sdk.NewDecCoins(sdk.NewDecCoin(DefaultRosettaDenomToSuggest, math.NewInt(4_000_000)))
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var evmTracers = []string{"json", "markdown", "struct", "access_list", "profile"}
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -12,7 +12,10 @@ const DefaultEVMConfigTemplate = `
 
 # Tracer defines the 'vm.Tracer' type that the EVM will use when the node is run in
 # debug mode. To enable tracing use the '--evm.tracer' flag when starting your node.
-# Valid types are: json|struct|access_list|markdown
+# Valid types are: json|struct|access_list|markdown|profile
+# The 'profile' tracer aggregates the gas consumed per contract, function selector
+# and opcode, which is exposed through the 'debug_evmProfile' JSON-RPC method. The
+# gas consumed per opcode is also reported to the telemetry sink.
 tracer = "{{ .EVM.Tracer }}"
 
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
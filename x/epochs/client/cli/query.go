@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdHookResults(),
+		GetCmdNextEpochStart(),
 	)
 
 	return cmd
@@ -110,3 +112,75 @@ func GetCmdCurrentEpoch() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdHookResults provides the results of the last hook executions by
+// specified identifier
+func GetCmdHookResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-results IDENTIFIER",
+		Short: "Query the results of the last epoch hook executions by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs hook-results day`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HookResults(cmd.Context(), &types.QueryHookResultsRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdNextEpochStart provides the expected start time of the next epoch by
+// specified identifier
+func GetCmdNextEpochStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch-start IDENTIFIER",
+		Short: "Query the expected start time of the next epoch by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs next-epoch-start day`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextEpochStart(cmd.Context(), &types.QueryNextEpochStartRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,6 +28,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 
 		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
+		var hookResults []types.HookResult
 		switch {
 		case shouldInitialEpochStart:
 			epochInfo.StartInitialEpoch()
@@ -44,7 +45,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
 				),
 			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			hookResults = k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
 		default:
 			// continue
 			return false
@@ -60,7 +61,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 			),
 		)
 
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		hookResults = append(hookResults, k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)...)
+		k.SetHookResults(ctx, epochInfo.Identifier, hookResults)
 
 		return false
 	})
@@ -69,3 +69,49 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// HookResults provides the results of the last hook executions of specified
+// identifier
+func (k Keeper) HookResults(
+	c context.Context,
+	req *types.QueryHookResultsRequest,
+) (*types.QueryHookResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	return &types.QueryHookResultsResponse{
+		Results: k.GetHookResults(ctx, req.Identifier),
+	}, nil
+}
+
+// NextEpochStart provides the expected start time of the next epoch of
+// specified identifier
+func (k Keeper) NextEpochStart(
+	c context.Context,
+	req *types.QueryNextEpochStartRequest,
+) (*types.QueryNextEpochStartResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	nextEpochNumber, nextEpochStartTime := info.NextEpochStart()
+
+	return &types.QueryNextEpochStartResponse{
+		NextEpochNumber:    nextEpochNumber,
+		NextEpochStartTime: nextEpochStartTime,
+	}, nil
+}
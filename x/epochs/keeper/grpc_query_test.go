@@ -200,3 +200,80 @@ func TestCurrentEpoch(t *testing.T) {
 		})
 	}
 }
+
+func TestNextEpochStart(t *testing.T) {
+	var (
+		// suite is defined here so it is available inside the malleate function.
+		suite  *KeeperTestSuite
+		req    *types.QueryNextEpochStartRequest
+		expRes *types.QueryNextEpochStartResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func() sdktypes.Context
+		expPass  bool
+	}{
+		{
+			"fail - unknown identifier",
+			func() sdktypes.Context {
+				req = &types.QueryNextEpochStartRequest{Identifier: "unknown"}
+				return suite.network.GetContext()
+			},
+			false,
+		},
+		{
+			"pass - started epoch",
+			func() sdktypes.Context {
+				ctx := suite.network.GetContext()
+				dayEpoch, found := suite.network.App.EpochsKeeper.GetEpochInfo(ctx, types.DayEpochID)
+				require.True(t, found)
+
+				req = &types.QueryNextEpochStartRequest{Identifier: types.DayEpochID}
+				expRes = &types.QueryNextEpochStartResponse{
+					NextEpochNumber:    2,
+					NextEpochStartTime: dayEpoch.CurrentEpochStartTime.Add(day),
+				}
+				return ctx
+			},
+			true,
+		},
+		{
+			"pass - epoch not started yet",
+			func() sdktypes.Context {
+				ctx := suite.network.GetContext()
+				startTime := ctx.BlockTime().Add(time.Hour)
+
+				suite.network.App.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{
+					Identifier:              "quarter",
+					StartTime:               startTime,
+					Duration:                time.Hour * 24 * 30 * 3,
+					CurrentEpochStartHeight: ctx.BlockHeight(),
+				})
+
+				req = &types.QueryNextEpochStartRequest{Identifier: "quarter"}
+				expRes = &types.QueryNextEpochStartResponse{
+					NextEpochNumber:    1,
+					NextEpochStartTime: startTime,
+				}
+				return ctx
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			suite = SetupTest([]types.EpochInfo{})
+			ctx := tc.malleate()
+
+			res, err := suite.network.GetEpochsClient().NextEpochStart(ctx, req)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, expRes.NextEpochNumber, res.NextEpochNumber)
+				require.Equal(t, expRes.NextEpochStartTime.UTC(), res.NextEpochStartTime.UTC())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+// GetHookResults returns the results of the last hook executions of the epoch
+// identifier
+func (k Keeper) GetHookResults(ctx sdk.Context, identifier string) []types.HookResult {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookResults)
+	bz := store.Get([]byte(identifier))
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var hookResults types.HookResults
+	k.cdc.MustUnmarshal(bz, &hookResults)
+	return hookResults.Results
+}
+
+// SetHookResults sets the results of the last hook executions of the epoch
+// identifier
+func (k Keeper) SetHookResults(ctx sdk.Context, identifier string, results []types.HookResult) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookResults)
+	bz := k.cdc.MustMarshal(&types.HookResults{Results: results})
+	store.Set([]byte(identifier), bz)
+}
+
+// DeleteHookResults deletes the results of the last hook executions of the
+// epoch identifier
+func (k Keeper) DeleteHookResults(ctx sdk.Context, identifier string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookResults)
+	store.Delete([]byte(identifier))
+}
@@ -4,7 +4,15 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/evmos/v20/x/epochs/types"
 )
 
@@ -33,12 +41,164 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
-// AfterEpochEnd executes the indicated hook after epochs ends
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+// AfterEpochEnd executes the indicated hook after epochs ends and returns the
+// results of the hook executions
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) []types.HookResult {
+	return k.executeHooks(ctx, identifier, epochNumber, types.HookMethodAfterEpochEnd, func(ctx sdk.Context, hooks types.EpochHooks) {
+		hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	})
+}
+
+// BeforeEpochStart executes the indicated hook before the epochs and returns
+// the results of the hook executions
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) []types.HookResult {
+	return k.executeHooks(ctx, identifier, epochNumber, types.HookMethodBeforeEpochStart, func(ctx sdk.Context, hooks types.EpochHooks) {
+		hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	})
+}
+
+// executeHooks executes the method of each registered epoch hooks in isolation,
+// limited by the hooks gas limit of the epoch. A failed hook execution is
+// logged and emitted as an event, without aborting the other executions.
+func (k Keeper) executeHooks(
+	ctx sdk.Context,
+	identifier string,
+	epochNumber int64,
+	method string,
+	call func(ctx sdk.Context, hooks types.EpochHooks),
+) []types.HookResult {
+	var gasLimit uint64
+	if epochInfo, found := k.GetEpochInfo(ctx, identifier); found {
+		gasLimit = epochInfo.HooksGasLimit
+	}
+
+	hooksList := k.hooksList()
+	if len(hooksList) == 0 {
+		return nil
+	}
+
+	results := make([]types.HookResult, 0, len(hooksList))
+	for _, hooks := range hooksList {
+		result := types.HookResult{
+			HookName:    hooksName(hooks),
+			Method:      method,
+			EpochNumber: epochNumber,
+			Height:      ctx.BlockHeight(),
+			Success:     true,
+		}
+
+		gasUsed, err := executeHook(ctx, gasLimit, func(ctx sdk.Context) {
+			call(ctx, hooks)
+		})
+		result.GasUsed = gasUsed
+
+		if err != nil {
+			result.Success = false
+			result.Error = hookErrorClass(err)
+
+			k.Logger(ctx).Error(
+				"epoch hook failed",
+				"identifier", identifier,
+				"epoch-number", epochNumber,
+				"hook", result.HookName,
+				"method", method,
+				"error", err,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochHookError,
+					sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+					sdk.NewAttribute(types.AttributeHookName, result.HookName),
+					sdk.NewAttribute(types.AttributeHookMethod, method),
+					sdk.NewAttribute(types.AttributeHookError, err.Error()),
+				),
+			)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
 }
 
-// BeforeEpochStart executes the indicated hook before the epochs
-func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+// executeHook calls the hook on a cached context with a gas meter limited to
+// the gas limit, or an infinite gas meter if the gas limit is zero. The state
+// changes and events of the hook are only committed if it doesn't panic, in
+// which case the panic, including running out of gas, is returned as an error.
+func executeHook(ctx sdk.Context, gasLimit uint64, call func(ctx sdk.Context)) (gasUsed uint64, err error) {
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	if gasLimit > 0 {
+		gasMeter = storetypes.NewGasMeter(gasLimit)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumed()
+
+			switch rType := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(
+					errortypes.ErrOutOfGas,
+					"out of gas in location: %s; gas limit: %d", rType.Descriptor, gasLimit,
+				)
+			default:
+				err = errorsmod.Wrapf(types.ErrHookPanic, "%v", r)
+			}
+		}
+	}()
+
+	call(cacheCtx)
+	writeCache()
+
+	return gasMeter.GasConsumed(), nil
+}
+
+// hooksList returns the registered epoch hooks as a list.
+func (k Keeper) hooksList() []types.EpochHooks {
+	switch hooks := k.hooks.(type) {
+	case nil:
+		return nil
+	case MultiEpochHooks:
+		return hooks
+	default:
+		return []types.EpochHooks{hooks}
+	}
+}
+
+// hooksDependingOn returns the name of the first registered epoch hooks that
+// depend on the epoch identifier, if any.
+func (k Keeper) hooksDependingOn(ctx sdk.Context, identifier string) (string, bool) {
+	for _, hooks := range k.hooksList() {
+		dependent, ok := hooks.(types.EpochDependentHooks)
+		if !ok {
+			continue
+		}
+
+		if slices.Contains(dependent.EpochIdentifiers(ctx), identifier) {
+			return hooksName(hooks), true
+		}
+	}
+	return "", false
+}
+
+// hookErrorClass returns the class of the error of a failed hook execution.
+func hookErrorClass(err error) string {
+	if errors.Is(err, errortypes.ErrOutOfGas) {
+		return types.HookErrorOutOfGas
+	}
+	return types.HookErrorPanic
+}
+
+// hooksName returns the name of the epoch hooks, defaulting to their type for
+// the hooks that don't implement NamedEpochHooks.
+func hooksName(hooks types.EpochHooks) string {
+	if named, ok := hooks.(types.NamedEpochHooks); ok {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", hooks)
 }
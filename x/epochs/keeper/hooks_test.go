@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/epochs/keeper"
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+// testEpochHooks consumes gas and writes to the store on each hook execution,
+// optionally panicking afterwards.
+type testEpochHooks struct {
+	name     string
+	storeKey storetypes.StoreKey
+	gas      uint64
+	panics   bool
+}
+
+var _ types.NamedEpochHooks = testEpochHooks{}
+
+func (h testEpochHooks) Name() string { return h.name }
+
+func (h testEpochHooks) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) {
+	h.execute(ctx, types.HookMethodAfterEpochEnd)
+}
+
+func (h testEpochHooks) BeforeEpochStart(ctx sdk.Context, _ string, _ int64) {
+	h.execute(ctx, types.HookMethodBeforeEpochStart)
+}
+
+func (h testEpochHooks) execute(ctx sdk.Context, method string) {
+	ctx.GasMeter().ConsumeGas(h.gas, "test epoch hook")
+	ctx.KVStore(h.storeKey).Set(h.key(method), []byte{1})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(h.name))
+	if h.panics {
+		panic("test epoch hook failure")
+	}
+}
+
+func (h testEpochHooks) key(method string) []byte {
+	return []byte("test/" + h.name + "/" + method)
+}
+
+func TestHooksExecution(t *testing.T) {
+	now := time.Now().UTC()
+	epochInfo := types.EpochInfo{
+		Identifier:            monthIdentifier,
+		StartTime:             now,
+		Duration:              month,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: now,
+		EpochCountingStarted:  true,
+		HooksGasLimit:         100_000,
+	}
+
+	suite := SetupTest([]types.EpochInfo{epochInfo})
+	ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+	storeKey := suite.network.App.GetKey(types.StoreKey)
+
+	successHooks := testEpochHooks{name: "success", storeKey: storeKey, gas: 10_000}
+	panicHooks := testEpochHooks{name: "panic", storeKey: storeKey, gas: 10_000, panics: true}
+	outOfGasHooks := testEpochHooks{name: "out_of_gas", storeKey: storeKey, gas: 200_000}
+
+	k := keeper.NewKeeper(suite.network.App.AppCodec(), storeKey, authtypes.NewModuleAddress(govtypes.ModuleName))
+	k.SetHooks(keeper.NewMultiEpochHooks(panicHooks, outOfGasHooks, successHooks))
+
+	// end the epoch
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(now.Add(month).Add(time.Second))
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	results := k.GetHookResults(ctx, monthIdentifier)
+	require.Len(t, results, 6)
+
+	for _, result := range results {
+		require.Equal(t, int64(2), result.Height)
+		require.Equal(t, int64(2), result.EpochNumber)
+
+		store := ctx.KVStore(storeKey)
+		switch result.HookName {
+		case successHooks.name:
+			require.True(t, result.Success, result.Error)
+			require.Empty(t, result.Error)
+			require.GreaterOrEqual(t, result.GasUsed, successHooks.gas)
+			require.True(t, store.Has(successHooks.key(result.Method)))
+		case panicHooks.name:
+			require.False(t, result.Success)
+			require.Equal(t, types.HookErrorPanic, result.Error)
+			require.False(t, store.Has(panicHooks.key(result.Method)))
+		case outOfGasHooks.name:
+			require.False(t, result.Success)
+			require.Equal(t, types.HookErrorOutOfGas, result.Error)
+			require.False(t, store.Has(outOfGasHooks.key(result.Method)))
+		default:
+			t.Fatalf("unexpected hook result %s", result.HookName)
+		}
+	}
+
+	// only the events of the successful hook executions are emitted, along
+	// with an error event with the raw error for each failed hook execution
+	var successEvents, hookErrorEvents int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case successHooks.name:
+			successEvents++
+		case panicHooks.name, outOfGasHooks.name:
+			t.Fatalf("unexpected event of failed hook %s", event.Type)
+		case types.EventTypeEpochHookError:
+			hookErrorEvents++
+
+			hookName, ok := event.GetAttribute(types.AttributeHookName)
+			require.True(t, ok)
+			hookErr, ok := event.GetAttribute(types.AttributeHookError)
+			require.True(t, ok)
+
+			switch hookName.Value {
+			case panicHooks.name:
+				require.Contains(t, hookErr.Value, "test epoch hook failure")
+			case outOfGasHooks.name:
+				require.Contains(t, hookErr.Value, "out of gas")
+			default:
+				t.Fatalf("unexpected hook error event of %s", hookName.Value)
+			}
+		}
+	}
+	require.Equal(t, 2, successEvents)
+	require.Equal(t, 4, hookErrorEvents)
+
+	// the epoch is still updated
+	updatedEpochInfo, found := k.GetEpochInfo(ctx, monthIdentifier)
+	require.True(t, found)
+	require.Equal(t, int64(2), updatedEpochInfo.CurrentEpoch)
+
+	// the results are exposed by the query
+	res, err := suite.network.GetEpochsClient().HookResults(ctx, &types.QueryHookResultsRequest{Identifier: monthIdentifier})
+	require.NoError(t, err)
+	require.Equal(t, results, res.Results)
+
+	_, err = suite.network.GetEpochsClient().HookResults(ctx, &types.QueryHookResultsRequest{Identifier: "second"})
+	require.Error(t, err)
+}
+
+func TestHooksWithoutGasLimit(t *testing.T) {
+	suite := SetupTest([]types.EpochInfo{})
+	ctx := suite.network.GetContext()
+	storeKey := suite.network.App.GetKey(types.StoreKey)
+
+	hooks := testEpochHooks{name: "heavy", storeKey: storeKey, gas: 100_000_000}
+	k := keeper.NewKeeper(suite.network.App.AppCodec(), storeKey, authtypes.NewModuleAddress(govtypes.ModuleName))
+	k.SetHooks(hooks)
+
+	// the default epochs don't limit the gas of the hooks
+	results := k.AfterEpochEnd(ctx, types.DayEpochID, 1)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Success, results[0].Error)
+	require.Equal(t, hooks.name, results[0].HookName)
+	require.Equal(t, types.HookMethodAfterEpochEnd, results[0].Method)
+	require.GreaterOrEqual(t, results[0].GasUsed, hooks.gas)
+	require.True(t, ctx.KVStore(storeKey).Has(hooks.key(types.HookMethodAfterEpochEnd)))
+}
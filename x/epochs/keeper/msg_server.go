@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateEpoch defines a method for creating a new epoch. The epoch counting
+// starts on the first block reaching the start time, which defaults to the
+// block time and cannot be in the past.
+func (k Keeper) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochExists, "identifier %s", req.Identifier)
+	}
+
+	startTime := req.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	if startTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"start time %s cannot be before the block time %s", startTime, ctx.BlockTime(),
+		)
+	}
+
+	epochInfo := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               startTime,
+		Duration:                req.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+		HooksGasLimit:           req.HooksGasLimit,
+	}
+
+	if err := epochInfo.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.StartTime.Unix(), 10)),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch defines a method for deleting an epoch, along with the results
+// of its last hook executions. The epochs that registered hooks depend on
+// cannot be deleted.
+func (k Keeper) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if hooksName, found := k.hooksDependingOn(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s is used by the %s epoch hooks", req.Identifier, hooksName)
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+	k.DeleteHookResults(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// UpdateHooksGasLimit defines a method for updating the gas limit of each hook
+// execution of an existing epoch.
+func (k Keeper) UpdateHooksGasLimit(goCtx context.Context, req *types.MsgUpdateHooksGasLimit) (*types.MsgUpdateHooksGasLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	epochInfo.HooksGasLimit = req.HooksGasLimit
+	k.SetEpochInfo(ctx, epochInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateHooksGasLimit,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeHooksGasLimit, strconv.FormatUint(req.HooksGasLimit, 10)),
+		),
+	)
+
+	return &types.MsgUpdateHooksGasLimitResponse{}, nil
+}
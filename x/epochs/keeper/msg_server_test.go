@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+func TestCreateEpoch(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       func(blockTime time.Time) *types.MsgCreateEpoch
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			func(_ time.Time) *types.MsgCreateEpoch {
+				return &types.MsgCreateEpoch{Authority: "evmos1invalid", Identifier: "hour", Duration: time.Hour}
+			},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - epoch already exists",
+			func(_ time.Time) *types.MsgCreateEpoch {
+				return &types.MsgCreateEpoch{Authority: authority, Identifier: types.DayEpochID, Duration: time.Hour}
+			},
+			false,
+			types.ErrEpochExists.Error(),
+		},
+		{
+			"fail - start time in the past",
+			func(blockTime time.Time) *types.MsgCreateEpoch {
+				return &types.MsgCreateEpoch{
+					Authority:  authority,
+					Identifier: "hour",
+					StartTime:  blockTime.Add(-time.Second),
+					Duration:   time.Hour,
+				}
+			},
+			false,
+			"cannot be before the block time",
+		},
+		{
+			"pass - start time defaults to the block time",
+			func(_ time.Time) *types.MsgCreateEpoch {
+				return &types.MsgCreateEpoch{Authority: authority, Identifier: "hour", Duration: time.Hour}
+			},
+			true,
+			"",
+		},
+		{
+			"pass - future start time with hooks gas limit",
+			func(blockTime time.Time) *types.MsgCreateEpoch {
+				return &types.MsgCreateEpoch{
+					Authority:     authority,
+					Identifier:    "hour",
+					StartTime:     blockTime.Add(time.Hour),
+					Duration:      time.Hour,
+					HooksGasLimit: 1_000_000,
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTest([]types.EpochInfo{})
+			ctx := suite.network.GetContext()
+			k := suite.network.App.EpochsKeeper
+
+			msg := tc.msg(ctx.BlockTime())
+			_, err := k.CreateEpoch(ctx, msg)
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+			require.True(t, found)
+			require.Equal(t, msg.Duration, epochInfo.Duration)
+			require.Equal(t, msg.HooksGasLimit, epochInfo.HooksGasLimit)
+			require.False(t, epochInfo.EpochCountingStarted)
+			require.Equal(t, int64(0), epochInfo.CurrentEpoch)
+			if msg.StartTime.IsZero() {
+				require.Equal(t, ctx.BlockTime(), epochInfo.StartTime)
+			} else {
+				require.Equal(t, msg.StartTime.UTC(), epochInfo.StartTime.UTC())
+			}
+
+			// the epoch starts counting once the start time is reached
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(epochInfo.StartTime)
+			require.NoError(t, k.BeginBlocker(ctx))
+
+			epochInfo, found = k.GetEpochInfo(ctx, msg.Identifier)
+			require.True(t, found)
+			require.True(t, epochInfo.EpochCountingStarted)
+			require.Equal(t, int64(1), epochInfo.CurrentEpoch)
+		})
+	}
+}
+
+func TestDeleteEpoch(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgDeleteEpoch
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgDeleteEpoch{Authority: "evmos1invalid", Identifier: types.DayEpochID},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - epoch not found",
+			&types.MsgDeleteEpoch{Authority: authority, Identifier: "hour"},
+			false,
+			types.ErrEpochNotFound.Error(),
+		},
+		{
+			"fail - epoch used by the inflation hooks",
+			&types.MsgDeleteEpoch{Authority: authority, Identifier: types.DayEpochID},
+			false,
+			types.ErrEpochInUse.Error(),
+		},
+		{
+			"fail - epoch used as the inflation epoch identifier",
+			&types.MsgDeleteEpoch{Authority: authority, Identifier: monthIdentifier},
+			false,
+			types.ErrEpochInUse.Error(),
+		},
+		{
+			"pass - delete existing epoch",
+			&types.MsgDeleteEpoch{Authority: authority, Identifier: types.WeekEpochID},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTest([]types.EpochInfo{})
+			ctx := suite.network.GetContext()
+			k := suite.network.App.EpochsKeeper
+
+			// the inflation mint happens on the month epoch
+			k.SetEpochInfo(ctx, types.EpochInfo{Identifier: monthIdentifier, Duration: month})
+			suite.network.App.InflationKeeper.SetEpochIdentifier(ctx, monthIdentifier)
+
+			k.SetHookResults(ctx, tc.msg.Identifier, []types.HookResult{{HookName: "test", Success: true}})
+
+			_, err := k.DeleteEpoch(ctx, tc.msg)
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := k.GetEpochInfo(ctx, tc.msg.Identifier)
+			require.False(t, found)
+			require.Empty(t, k.GetHookResults(ctx, tc.msg.Identifier))
+		})
+	}
+}
+
+func TestUpdateHooksGasLimit(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateHooksGasLimit
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateHooksGasLimit{Authority: "evmos1invalid", Identifier: types.DayEpochID, HooksGasLimit: 1_000_000},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - epoch not found",
+			&types.MsgUpdateHooksGasLimit{Authority: authority, Identifier: "hour", HooksGasLimit: 1_000_000},
+			false,
+			types.ErrEpochNotFound.Error(),
+		},
+		{
+			"pass - set hooks gas limit",
+			&types.MsgUpdateHooksGasLimit{Authority: authority, Identifier: types.DayEpochID, HooksGasLimit: 1_000_000},
+			true,
+			"",
+		},
+		{
+			"pass - remove hooks gas limit",
+			&types.MsgUpdateHooksGasLimit{Authority: authority, Identifier: types.DayEpochID, HooksGasLimit: 0},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTest([]types.EpochInfo{})
+			ctx := suite.network.GetContext()
+			k := suite.network.App.EpochsKeeper
+
+			epochInfoBefore, found := k.GetEpochInfo(ctx, types.DayEpochID)
+			require.True(t, found)
+			epochInfoBefore.HooksGasLimit = 500_000
+			k.SetEpochInfo(ctx, epochInfoBefore)
+
+			_, err := k.UpdateHooksGasLimit(ctx, tc.msg)
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			// only the hooks gas limit of the epoch is updated
+			epochInfo, found := k.GetEpochInfo(ctx, tc.msg.Identifier)
+			require.True(t, found)
+			require.Equal(t, tc.msg.HooksGasLimit, epochInfo.HooksGasLimit)
+			epochInfo.HooksGasLimit = epochInfoBefore.HooksGasLimit
+			require.Equal(t, epochInfoBefore, epochInfo)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // StartInitialEpoch sets the epoch info fields to their start values
@@ -22,6 +23,17 @@ func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
 }
 
+// NextEpochStart returns the number and the expected start time of the next
+// epoch. The first epoch starts at the start time, and each following epoch
+// starts once the duration of the current epoch has passed.
+func (ei EpochInfo) NextEpochStart() (int64, time.Time) {
+	if !ei.EpochCountingStarted {
+		return 1, ei.StartTime
+	}
+
+	return ei.CurrentEpoch + 1, ei.CurrentEpochStartTime.Add(ei.Duration)
+}
+
 // Validate performs a stateless validation of the epoch info fields
 func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
@@ -30,6 +30,26 @@ func (suite *EpochInfoTestSuite) TestStartEndEpoch() {
 	suite.Require().Equal(startTime.Add(duration), ei.CurrentEpochStartTime)
 }
 
+func (suite *EpochInfoTestSuite) TestNextEpochStart() {
+	startTime := time.Now()
+	duration := time.Hour
+	ei := EpochInfo{StartTime: startTime, Duration: duration}
+
+	number, nextStartTime := ei.NextEpochStart()
+	suite.Require().Equal(int64(1), number)
+	suite.Require().Equal(startTime, nextStartTime)
+
+	ei.StartInitialEpoch()
+	number, nextStartTime = ei.NextEpochStart()
+	suite.Require().Equal(int64(2), number)
+	suite.Require().Equal(startTime.Add(duration), nextStartTime)
+
+	ei.EndEpoch()
+	number, nextStartTime = ei.NextEpochStart()
+	suite.Require().Equal(int64(3), number)
+	suite.Require().Equal(startTime.Add(2*duration), nextStartTime)
+}
+
 func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 	testCases := []struct {
 		name       string
@@ -46,6 +66,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -59,6 +80,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -72,6 +94,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -85,6 +108,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
 			},
 			false,
 		},
@@ -98,6 +122,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			true,
 		},
@@ -0,0 +1,16 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrEpochExists   = errorsmod.Register(ModuleName, 2, "epoch already exists")
+	ErrEpochNotFound = errorsmod.Register(ModuleName, 3, "epoch not found")
+	ErrHookPanic     = errorsmod.Register(ModuleName, 4, "epoch hook panicked")
+	ErrEpochInUse    = errorsmod.Register(ModuleName, 5, "epoch is in use")
+)
@@ -5,9 +5,18 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd            = "epoch_end"
+	EventTypeEpochStart          = "epoch_start"
+	EventTypeEpochHookError      = "epoch_hook_error"
+	EventTypeCreateEpoch         = "create_epoch"
+	EventTypeDeleteEpoch         = "delete_epoch"
+	EventTypeUpdateHooksGasLimit = "update_hooks_gas_limit"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeHookName        = "hook"
+	AttributeHookMethod      = "method"
+	AttributeHookError       = "error"
+	AttributeHooksGasLimit   = "hooks_gas_limit"
 )
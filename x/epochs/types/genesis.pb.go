@@ -46,6 +46,9 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// hooks_gas_limit is the gas limit of each epoch hook execution for the
+	// epoch. Zero means that the hook executions are not gas limited.
+	HooksGasLimit uint64 `protobuf:"varint,8,opt,name=hooks_gas_limit,json=hooksGasLimit,proto3" json:"hooks_gas_limit,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -130,6 +133,162 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetHooksGasLimit() uint64 {
+	if m != nil {
+		return m.HooksGasLimit
+	}
+	return 0
+}
+
+// HookResult defines the result of an epoch hook execution.
+type HookResult struct {
+	// hook_name is the name of the executed epoch hooks
+	HookName string `protobuf:"bytes,1,opt,name=hook_name,json=hookName,proto3" json:"hook_name,omitempty"`
+	// method is the executed hook method, either after_epoch_end or
+	// before_epoch_start
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// epoch_number is the number of the epoch passed to the hook
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// height is the block height of the execution
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// success is true if the hook execution didn't fail
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the class of the error of the failed hook execution, either
+	// out_of_gas or panic. The raw error is only emitted in the epoch_hook_error
+	// event.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas consumed by the hook execution
+	GasUsed uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *HookResult) Reset()         { *m = HookResult{} }
+func (m *HookResult) String() string { return proto.CompactTextString(m) }
+func (*HookResult) ProtoMessage()    {}
+func (*HookResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{1}
+}
+func (m *HookResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookResult.Merge(m, src)
+}
+func (m *HookResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookResult proto.InternalMessageInfo
+
+func (m *HookResult) GetHookName() string {
+	if m != nil {
+		return m.HookName
+	}
+	return ""
+}
+
+func (m *HookResult) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HookResult) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *HookResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HookResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *HookResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *HookResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// HookResults defines the results of the last hook executions of an epoch.
+type HookResults struct {
+	// results is a slice of the hook execution results
+	Results []HookResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *HookResults) Reset()         { *m = HookResults{} }
+func (m *HookResults) String() string { return proto.CompactTextString(m) }
+func (*HookResults) ProtoMessage()    {}
+func (*HookResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{2}
+}
+func (m *HookResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookResults.Merge(m, src)
+}
+func (m *HookResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookResults proto.InternalMessageInfo
+
+func (m *HookResults) GetResults() []HookResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
@@ -140,7 +299,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c74bc0b3e7fa01c2, []int{1}
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,44 +337,56 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "evmos.epochs.v1.EpochInfo")
+	proto.RegisterType((*HookResult)(nil), "evmos.epochs.v1.HookResult")
+	proto.RegisterType((*HookResults)(nil), "evmos.epochs.v1.HookResults")
 	proto.RegisterType((*GenesisState)(nil), "evmos.epochs.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0x49, 0x09, 0x8d, 0x29, 0xaa, 0x6a, 0x15, 0x38, 0x22, 0x71, 0x77, 0x3a, 0x96, 0x80,
-	0xd0, 0xb9, 0x2d, 0x88, 0x01, 0xc4, 0x92, 0x82, 0x80, 0x81, 0x25, 0x61, 0x42, 0x42, 0xd1, 0x25,
-	0x71, 0x1c, 0x4b, 0xb5, 0x7d, 0xba, 0x7b, 0x17, 0x91, 0x1f, 0xc0, 0xde, 0x11, 0xfe, 0x01, 0x23,
-	0x3f, 0xa3, 0x63, 0x47, 0xa6, 0x80, 0x92, 0x01, 0x89, 0xb1, 0xbf, 0x00, 0x9d, 0xed, 0x0b, 0xa1,
-	0x05, 0xb1, 0x9c, 0x7c, 0xef, 0x7b, 0xdf, 0xf7, 0xf9, 0x7d, 0x7a, 0xc6, 0xb7, 0xd9, 0x54, 0xea,
-	0x9c, 0xb2, 0x54, 0x0f, 0x27, 0x39, 0x9d, 0xee, 0x53, 0xce, 0x14, 0xcb, 0x45, 0x1e, 0xa7, 0x99,
-	0x06, 0x4d, 0xb6, 0x0d, 0x1c, 0x5b, 0x38, 0x9e, 0xee, 0xb7, 0x76, 0x12, 0x29, 0x94, 0xa6, 0xe6,
-	0x6b, 0x7b, 0x5a, 0xbb, 0x5c, 0x73, 0x6d, 0x8e, 0xb4, 0x3c, 0xb9, 0xaa, 0xcf, 0xb5, 0xe6, 0x47,
-	0x8c, 0x9a, 0xbf, 0x41, 0x31, 0xa6, 0xa3, 0x22, 0x4b, 0x40, 0x68, 0xe5, 0xf0, 0xe0, 0x3c, 0x0e,
-	0x42, 0xb2, 0x1c, 0x12, 0x99, 0xda, 0x86, 0xe8, 0xd3, 0x06, 0x6e, 0x3e, 0x2f, 0x7d, 0x5f, 0xa9,
-	0xb1, 0x26, 0x3e, 0xc6, 0x62, 0xc4, 0x14, 0x88, 0xb1, 0x60, 0x99, 0x87, 0x42, 0xd4, 0x6e, 0x76,
-	0xd7, 0x2a, 0xe4, 0x1d, 0xc6, 0x39, 0x24, 0x19, 0xf4, 0x4b, 0x19, 0xef, 0x52, 0x88, 0xda, 0x57,
-	0x0f, 0x5a, 0xb1, 0xf5, 0x88, 0x2b, 0x8f, 0xf8, 0x4d, 0xe5, 0xd1, 0x89, 0x4e, 0xe6, 0x41, 0xed,
-	0x6c, 0x1e, 0xec, 0xcc, 0x12, 0x79, 0xf4, 0x38, 0xfa, 0xcd, 0x8d, 0x8e, 0xbf, 0x05, 0xe8, 0xf3,
-	0x8f, 0x2f, 0xf7, 0x50, 0xb7, 0x69, 0xaa, 0x25, 0x87, 0x48, 0xbc, 0x59, 0xdd, 0xdf, 0xab, 0x1b,
-	0xf1, 0x5b, 0x17, 0xc4, 0x9f, 0xb9, 0x86, 0xce, 0xa3, 0x52, 0xfb, 0xe7, 0x3c, 0x20, 0x15, 0xe5,
-	0xbe, 0x96, 0x02, 0x98, 0x4c, 0x61, 0x76, 0x36, 0x0f, 0xb6, 0xad, 0x63, 0x85, 0x45, 0x1f, 0x57,
-	0x7e, 0x2b, 0x0b, 0x72, 0x07, 0x5f, 0x1b, 0x16, 0x59, 0xc6, 0x14, 0xf4, 0x4d, 0xf4, 0xde, 0x46,
-	0x88, 0xda, 0xf5, 0xee, 0x96, 0x2b, 0x9a, 0x58, 0xc8, 0x07, 0x84, 0xbd, 0x3f, 0xba, 0xfa, 0x6b,
-	0x09, 0x5c, 0xfe, 0x6f, 0x02, 0x7b, 0x2e, 0x81, 0xc0, 0xde, 0xe7, 0x5f, 0x4a, 0x6b, 0x79, 0x5c,
-	0x5f, 0xb7, 0xef, 0xad, 0xb2, 0x79, 0x88, 0x6f, 0x58, 0xd2, 0x50, 0x17, 0x0a, 0x84, 0xe2, 0x96,
-	0xcd, 0x46, 0x5e, 0x23, 0x44, 0xed, 0xcd, 0xee, 0xae, 0x41, 0x0f, 0x1d, 0xd8, 0xb3, 0x18, 0x79,
-	0x82, 0x5b, 0x7f, 0xb3, 0x9c, 0x30, 0xc1, 0x27, 0xe0, 0x5d, 0x31, 0xf3, 0xde, 0xbc, 0x60, 0xf8,
-	0xd2, 0xc0, 0xd1, 0x6b, 0xbc, 0xf5, 0xc2, 0xee, 0x69, 0x0f, 0x12, 0x60, 0xe4, 0x29, 0x6e, 0xd8,
-	0x15, 0xf5, 0x50, 0x58, 0x37, 0x73, 0x9f, 0xdb, 0xdb, 0x78, 0xb5, 0x49, 0x9d, 0x66, 0x39, 0xb7,
-	0x1d, 0xc8, 0x91, 0x3a, 0x87, 0x27, 0x0b, 0x1f, 0x9d, 0x2e, 0x7c, 0xf4, 0x7d, 0xe1, 0xa3, 0xe3,
-	0xa5, 0x5f, 0x3b, 0x5d, 0xfa, 0xb5, 0xaf, 0x4b, 0xbf, 0xf6, 0xf6, 0x2e, 0x17, 0x30, 0x29, 0x06,
-	0xf1, 0x50, 0x4b, 0xea, 0x5e, 0x8a, 0xf9, 0x4e, 0x0f, 0xf6, 0xe8, 0xfb, 0xea, 0xd5, 0xc0, 0x2c,
-	0x65, 0xf9, 0xa0, 0x61, 0x32, 0x7e, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf1, 0x62, 0x17,
-	0x52, 0x03, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x69, 0xd7, 0x36, 0xee, 0xa6, 0x69, 0xd6, 0x18, 0x59, 0x27, 0x92, 0x12, 0x24, 0x54,
+	0x10, 0x4a, 0xb6, 0x81, 0x38, 0x80, 0x90, 0x50, 0x07, 0xda, 0x90, 0x60, 0x48, 0x19, 0x5c, 0x90,
+	0x50, 0x95, 0x36, 0x5e, 0x62, 0xad, 0x8e, 0xab, 0xd8, 0xa9, 0xd8, 0x0f, 0xe0, 0xbe, 0x23, 0x3f,
+	0x81, 0x23, 0x7f, 0x81, 0x13, 0x3b, 0xee, 0xc8, 0xa9, 0xa0, 0xed, 0x80, 0xc4, 0x71, 0xbf, 0x00,
+	0xc5, 0x76, 0xba, 0xb2, 0x82, 0xb8, 0x44, 0x7e, 0xef, 0x7b, 0xdf, 0xfb, 0xec, 0xef, 0xd9, 0x81,
+	0xd7, 0xf1, 0x88, 0x32, 0xee, 0xe1, 0x21, 0xeb, 0xc7, 0xdc, 0x1b, 0x6d, 0x78, 0x11, 0x4e, 0x30,
+	0x27, 0xdc, 0x1d, 0xa6, 0x4c, 0x30, 0xb4, 0x28, 0x61, 0x57, 0xc1, 0xee, 0x68, 0xa3, 0xb9, 0x14,
+	0x50, 0x92, 0x30, 0x4f, 0x7e, 0x55, 0x4d, 0x73, 0x39, 0x62, 0x11, 0x93, 0x4b, 0x2f, 0x5f, 0xe9,
+	0xac, 0x15, 0x31, 0x16, 0x0d, 0xb0, 0x27, 0xa3, 0x5e, 0xb6, 0xef, 0x85, 0x59, 0x1a, 0x08, 0xc2,
+	0x12, 0x8d, 0xdb, 0x97, 0x71, 0x41, 0x28, 0xe6, 0x22, 0xa0, 0x43, 0x55, 0xe0, 0x7c, 0xa9, 0x40,
+	0xe3, 0x59, 0xae, 0xfb, 0x3c, 0xd9, 0x67, 0xc8, 0x82, 0x90, 0x84, 0x38, 0x11, 0x64, 0x9f, 0xe0,
+	0xd4, 0x04, 0x2d, 0xd0, 0x36, 0xfc, 0xa9, 0x0c, 0x7a, 0x07, 0x21, 0x17, 0x41, 0x2a, 0xba, 0x79,
+	0x1b, 0xf3, 0x4a, 0x0b, 0xb4, 0x1b, 0x9b, 0x4d, 0x57, 0x69, 0xb8, 0x85, 0x86, 0xfb, 0xba, 0xd0,
+	0xe8, 0x38, 0xc7, 0x63, 0xbb, 0x74, 0x3e, 0xb6, 0x97, 0x0e, 0x03, 0x3a, 0x78, 0xe8, 0x5c, 0x70,
+	0x9d, 0xa3, 0xef, 0x36, 0xf8, 0xf4, 0xf3, 0xf3, 0x1d, 0xe0, 0x1b, 0x32, 0x9b, 0x73, 0x10, 0x85,
+	0xf5, 0x62, 0xff, 0x66, 0x59, 0x36, 0x5f, 0x9d, 0x69, 0xfe, 0x54, 0x17, 0x74, 0x1e, 0xe4, 0xbd,
+	0x7f, 0x8d, 0x6d, 0x54, 0x50, 0xee, 0x32, 0x4a, 0x04, 0xa6, 0x43, 0x71, 0x78, 0x3e, 0xb6, 0x17,
+	0x95, 0x62, 0x81, 0x39, 0x1f, 0x27, 0x7a, 0x13, 0x09, 0x74, 0x13, 0x2e, 0xf4, 0xb3, 0x34, 0xc5,
+	0x89, 0xe8, 0x4a, 0xeb, 0xcd, 0x4a, 0x0b, 0xb4, 0xcb, 0xfe, 0xbc, 0x4e, 0x4a, 0x5b, 0xd0, 0x07,
+	0x00, 0xcd, 0x3f, 0xaa, 0xba, 0x53, 0x0e, 0xcc, 0xfd, 0xd7, 0x81, 0x75, 0xed, 0x80, 0xad, 0xf6,
+	0xf3, 0xaf, 0x4e, 0x53, 0x7e, 0x5c, 0x9d, 0x96, 0xdf, 0x9b, 0x78, 0x73, 0x1f, 0xae, 0x28, 0x52,
+	0x9f, 0x65, 0x89, 0x20, 0x49, 0xa4, 0xd8, 0x38, 0x34, 0xab, 0x2d, 0xd0, 0xae, 0xfb, 0xcb, 0x12,
+	0xdd, 0xd2, 0xe0, 0x9e, 0xc2, 0xd0, 0x23, 0xd8, 0xfc, 0x9b, 0x64, 0x8c, 0x49, 0x14, 0x0b, 0xb3,
+	0x26, 0xcf, 0x7b, 0x6d, 0x46, 0x70, 0x47, 0xc2, 0xe8, 0x16, 0x5c, 0x8c, 0x19, 0x3b, 0xe0, 0xdd,
+	0x28, 0xe0, 0xdd, 0x01, 0xa1, 0x44, 0x98, 0xf5, 0x16, 0x68, 0x57, 0xfc, 0x05, 0x99, 0xde, 0x0e,
+	0xf8, 0x8b, 0x3c, 0xe9, 0x7c, 0x05, 0x10, 0xee, 0x30, 0x76, 0xe0, 0x63, 0x9e, 0x0d, 0x04, 0x5a,
+	0x83, 0x46, 0x8e, 0x77, 0x93, 0x80, 0x62, 0x7d, 0x87, 0xea, 0x79, 0x62, 0x37, 0xa0, 0x18, 0xad,
+	0xc0, 0x2a, 0xc5, 0x22, 0x66, 0xa1, 0xbc, 0x3d, 0x86, 0xaf, 0x23, 0x74, 0x03, 0xce, 0xab, 0x0d,
+	0x26, 0x19, 0xed, 0xe1, 0x54, 0x8e, 0xbf, 0xec, 0x37, 0x64, 0x6e, 0x57, 0xa6, 0x72, 0xaa, 0xde,
+	0xb7, 0x9a, 0x93, 0x8e, 0x90, 0x09, 0x6b, 0x3c, 0xeb, 0xf7, 0x31, 0xe7, 0x72, 0x1e, 0x75, 0xbf,
+	0x08, 0xd1, 0x32, 0x9c, 0xc3, 0x69, 0xca, 0x52, 0x69, 0x91, 0xe1, 0xab, 0x00, 0xad, 0xc2, 0x7a,
+	0x7e, 0xa0, 0x8c, 0xe3, 0x50, 0x3a, 0x50, 0xf1, 0x6b, 0x51, 0xc0, 0xdf, 0x70, 0x1c, 0x3a, 0xaf,
+	0x60, 0xe3, 0xe2, 0x20, 0x1c, 0x3d, 0x81, 0xb5, 0x54, 0x2d, 0x4d, 0xd0, 0x2a, 0xb7, 0x1b, 0x9b,
+	0x6b, 0xee, 0xa5, 0x97, 0xea, 0x5e, 0x94, 0x77, 0x8c, 0x7c, 0xd4, 0x6a, 0x86, 0x05, 0xcd, 0x79,
+	0x09, 0xe7, 0xb7, 0xd5, 0x53, 0xdf, 0x13, 0x81, 0xc0, 0xe8, 0x31, 0xac, 0x2a, 0xae, 0x6e, 0xd8,
+	0x9c, 0x69, 0x38, 0x79, 0x8c, 0xd3, 0xfd, 0x34, 0xa9, 0xb3, 0x75, 0x7c, 0x6a, 0x81, 0x93, 0x53,
+	0x0b, 0xfc, 0x38, 0xb5, 0xc0, 0xd1, 0x99, 0x55, 0x3a, 0x39, 0xb3, 0x4a, 0xdf, 0xce, 0xac, 0xd2,
+	0xdb, 0xdb, 0x11, 0x11, 0x71, 0xd6, 0x73, 0xfb, 0x8c, 0x7a, 0xfa, 0x67, 0x23, 0xbf, 0xa3, 0xcd,
+	0x75, 0xef, 0x7d, 0xf1, 0xe3, 0x11, 0x87, 0x43, 0xcc, 0x7b, 0x55, 0x79, 0x4d, 0xef, 0xfd, 0x1e,
+	0x00, 0xab, 0x1d, 0xd1, 0x2e, 0x95, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -238,6 +409,11 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HooksGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HooksGasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -292,6 +468,112 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HookName) > 0 {
+		i -= len(m.HookName)
+		copy(dAtA[i:], m.HookName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.HookName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +647,57 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.HooksGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.HooksGasLimit))
+	}
+	return n
+}
+
+func (m *HookResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HookName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *HookResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -607,6 +940,332 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksGasLimit", wireType)
+			}
+			m.HooksGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HooksGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, HookResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
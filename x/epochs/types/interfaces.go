@@ -5,6 +5,19 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+// epoch hook methods reported in the hook execution results
+const (
+	HookMethodAfterEpochEnd    = "after_epoch_end"
+	HookMethodBeforeEpochStart = "before_epoch_start"
+)
+
+// epoch hook error classes stored in the hook execution results, as the raw
+// errors are not deterministic
+const (
+	HookErrorOutOfGas = "out_of_gas"
+	HookErrorPanic    = "panic"
+)
+
 // EpochHooks event hooks for epoch processing
 type EpochHooks interface {
 	// the first block whose timestamp is after the duration is counted as the end of the epoch
@@ -12,3 +25,20 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// NamedEpochHooks defines the epoch hooks that expose a name, which identifies
+// them in the hook execution results.
+type NamedEpochHooks interface {
+	EpochHooks
+	// Name returns the name of the epoch hooks
+	Name() string
+}
+
+// EpochDependentHooks defines the epoch hooks that depend on epochs, which
+// cannot be deleted while the hooks are registered.
+type EpochDependentHooks interface {
+	EpochHooks
+	// EpochIdentifiers returns the identifiers of the epochs that the hooks
+	// depend on
+	EpochIdentifiers(ctx sdk.Context) []string
+}
@@ -17,7 +17,13 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixHookResults
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// KeyPrefixHookResults defines prefix key for storing the last hook
+	// execution results of the epochs
+	KeyPrefixHookResults = []byte{prefixHookResults}
+)
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinEpochDuration is the minimum duration of the epochs created by governance
+const MinEpochDuration = time.Minute
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+	_ sdk.Msg = &MsgUpdateHooksGasLimit{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+
+	if m.Duration < MinEpochDuration {
+		return fmt.Errorf("epoch duration must be at least %s: %s", MinEpochDuration, m.Duration)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateHooksGasLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateHooksGasLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
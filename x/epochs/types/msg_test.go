@@ -0,0 +1,149 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name       string
+		msg        MsgCreateEpoch
+		expectPass bool
+	}{
+		{
+			"fail - invalid authority",
+			MsgCreateEpoch{Authority: "invalid", Identifier: "month", Duration: time.Hour * 24 * 30},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			MsgCreateEpoch{Authority: authority, Identifier: " ", Duration: time.Hour * 24 * 30},
+			false,
+		},
+		{
+			"fail - zero duration",
+			MsgCreateEpoch{Authority: authority, Identifier: "month"},
+			false,
+		},
+		{
+			"fail - duration below the minimum",
+			MsgCreateEpoch{Authority: authority, Identifier: "second", Duration: time.Second},
+			false,
+		},
+		{
+			"pass - minimum duration",
+			MsgCreateEpoch{Authority: authority, Identifier: "minute", Duration: MinEpochDuration},
+			true,
+		},
+		{
+			"pass - with start time and hooks gas limit",
+			MsgCreateEpoch{
+				Authority:     authority,
+				Identifier:    "hour",
+				StartTime:     time.Now(),
+				Duration:      time.Hour,
+				HooksGasLimit: 1_000_000,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeleteEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name       string
+		msg        MsgDeleteEpoch
+		expectPass bool
+	}{
+		{
+			"fail - invalid authority",
+			MsgDeleteEpoch{Authority: "invalid", Identifier: "month"},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			MsgDeleteEpoch{Authority: authority, Identifier: ""},
+			false,
+		},
+		{
+			"pass",
+			MsgDeleteEpoch{Authority: authority, Identifier: "month"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateHooksGasLimitValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name       string
+		msg        MsgUpdateHooksGasLimit
+		expectPass bool
+	}{
+		{
+			"fail - invalid authority",
+			MsgUpdateHooksGasLimit{Authority: "invalid", Identifier: "month", HooksGasLimit: 1_000_000},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			MsgUpdateHooksGasLimit{Authority: authority, Identifier: "", HooksGasLimit: 1_000_000},
+			false,
+		},
+		{
+			"pass",
+			MsgUpdateHooksGasLimit{Authority: authority, Identifier: "month", HooksGasLimit: 1_000_000},
+			true,
+		},
+		{
+			"pass - no hooks gas limit",
+			MsgUpdateHooksGasLimit{Authority: authority, Identifier: "month"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -11,19 +11,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -228,46 +232,261 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryHookResultsRequest is the request type for the Query/HookResults RPC
+// method.
+type QueryHookResultsRequest struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryHookResultsRequest) Reset()         { *m = QueryHookResultsRequest{} }
+func (m *QueryHookResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHookResultsRequest) ProtoMessage()    {}
+func (*QueryHookResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{4}
+}
+func (m *QueryHookResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookResultsRequest.Merge(m, src)
+}
+func (m *QueryHookResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookResultsRequest proto.InternalMessageInfo
+
+func (m *QueryHookResultsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// QueryHookResultsResponse is the response type for the Query/HookResults RPC
+// method.
+type QueryHookResultsResponse struct {
+	// results is a slice of the results of the last hook executions
+	Results []HookResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryHookResultsResponse) Reset()         { *m = QueryHookResultsResponse{} }
+func (m *QueryHookResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHookResultsResponse) ProtoMessage()    {}
+func (*QueryHookResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{5}
+}
+func (m *QueryHookResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookResultsResponse.Merge(m, src)
+}
+func (m *QueryHookResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookResultsResponse proto.InternalMessageInfo
+
+func (m *QueryHookResultsResponse) GetResults() []HookResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// QueryNextEpochStartRequest is the request type for the Query/NextEpochStart
+// RPC method.
+type QueryNextEpochStartRequest struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryNextEpochStartRequest) Reset()         { *m = QueryNextEpochStartRequest{} }
+func (m *QueryNextEpochStartRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochStartRequest) ProtoMessage()    {}
+func (*QueryNextEpochStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{6}
+}
+func (m *QueryNextEpochStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochStartRequest.Merge(m, src)
+}
+func (m *QueryNextEpochStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochStartRequest proto.InternalMessageInfo
+
+func (m *QueryNextEpochStartRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// QueryNextEpochStartResponse is the response type for the Query/NextEpochStart
+// RPC method.
+type QueryNextEpochStartResponse struct {
+	// next_epoch_number is the number of the next epoch
+	NextEpochNumber int64 `protobuf:"varint,1,opt,name=next_epoch_number,json=nextEpochNumber,proto3" json:"next_epoch_number,omitempty"`
+	// next_epoch_start_time is the expected start time of the next epoch. The
+	// epoch starts on the first block after this time.
+	NextEpochStartTime time.Time `protobuf:"bytes,2,opt,name=next_epoch_start_time,json=nextEpochStartTime,proto3,stdtime" json:"next_epoch_start_time"`
+}
+
+func (m *QueryNextEpochStartResponse) Reset()         { *m = QueryNextEpochStartResponse{} }
+func (m *QueryNextEpochStartResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochStartResponse) ProtoMessage()    {}
+func (*QueryNextEpochStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{7}
+}
+func (m *QueryNextEpochStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochStartResponse.Merge(m, src)
+}
+func (m *QueryNextEpochStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochStartResponse proto.InternalMessageInfo
+
+func (m *QueryNextEpochStartResponse) GetNextEpochNumber() int64 {
+	if m != nil {
+		return m.NextEpochNumber
+	}
+	return 0
+}
+
+func (m *QueryNextEpochStartResponse) GetNextEpochStartTime() time.Time {
+	if m != nil {
+		return m.NextEpochStartTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "evmos.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "evmos.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryHookResultsRequest)(nil), "evmos.epochs.v1.QueryHookResultsRequest")
+	proto.RegisterType((*QueryHookResultsResponse)(nil), "evmos.epochs.v1.QueryHookResultsResponse")
+	proto.RegisterType((*QueryNextEpochStartRequest)(nil), "evmos.epochs.v1.QueryNextEpochStartRequest")
+	proto.RegisterType((*QueryNextEpochStartResponse)(nil), "evmos.epochs.v1.QueryNextEpochStartResponse")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x29, 0x16, 0x3a, 0xad, 0x88, 0x83, 0xd8, 0x74, 0xd5, 0x6d, 0x88, 0xd0, 0xa6,
-	0x39, 0xcc, 0x98, 0x78, 0x13, 0x04, 0x69, 0x51, 0xf1, 0xa6, 0x7b, 0xf4, 0xa2, 0x9b, 0xf5, 0x75,
-	0x3a, 0x60, 0x66, 0xb6, 0x3b, 0x93, 0xc5, 0xde, 0xc4, 0xbb, 0x20, 0x78, 0xf3, 0xe6, 0xcd, 0xa3,
-	0x7f, 0x46, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x12, 0xc1, 0x7f, 0x43, 0xf6, 0xcd, 0x44, 0xf3, 0x0b,
-	0xe2, 0x65, 0x19, 0xe6, 0xbd, 0xef, 0xf7, 0x7d, 0xde, 0x77, 0x87, 0xde, 0x80, 0x72, 0x60, 0xac,
-	0x80, 0xdc, 0x64, 0x27, 0x56, 0x94, 0x5d, 0x71, 0x3a, 0x84, 0xe2, 0x8c, 0xe7, 0x85, 0x71, 0x86,
-	0x5d, 0xc1, 0x22, 0xf7, 0x45, 0x5e, 0x76, 0xa3, 0xab, 0xe9, 0x40, 0x69, 0x23, 0xf0, 0xeb, 0x7b,
-	0xa2, 0x4e, 0x66, 0x6c, 0xe5, 0xd0, 0x4f, 0x2d, 0x78, 0xb1, 0x28, 0xbb, 0x7d, 0x70, 0x69, 0x57,
-	0xe4, 0xa9, 0x54, 0x3a, 0x75, 0xca, 0xe8, 0xd0, 0x7b, 0x6b, 0x7e, 0x98, 0x04, 0x0d, 0x56, 0xd9,
-	0x50, 0xbe, 0x26, 0x8d, 0x34, 0x78, 0x14, 0xd5, 0x29, 0xdc, 0xde, 0x94, 0xc6, 0xc8, 0xd7, 0x20,
-	0xd2, 0x5c, 0x89, 0x54, 0x6b, 0xe3, 0xd0, 0x31, 0x68, 0x5a, 0x2f, 0xe9, 0xf5, 0x67, 0xd5, 0xd0,
-	0x87, 0xe8, 0xf9, 0x44, 0x1f, 0x9b, 0x04, 0x4e, 0x87, 0x60, 0x1d, 0x7b, 0x44, 0xe9, 0x3f, 0x80,
-	0x06, 0x69, 0x92, 0xf6, 0x66, 0x6f, 0x8f, 0x7b, 0x5a, 0x5e, 0xd1, 0x72, 0xbf, 0x6a, 0xa0, 0xe5,
-	0x4f, 0x53, 0x09, 0x41, 0x9b, 0x4c, 0x29, 0x5b, 0x9f, 0x09, 0xdd, 0x5e, 0x18, 0x61, 0x73, 0xa3,
-	0x2d, 0xb0, 0xfb, 0x74, 0xdd, 0x2f, 0xd3, 0x20, 0xcd, 0xb5, 0xf6, 0x66, 0x2f, 0xe2, 0x73, 0x89,
-	0x71, 0x14, 0x55, 0x9a, 0xc3, 0x8d, 0xf3, 0x1f, 0xbb, 0xb5, 0x2f, 0xbf, 0xbf, 0x76, 0x48, 0x12,
-	0x44, 0xec, 0xf1, 0x0c, 0x62, 0x1d, 0x11, 0xf7, 0x57, 0x22, 0xfa, 0xd9, 0x33, 0x8c, 0xf7, 0x68,
-	0x03, 0x11, 0x8f, 0x86, 0x45, 0x01, 0xda, 0xe1, 0xd0, 0x49, 0x0e, 0x31, 0xa5, 0xea, 0x15, 0x68,
-	0xa7, 0x8e, 0x15, 0x14, 0x98, 0xc3, 0x46, 0x32, 0x75, 0xd3, 0x7a, 0x40, 0x77, 0x96, 0x68, 0xc3,
-	0x82, 0xb7, 0xe9, 0xe5, 0xcc, 0xdf, 0xbf, 0x40, 0x66, 0xd4, 0xaf, 0x25, 0x5b, 0xd9, 0x54, 0x73,
-	0xef, 0x53, 0x9d, 0x5e, 0x42, 0x0b, 0xf6, 0x96, 0x50, 0xfa, 0x77, 0x63, 0xcb, 0xf6, 0x17, 0xe2,
-	0x58, 0xfe, 0xaf, 0xa2, 0xf6, 0xea, 0x46, 0x0f, 0xd4, 0xda, 0x7d, 0xf7, 0xed, 0xd7, 0xc7, 0xfa,
-	0x0e, 0xdb, 0x16, 0xf3, 0x6f, 0x29, 0x64, 0xfa, 0x9e, 0xd0, 0xad, 0xe9, 0x55, 0xd8, 0xc1, 0x72,
-	0xef, 0x25, 0x51, 0x45, 0x9d, 0xff, 0x69, 0x0d, 0x20, 0x7b, 0x08, 0xd2, 0x64, 0xf1, 0x02, 0xc8,
-	0x4c, 0x60, 0x87, 0x47, 0xe7, 0xa3, 0x98, 0x5c, 0x8c, 0x62, 0xf2, 0x73, 0x14, 0x93, 0x0f, 0xe3,
-	0xb8, 0x76, 0x31, 0x8e, 0x6b, 0xdf, 0xc7, 0x71, 0xed, 0xf9, 0x81, 0x54, 0xee, 0x64, 0xd8, 0xe7,
-	0x99, 0x19, 0x4c, 0x3c, 0xf0, 0x5b, 0xf6, 0xee, 0x88, 0x37, 0x13, 0x3f, 0x77, 0x96, 0x83, 0xed,
-	0xaf, 0xe3, 0x63, 0xbf, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc4, 0xde, 0x7f, 0xae, 0x03,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0x5a, 0xad, 0x74, 0xda, 0x5a, 0x3a, 0xa8, 0xdd, 0xa6, 0x9a, 0x2d, 0x2b, 0xf4,
+	0xcf, 0x5a, 0x66, 0xba, 0x2b, 0x08, 0x8a, 0x42, 0x69, 0xf1, 0xdf, 0xa5, 0x68, 0xf4, 0x24, 0x85,
+	0x35, 0xbb, 0x4e, 0xd3, 0xd0, 0xee, 0x4c, 0x9a, 0x99, 0x2c, 0x2d, 0x22, 0x88, 0x77, 0xa1, 0x20,
+	0x78, 0x17, 0x0f, 0x7a, 0xf4, 0x63, 0xf4, 0x58, 0xf0, 0xa2, 0x17, 0x95, 0x56, 0xf0, 0x6b, 0x48,
+	0x66, 0x26, 0x36, 0xd9, 0x44, 0xba, 0x97, 0x90, 0x9d, 0x79, 0x9f, 0xf7, 0xfd, 0xbd, 0xcf, 0x3e,
+	0x81, 0xd3, 0xb4, 0xdb, 0xe1, 0x82, 0xd0, 0x80, 0xb7, 0x37, 0x05, 0xe9, 0xd6, 0xc9, 0x4e, 0x44,
+	0xc3, 0x3d, 0x1c, 0x84, 0x5c, 0x72, 0x34, 0xae, 0x2e, 0xb1, 0xbe, 0xc4, 0xdd, 0xba, 0x35, 0xe1,
+	0x76, 0x7c, 0xc6, 0x89, 0x7a, 0xea, 0x1a, 0xab, 0xd6, 0xe6, 0x22, 0xee, 0xd0, 0x72, 0x05, 0xd5,
+	0x62, 0xd2, 0xad, 0xb7, 0xa8, 0x74, 0xeb, 0x24, 0x70, 0x3d, 0x9f, 0xb9, 0xd2, 0xe7, 0xcc, 0xd4,
+	0x5e, 0xe9, 0x1d, 0xe6, 0x51, 0x46, 0x85, 0x2f, 0xcc, 0xf5, 0x05, 0x8f, 0x7b, 0x5c, 0xbd, 0x92,
+	0xf8, 0xcd, 0x9c, 0x5e, 0xf6, 0x38, 0xf7, 0xb6, 0x29, 0x71, 0x03, 0x9f, 0xb8, 0x8c, 0x71, 0xa9,
+	0x3a, 0x26, 0x9a, 0x8a, 0xb9, 0x55, 0xbf, 0x5a, 0xd1, 0x06, 0x91, 0x7e, 0x87, 0x0a, 0xe9, 0x76,
+	0x02, 0x5d, 0x50, 0x7d, 0x0e, 0x2f, 0x3d, 0x8e, 0xa9, 0xee, 0xaa, 0xa1, 0x0f, 0xd9, 0x06, 0x77,
+	0xe8, 0x4e, 0x44, 0x85, 0x44, 0xf7, 0x20, 0x3c, 0x21, 0x2c, 0x83, 0x19, 0x30, 0x3f, 0xd2, 0x98,
+	0xc5, 0x7a, 0x1d, 0x1c, 0xaf, 0x83, 0xb5, 0x17, 0x66, 0x1d, 0xfc, 0xc8, 0xf5, 0xa8, 0xd1, 0x3a,
+	0x29, 0x65, 0xf5, 0x03, 0x80, 0x93, 0xb9, 0x11, 0x22, 0xe0, 0x4c, 0x50, 0x74, 0x07, 0x0e, 0xe9,
+	0x6d, 0xcb, 0x60, 0x66, 0x70, 0x7e, 0xa4, 0x61, 0xe1, 0x1e, 0x4b, 0xb1, 0x12, 0xc5, 0x9a, 0x95,
+	0xe1, 0x83, 0x1f, 0x95, 0xd2, 0xe7, 0x3f, 0x5f, 0x6a, 0xc0, 0x31, 0x22, 0x74, 0x3f, 0x83, 0x38,
+	0xa0, 0x10, 0xe7, 0x4e, 0x45, 0xd4, 0xb3, 0x33, 0x8c, 0xb7, 0x60, 0x59, 0x21, 0xae, 0x46, 0x61,
+	0x48, 0x99, 0x54, 0x43, 0x13, 0x1f, 0x6c, 0x08, 0xfd, 0x17, 0x94, 0x49, 0x7f, 0xc3, 0xa7, 0xa1,
+	0xf2, 0x61, 0xd8, 0x49, 0x9d, 0x54, 0x97, 0xe1, 0x54, 0x81, 0xd6, 0x2c, 0x78, 0x15, 0x8e, 0xb5,
+	0xf5, 0x79, 0x53, 0x31, 0x2b, 0xfd, 0xa0, 0x33, 0xda, 0x4e, 0x15, 0x57, 0x6f, 0x1a, 0x83, 0x1e,
+	0x70, 0xbe, 0xe5, 0x50, 0x11, 0x6d, 0x4b, 0xd1, 0xef, 0xf0, 0x75, 0x58, 0xce, 0x4b, 0xcd, 0xec,
+	0x65, 0x78, 0x2e, 0xd4, 0x47, 0xc6, 0xdd, 0xe9, 0x9c, 0xbb, 0x27, 0xb2, 0xb4, 0xbd, 0x89, 0xac,
+	0x7a, 0x1b, 0x5a, 0xaa, 0xfb, 0x1a, 0xdd, 0xd5, 0xa8, 0x4f, 0xa4, 0x1b, 0xca, 0x7e, 0xd9, 0x3e,
+	0x01, 0x38, 0x5d, 0x28, 0x37, 0x7c, 0x35, 0x38, 0xc1, 0xe8, 0xae, 0x31, 0xa6, 0xc9, 0xa2, 0x4e,
+	0xcb, 0xb4, 0x19, 0x74, 0xc6, 0x59, 0x22, 0x59, 0x53, 0xc7, 0x68, 0x1d, 0x5e, 0x4c, 0xd5, 0x8a,
+	0xb8, 0x4f, 0x33, 0x8e, 0xb2, 0xf9, 0xd3, 0x2d, 0xac, 0x73, 0x8e, 0x93, 0x9c, 0xe3, 0xa7, 0x49,
+	0xce, 0x57, 0xc6, 0xe2, 0xc5, 0xf6, 0x7f, 0x56, 0x80, 0x5e, 0x0e, 0xb1, 0x0c, 0x4d, 0x5c, 0xd7,
+	0xf8, 0x7e, 0x06, 0x9e, 0x55, 0xa4, 0xe8, 0x35, 0x80, 0xf0, 0x5f, 0xe4, 0x04, 0x9a, 0xcb, 0x39,
+	0x56, 0xfc, 0xb1, 0x58, 0xf3, 0xa7, 0x17, 0xea, 0xad, 0xab, 0x95, 0x37, 0x5f, 0x7f, 0xbf, 0x1b,
+	0x98, 0x42, 0x93, 0xa4, 0xf7, 0x6b, 0xd7, 0x6f, 0xe8, 0x2d, 0x80, 0xa3, 0xe9, 0x2c, 0xa1, 0x85,
+	0xe2, 0xde, 0x05, 0x59, 0xb5, 0x6a, 0xfd, 0x94, 0x1a, 0x90, 0x59, 0x05, 0x32, 0x83, 0xec, 0x1c,
+	0x48, 0x26, 0xb1, 0xe8, 0x3d, 0x80, 0x23, 0xa9, 0x78, 0xa1, 0xff, 0xac, 0x9a, 0x0f, 0xaf, 0xb5,
+	0xd0, 0x47, 0xa5, 0x81, 0x69, 0x28, 0x98, 0x45, 0x54, 0xcb, 0xc1, 0x6c, 0x72, 0xbe, 0xd5, 0x34,
+	0x81, 0x24, 0x2f, 0x4f, 0xe2, 0xf5, 0x0a, 0x7d, 0x04, 0xf0, 0x7c, 0x36, 0x5a, 0xe8, 0x5a, 0xf1,
+	0xc4, 0xc2, 0xfc, 0x5a, 0x8b, 0xfd, 0x15, 0x1b, 0xc2, 0x1b, 0x8a, 0x70, 0x09, 0xe1, 0x1c, 0x61,
+	0x6f, 0x30, 0x33, 0x94, 0x2b, 0xab, 0x07, 0x47, 0x36, 0x38, 0x3c, 0xb2, 0xc1, 0xaf, 0x23, 0x1b,
+	0xec, 0x1f, 0xdb, 0xa5, 0xc3, 0x63, 0xbb, 0xf4, 0xed, 0xd8, 0x2e, 0x3d, 0x5b, 0xf0, 0x7c, 0xb9,
+	0x19, 0xb5, 0x70, 0x9b, 0x77, 0x92, 0x9e, 0xea, 0xd9, 0x6d, 0x2c, 0x91, 0xdd, 0xa4, 0xbf, 0xdc,
+	0x0b, 0xa8, 0x68, 0x0d, 0xa9, 0x5c, 0x5f, 0xff, 0x3b, 0x00, 0x65, 0x81, 0xf5, 0xda, 0x8f, 0x06,
 	0x00, 0x00,
 }
 
@@ -287,6 +506,12 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// HookResults provide the results of the last hook executions of specified
+	// identifier
+	HookResults(ctx context.Context, in *QueryHookResultsRequest, opts ...grpc.CallOption) (*QueryHookResultsResponse, error)
+	// NextEpochStart provide the expected start time of the next epoch of
+	// specified identifier
+	NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error)
 }
 
 type queryClient struct {
@@ -315,12 +540,36 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) HookResults(ctx context.Context, in *QueryHookResultsRequest, opts ...grpc.CallOption) (*QueryHookResultsResponse, error) {
+	out := new(QueryHookResultsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/HookResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error) {
+	out := new(QueryNextEpochStartResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/NextEpochStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// HookResults provide the results of the last hook executions of specified
+	// identifier
+	HookResults(context.Context, *QueryHookResultsRequest) (*QueryHookResultsResponse, error)
+	// NextEpochStart provide the expected start time of the next epoch of
+	// specified identifier
+	NextEpochStart(context.Context, *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -333,6 +582,12 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) HookResults(ctx context.Context, req *QueryHookResultsRequest) (*QueryHookResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookResults not implemented")
+}
+func (*UnimplementedQueryServer) NextEpochStart(ctx context.Context, req *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochStart not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -374,6 +629,42 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HookResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/HookResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookResults(ctx, req.(*QueryHookResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextEpochStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/NextEpochStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochStart(ctx, req.(*QueryNextEpochStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -386,6 +677,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "HookResults",
+			Handler:    _Query_HookResults_Handler,
+		},
+		{
+			MethodName: "NextEpochStart",
+			Handler:    _Query_NextEpochStart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/epochs/v1/query.proto",
@@ -533,81 +832,475 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHookResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEpochsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryHookResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextEpochStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Epochs) > 0 {
-		for _, e := range m.Epochs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.NextEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEpochsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryHookResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHookResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextEpochStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextEpochStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -630,17 +1323,17 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -650,28 +1343,93 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -693,7 +1451,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHookResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -716,17 +1474,17 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHookResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHookResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -736,29 +1494,77 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epochs = append(m.Epochs, EpochInfo{})
-			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHookResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHookResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHookResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -785,10 +1591,8 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, HookResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -813,7 +1617,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextEpochStartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,10 +1640,10 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextEpochStartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextEpochStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -895,7 +1699,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextEpochStartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,17 +1722,17 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextEpochStartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextEpochStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochNumber", wireType)
 			}
-			m.CurrentEpoch = 0
+			m.NextEpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -938,11 +1742,44 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= int64(b&0x7F) << shift
+				m.NextEpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
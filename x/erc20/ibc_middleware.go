@@ -37,7 +37,8 @@ func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
 // OnRecvPacket implements the IBCModule interface.
 // It receives the tokens through the default ICS20 OnRecvPacket callback logic
 // and then automatically converts the Cosmos Coin to their ERC20 token
-// representation.
+// representation. Finally, it calls the destination contract set in the `evm`
+// memo of the packet, if any.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
 func (im IBCMiddleware) OnRecvPacket(
@@ -52,12 +53,18 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	ack = im.keeper.OnRecvPacket(ctx, packet, ack)
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacketEVMCallback(ctx, packet, ack)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. Finally, it calls the
+// source contract set in the `evm` memo of the packet, if any.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -78,12 +85,18 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
-	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacketEVMCallback(ctx, packet, data, ack)
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. Finally, it calls the
+// source contract set in the `evm` memo of the packet, if any.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -98,5 +111,10 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
-	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacketEVMCallback(ctx, packet, data)
+	return nil
 }
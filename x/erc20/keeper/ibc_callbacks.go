@@ -20,6 +20,7 @@ import (
 
 	"github.com/evmos/evmos/v20/ibc"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	forwardtypes "github.com/evmos/evmos/v20/x/ibc/forward/types"
 )
 
 // OnRecvPacket performs the ICS20 middleware receive callback for automatically
@@ -75,6 +76,14 @@ func (k Keeper) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(types.ErrInvalidIBC)
 	}
 
+	// return acknowledgement without conversion if the tokens are received by
+	// the forward middleware, which passes them on to the next hop. The
+	// receiver account is checked by address, as it is not a blocked module
+	// account and it may not exist yet.
+	if recipient.Equals(forwardtypes.ReceiverAddress) {
+		return ack
+	}
+
 	receiverAcc := k.accountKeeper.GetAccount(ctx, recipient)
 
 	// return acknowledgement without conversion if receiver is a module account
@@ -27,6 +27,7 @@ import (
 	"github.com/evmos/evmos/v20/contracts"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	forwardtypes "github.com/evmos/evmos/v20/x/ibc/forward/types"
 )
 
 var erc20Denom = "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7"
@@ -313,6 +314,64 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketForwardReceiver() {
+	sourceChannel := "channel-292"
+	evmosChannel := "channel-3"
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	// single hop IBC coin, which is registered as an ERC20 extension on
+	// receipt unless the receiver is skipped
+	baseDenom := "uatom"
+	ibcDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, evmosChannel, baseDenom),
+	).IBCDenom()
+
+	testCases := []struct {
+		name        string
+		receiver    func() sdk.AccAddress
+		expRegister bool
+	}{
+		{
+			name: "pass - ERC20 extension registered for a user receiver",
+			receiver: func() sdk.AccAddress {
+				return suite.keyring.GetAccAddr(0)
+			},
+			expRegister: true,
+		},
+		{
+			name: "no-op - forward receiver account that does not exist yet",
+			receiver: func() sdk.AccAddress {
+				ctx := suite.network.GetContext()
+				acc := suite.network.App.AccountKeeper.GetAccount(ctx, forwardtypes.ReceiverAddress)
+				suite.Require().NotNil(acc)
+				suite.network.App.AccountKeeper.RemoveAccount(ctx, acc)
+				return forwardtypes.ReceiverAddress
+			},
+			expRegister: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			receiver := tc.receiver()
+			sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			transfer := transfertypes.NewFungibleTokenPacketData(baseDenom, "100", sender.String(), receiver.String(), "")
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+
+			ack := suite.network.App.Erc20Keeper.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+			suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+			pairID := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, ibcDenom)
+			_, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, pairID)
+			suite.Require().Equal(tc.expRegister, found)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 	var ctx sdk.Context
 	senderAddr := "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v"
@@ -0,0 +1,240 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// OnRecvPacketEVMCallback calls the destination callback contract set in the
+// `evm` memo of a received ICS20 packet with the received ERC-20 token and amount.
+//
+// The packet receiver MUST be the callback contract so that the contract holds
+// the received tokens when it is called. If the memo is malformed or the callback
+// fails, an error acknowledgement is returned so that the tokens are refunded to
+// the sender on the counterparty chain.
+//
+// CONTRACT: This callback MUST be executed after the ERC-20 OnRecvPacket callback.
+func (k Keeper) OnRecvPacketEVMCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS20 transfer logic
+		err = errorsmod.Wrapf(errortypes.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	callbacks, found, err := types.ParseEVMCallbacks(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !found || callbacks.DestCallback == nil {
+		return ack
+	}
+
+	if !k.IsERC20Enabled(ctx) {
+		return channeltypes.NewErrorAcknowledgement(types.ErrERC20Disabled)
+	}
+
+	callback := callbacks.DestCallback
+	contract := callback.ContractAddress()
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if !bytes.Equal(recipient.Bytes(), contract.Bytes()) {
+		err = errorsmod.Wrapf(types.ErrInvalidEVMCallback, "packet receiver %s is not the callback contract %s", data.Receiver, callback.Address)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+	if !found || !pair.Enabled {
+		err = errorsmod.Wrapf(types.ErrTokenPairNotFound, "no enabled token pair for received coin %s", coin.Denom)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	err = k.callEVMCallback(
+		ctx, callback, types.CallbackMethodRecv,
+		packet.SourceChannel, data.Sender, pair.GetERC20Contract(), coin.Amount.BigInt(), []byte(callback.Calldata),
+	)
+	k.emitEVMCallbackEvent(ctx, types.CallbackTypeRecv, callback.Address, packet.Sequence, err)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacketEVMCallback calls the source callback contract set in
+// the `evm` memo of an acknowledged ICS20 packet.
+//
+// The source callback contract MUST be the packet sender. A failing callback
+// does not revert the acknowledgement, so that the refund of the tokens on an
+// error acknowledgement is always processed. Its state changes are discarded.
+func (k Keeper) OnAcknowledgementPacketEVMCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) {
+	k.executeSrcEVMCallback(
+		ctx, packet, data, types.CallbackTypeAck, types.CallbackMethodAck,
+		packet.SourceChannel, packet.Sequence, ack.Success(),
+	)
+}
+
+// OnTimeoutPacketEVMCallback calls the source callback contract set in the `evm`
+// memo of a timed out ICS20 packet.
+//
+// The source callback contract MUST be the packet sender. A failing callback
+// does not revert the timeout, so that the refund of the tokens is always
+// processed. Its state changes are discarded.
+func (k Keeper) OnTimeoutPacketEVMCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) {
+	k.executeSrcEVMCallback(
+		ctx, packet, data, types.CallbackTypeTimeout, types.CallbackMethodTimeout,
+		packet.SourceChannel, packet.Sequence,
+	)
+}
+
+// executeSrcEVMCallback calls the given method on the source callback contract
+// of the packet, if any. Errors are only reported through the callback event.
+func (k Keeper) executeSrcEVMCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	callbackType, method string,
+	args ...interface{},
+) {
+	callbacks, found, err := types.ParseEVMCallbacks(data.Memo)
+	if !found || (err == nil && callbacks.SrcCallback == nil) {
+		return
+	}
+	if err != nil {
+		k.emitEVMCallbackEvent(ctx, callbackType, "", packet.Sequence, err)
+		return
+	}
+
+	callback := callbacks.SrcCallback
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err == nil && !bytes.Equal(sender.Bytes(), callback.ContractAddress().Bytes()) {
+		err = errorsmod.Wrapf(types.ErrInvalidEVMCallback, "packet sender %s is not the callback contract %s", data.Sender, callback.Address)
+	}
+
+	if err == nil {
+		err = k.callEVMCallback(ctx, callback, method, args...)
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to execute evm callback",
+			"type", callbackType, "contract", callback.Address, "sequence", packet.Sequence, "error", err,
+		)
+	}
+
+	k.emitEVMCallbackEvent(ctx, callbackType, callback.Address, packet.Sequence, err)
+}
+
+// callEVMCallback calls the given method on the callback contract from the
+// ERC-20 module account, limited to the gas limit of the callback. The gas used
+// is consumed from the context gas meter and the state changes are only
+// committed if the call succeeds.
+func (k Keeper) callEVMCallback(
+	ctx sdk.Context,
+	callback *types.EVMCallback,
+	method string,
+	args ...interface{},
+) error {
+	contract := callback.ContractAddress()
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return errorsmod.Wrapf(types.ErrInvalidEVMCallback, "callback address %s is not a contract", callback.Address)
+	}
+
+	input, err := contracts.IIBCCallbacksContract.ABI.Pack(method, args...)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return err
+	}
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&contract,
+		nonce,
+		big.NewInt(0),     // amount
+		callback.GasLimit, // gasLimit
+		big.NewInt(0),     // gasFeeCap
+		big.NewInt(0),     // gasTipCap
+		big.NewInt(0),     // gasPrice
+		input,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrEVMCallbackFailed, err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm callback")
+
+	if res.Failed() {
+		return errorsmod.Wrap(types.ErrEVMCallbackFailed, res.VmError)
+	}
+
+	writeFn()
+	return nil
+}
+
+// emitEVMCallbackEvent emits the result of an EVM callback execution.
+func (k Keeper) emitEVMCallbackEvent(ctx sdk.Context, callbackType, contract string, sequence uint64, err error) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyCallbackAddress, contract),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEVMCallback, attrs...))
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+var (
+	// storeCalldataSizeCode is the runtime code of a contract that stores the
+	// size of its calldata in the first storage slot:
+	// CALLDATASIZE PUSH1 0x00 SSTORE STOP
+	storeCalldataSizeCode = common.FromHex("0x3660005500")
+	// revertCode is the runtime code of a contract that always reverts:
+	// PUSH1 0x00 PUSH1 0x00 REVERT
+	revertCode = common.FromHex("0x60006000fd")
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEVMCallback() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		receiver string
+		memo     string
+		denom    string
+	)
+
+	sourceChannel := "channel-292"
+	evmosChannel := "channel-3"
+	sender := sdk.MustBech32ifyAddressBytes(sdk.Bech32MainPrefix, tx.GenerateAddress().Bytes())
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	callbackMemo := func(addr common.Address, gasLimit uint64) string {
+		return fmt.Sprintf(`{"evm":{"dest_callback":{"address":"%s","gas_limit":%d,"calldata":"0x1234"}}}`, addr.Hex(), gasLimit)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		ackSuccess bool
+		expCalled  bool
+	}{
+		{
+			"no-op - no memo",
+			func() {
+				memo = ""
+			},
+			true,
+			false,
+		},
+		{
+			"no-op - memo without evm callbacks",
+			func() {
+				memo = "transfer from cosmos"
+			},
+			true,
+			false,
+		},
+		{
+			"fail - invalid evm memo",
+			func() {
+				memo = callbackMemo(contract, 0)
+			},
+			false,
+			false,
+		},
+		{
+			"fail - receiver is not the callback contract",
+			func() {
+				receiver = sdk.AccAddress(tx.GenerateAddress().Bytes()).String()
+			},
+			false,
+			false,
+		},
+		{
+			"fail - callback address is not a contract",
+			func() {
+				contract = tx.GenerateAddress()
+				receiver = sdk.AccAddress(contract.Bytes()).String()
+				memo = callbackMemo(contract, 100_000)
+			},
+			false,
+			false,
+		},
+		{
+			"fail - received coin without token pair",
+			func() {
+				denom = "uatom"
+			},
+			false,
+			false,
+		},
+		{
+			"fail - erc20 module disabled",
+			func() {
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.EnableErc20 = false
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+			},
+			false,
+			false,
+		},
+		{
+			"fail - callback reverts",
+			func() {
+				suite.setCallbackCode(ctx, contract, revertCode)
+			},
+			false,
+			false,
+		},
+		{
+			"fail - callback runs out of gas",
+			func() {
+				memo = callbackMemo(contract, 100)
+			},
+			false,
+			false,
+		},
+		{
+			"success - callback executed",
+			func() {},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			contract = tx.GenerateAddress()
+			suite.setCallbackCode(ctx, contract, storeCalldataSizeCode)
+			receiver = sdk.AccAddress(contract.Bytes()).String()
+			memo = callbackMemo(contract, 100_000)
+			denom = "uosmo"
+
+			// register the token pair of the received IBC voucher
+			voucher := ibc.GetReceivedCoin(transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, "uosmo", "100")
+			_, err := suite.network.App.Erc20Keeper.CreateNewTokenPair(ctx, voucher.Denom)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			transfer := transfertypes.NewFungibleTokenPacketData(denom, "100", sender, receiver, memo)
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			expAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+			ack := suite.network.App.Erc20Keeper.OnRecvPacketEVMCallback(ctx, packet, expAck)
+
+			if tc.ackSuccess {
+				suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+				suite.Require().Equal(expAck, ack)
+			} else {
+				suite.Require().False(ack.Success(), string(ack.Acknowledgement()))
+			}
+
+			stored := suite.network.App.EvmKeeper.GetState(ctx, contract, common.Hash{})
+			suite.Require().Equal(tc.expCalled, stored != common.Hash{})
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementAndTimeoutPacketEVMCallback() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		sender   string
+		memo     string
+	)
+
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+	receiver := sdk.MustBech32ifyAddressBytes(sdk.Bech32MainPrefix, tx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expCalled  bool
+		expEvent   bool
+		expSuccess bool
+	}{
+		{
+			"no-op - no evm callbacks",
+			func() {
+				memo = ""
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"no-op - only destination callback",
+			func() {
+				memo = fmt.Sprintf(`{"evm":{"dest_callback":{"address":"%s","gas_limit":100000}}}`, contract.Hex())
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"fail - invalid evm memo",
+			func() {
+				memo = `{"evm":{}}`
+			},
+			false,
+			true,
+			false,
+		},
+		{
+			"fail - sender is not the callback contract",
+			func() {
+				sender = sdk.AccAddress(tx.GenerateAddress().Bytes()).String()
+			},
+			false,
+			true,
+			false,
+		},
+		{
+			"fail - callback reverts",
+			func() {
+				suite.setCallbackCode(ctx, contract, revertCode)
+			},
+			false,
+			true,
+			false,
+		},
+		{
+			"success - callback executed",
+			func() {},
+			true,
+			true,
+			true,
+		},
+	}
+
+	for _, callbackType := range []string{types.CallbackTypeAck, types.CallbackTypeTimeout} {
+		for _, tc := range testCases {
+			suite.Run(fmt.Sprintf("%s - %s", callbackType, tc.name), func() {
+				suite.SetupTest()
+				ctx = suite.network.GetContext()
+
+				contract = tx.GenerateAddress()
+				suite.setCallbackCode(ctx, contract, storeCalldataSizeCode)
+				sender = sdk.AccAddress(contract.Bytes()).String()
+				memo = fmt.Sprintf(`{"evm":{"src_callback":{"address":"%s","gas_limit":100000}}}`, contract.Hex())
+
+				tc.malleate()
+
+				data := transfertypes.NewFungibleTokenPacketData("aevmos", "100", sender, receiver, memo)
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&data)
+				packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-292", timeoutHeight, 0)
+
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				if callbackType == types.CallbackTypeAck {
+					ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidIBC)
+					suite.network.App.Erc20Keeper.OnAcknowledgementPacketEVMCallback(ctx, packet, data, ack)
+				} else {
+					suite.network.App.Erc20Keeper.OnTimeoutPacketEVMCallback(ctx, packet, data)
+				}
+
+				stored := suite.network.App.EvmKeeper.GetState(ctx, contract, common.Hash{})
+				suite.Require().Equal(tc.expCalled, stored != common.Hash{})
+
+				var found bool
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type != types.EventTypeEVMCallback {
+						continue
+					}
+					found = true
+					attr, ok := event.GetAttribute(types.AttributeKeyCallbackType)
+					suite.Require().True(ok)
+					suite.Require().Equal(callbackType, attr.Value)
+					attr, ok = event.GetAttribute(types.AttributeKeySuccess)
+					suite.Require().True(ok)
+					suite.Require().Equal(fmt.Sprint(tc.expSuccess), attr.Value)
+				}
+				suite.Require().Equal(tc.expEvent, found)
+			})
+		}
+	}
+}
+
+// setCallbackCode sets the given runtime code on the callback contract address.
+func (suite *KeeperTestSuite) setCallbackCode(ctx sdk.Context, addr common.Address, code []byte) {
+	codeHash := crypto.Keccak256(code)
+	suite.network.App.EvmKeeper.SetCode(ctx, codeHash, code)
+	err := suite.network.App.EvmKeeper.SetAccount(ctx, addr, statedb.Account{
+		CodeHash: codeHash,
+		Balance:  math.ZeroInt().BigInt(),
+	})
+	suite.Require().NoError(err)
+}
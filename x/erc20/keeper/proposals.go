@@ -37,6 +37,37 @@ func (k Keeper) registerERC20(
 	return &pair, nil
 }
 
+// RegisterERC20Permissionless registers the token pair of the ERC20 contract
+// on behalf of any account, when permissionless registration is enabled by the
+// module parameters.
+func (k Keeper) RegisterERC20Permissionless(
+	ctx sdk.Context,
+	contract common.Address,
+) (*types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	if !k.IsPermissionlessRegistrationEnabled(ctx) {
+		return nil, types.ErrPermissionlessDisabled
+	}
+
+	pair, err := k.registerERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
 // CreateCoinMetadata generates the metadata to represent the ERC20 token on
 // evmos.
 func (k Keeper) CreateCoinMetadata(
@@ -24,4 +24,7 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrInvalidEVMCallback       = errorsmod.Register(ModuleName, 17, "invalid EVM callback")
+	ErrEVMCallbackFailed        = errorsmod.Register(ModuleName, 18, "EVM callback failed")
+	ErrPermissionlessDisabled   = errorsmod.Register(ModuleName, 19, "permissionless registration is disabled")
 )
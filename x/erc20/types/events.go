@@ -15,11 +15,17 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeEVMCallback            = "evm_callback"
 
-	AttributeCoinSourceChannel = "source_channel"
-	AttributeKeyCosmosCoin     = "cosmos_coin"
-	AttributeKeyERC20Token     = "erc20_token" // #nosec
-	AttributeKeyReceiver       = "receiver"
+	AttributeCoinSourceChannel  = "source_channel"
+	AttributeKeyCosmosCoin      = "cosmos_coin"
+	AttributeKeyERC20Token      = "erc20_token" // #nosec
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyCallbackType    = "callback_type"
+	AttributeKeyCallbackAddress = "callback_address"
+	AttributeKeyPacketSequence  = "packet_sequence"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// EVMMemoKey is the key of the ICS20 packet memo field that holds the EVM callbacks.
+	EVMMemoKey = "evm"
+	// MaxCallbackGasLimit is the maximum gas limit a single EVM callback can request.
+	MaxCallbackGasLimit uint64 = 1_000_000
+
+	// CallbackTypeRecv is the callback type of the contract call on packet receipt.
+	CallbackTypeRecv = "recv"
+	// CallbackTypeAck is the callback type of the contract call on packet acknowledgement.
+	CallbackTypeAck = "ack"
+	// CallbackTypeTimeout is the callback type of the contract call on packet timeout.
+	CallbackTypeTimeout = "timeout"
+
+	// CallbackMethodRecv is the contract method called on packet receipt.
+	CallbackMethodRecv = "onRecv"
+	// CallbackMethodAck is the contract method called on packet acknowledgement.
+	CallbackMethodAck = "onAck"
+	// CallbackMethodTimeout is the contract method called on packet timeout.
+	CallbackMethodTimeout = "onTimeout"
+)
+
+// EVMCallbacks defines the EVM callbacks of an ICS20 packet. They are set in
+// the packet memo under the EVMMemoKey, e.g.:
+//
+//	{"evm": {"dest_callback": {"address": "0x...", "gas_limit": 200000, "calldata": "0x..."}}}
+//
+// The destination callback is executed on the receiving chain and the source
+// callback on the sending chain once the packet is acknowledged or timed out.
+type EVMCallbacks struct {
+	DestCallback *EVMCallback `json:"dest_callback,omitempty"`
+	SrcCallback  *EVMCallback `json:"src_callback,omitempty"`
+}
+
+// EVMCallback defines the contract to call back, the gas limit of the call and
+// the optional calldata forwarded to the contract on packet receipt.
+type EVMCallback struct {
+	Address  string        `json:"address"`
+	GasLimit uint64        `json:"gas_limit"`
+	Calldata hexutil.Bytes `json:"calldata,omitempty"`
+}
+
+// ParseEVMCallbacks returns the EVM callbacks set in the given ICS20 packet memo.
+// It returns false if the memo is not a JSON object or does not contain the
+// EVMMemoKey, and an error if the EVM callbacks are malformed.
+func ParseEVMCallbacks(memo string) (*EVMCallbacks, bool, error) {
+	if memo == "" {
+		return nil, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// memos that are not JSON objects are not meant for the EVM callbacks
+		return nil, false, nil //nolint:nilerr
+	}
+
+	raw, found := fields[EVMMemoKey]
+	if !found {
+		return nil, false, nil
+	}
+
+	var callbacks EVMCallbacks
+	if err := json.Unmarshal(raw, &callbacks); err != nil {
+		return nil, true, errorsmod.Wrapf(ErrInvalidEVMCallback, "failed to unmarshal %q memo: %s", EVMMemoKey, err)
+	}
+
+	if callbacks.DestCallback == nil && callbacks.SrcCallback == nil {
+		return nil, true, errorsmod.Wrapf(ErrInvalidEVMCallback, "%q memo does not contain any callback", EVMMemoKey)
+	}
+
+	if callbacks.DestCallback != nil {
+		if err := callbacks.DestCallback.Validate(); err != nil {
+			return nil, true, errorsmod.Wrap(err, "invalid destination callback")
+		}
+	}
+
+	if callbacks.SrcCallback != nil {
+		if err := callbacks.SrcCallback.Validate(); err != nil {
+			return nil, true, errorsmod.Wrap(err, "invalid source callback")
+		}
+	}
+
+	return &callbacks, true, nil
+}
+
+// Validate performs a stateless validation of the EVM callback.
+func (c EVMCallback) Validate() error {
+	if !common.IsHexAddress(c.Address) {
+		return errorsmod.Wrapf(ErrInvalidEVMCallback, "invalid contract address %s", c.Address)
+	}
+
+	if c.GasLimit == 0 || c.GasLimit > MaxCallbackGasLimit {
+		return errorsmod.Wrapf(ErrInvalidEVMCallback, "gas limit must be between 1 and %d, got %d", MaxCallbackGasLimit, c.GasLimit)
+	}
+
+	return nil
+}
+
+// ContractAddress returns the address of the contract to call back.
+func (c EVMCallback) ContractAddress() common.Address {
+	return common.HexToAddress(c.Address)
+}
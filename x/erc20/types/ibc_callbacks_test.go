@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEVMCallbacks(t *testing.T) {
+	contract := "0x1D3E5a9d3e5Bc6bc3Cd6eb6Ce93b27B8B4C9F0a6"
+
+	testCases := []struct {
+		name      string
+		memo      string
+		expFound  bool
+		expPass   bool
+		expDest   bool
+		expSrc    bool
+		expErrMsg string
+	}{
+		{"empty memo", "", false, true, false, false, ""},
+		{"plain text memo", "hello", false, true, false, false, ""},
+		{"json memo without evm key", `{"wasm":{}}`, false, true, false, false, ""},
+		{"evm memo without callbacks", `{"evm":{}}`, true, false, false, false, "does not contain any callback"},
+		{"evm memo is not an object", `{"evm":"0x"}`, true, false, false, false, "failed to unmarshal"},
+		{"invalid contract address", `{"evm":{"dest_callback":{"address":"evmos1","gas_limit":100000}}}`, true, false, false, false, "invalid contract address"},
+		{"zero gas limit", `{"evm":{"dest_callback":{"address":"` + contract + `","gas_limit":0}}}`, true, false, false, false, "gas limit"},
+		{"gas limit above maximum", `{"evm":{"src_callback":{"address":"` + contract + `","gas_limit":1000001}}}`, true, false, false, false, "gas limit"},
+		{"invalid calldata", `{"evm":{"dest_callback":{"address":"` + contract + `","gas_limit":100000,"calldata":"zz"}}}`, true, false, false, false, "failed to unmarshal"},
+		{"valid destination callback", `{"evm":{"dest_callback":{"address":"` + contract + `","gas_limit":100000,"calldata":"0x1234"}}}`, true, true, true, false, ""},
+		{"valid source callback", `{"evm":{"src_callback":{"address":"` + contract + `","gas_limit":100000}}}`, true, true, false, true, ""},
+		{
+			"valid source and destination callbacks with other memo keys",
+			`{"forward":{},"evm":{"dest_callback":{"address":"` + contract + `","gas_limit":100000},"src_callback":{"address":"` + contract + `","gas_limit":100000}}}`,
+			true, true, true, true, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			callbacks, found, err := types.ParseEVMCallbacks(tc.memo)
+			require.Equal(t, tc.expFound, found)
+
+			if !tc.expPass {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				require.Nil(t, callbacks)
+				return
+			}
+
+			require.NoError(t, err)
+			if !tc.expFound {
+				require.Nil(t, callbacks)
+				return
+			}
+
+			require.Equal(t, tc.expDest, callbacks.DestCallback != nil)
+			require.Equal(t, tc.expSrc, callbacks.SrcCallback != nil)
+			if tc.expDest {
+				require.Equal(t, contract, callbacks.DestCallback.ContractAddress().Hex())
+			}
+		})
+	}
+}
@@ -44,6 +44,8 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	// ParamStoreKeyPermissionlessRegistration is set when any account can register ERC20 token pairs
+	ParamStoreKeyPermissionlessRegistration = []byte("PermissionlessRegistration")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
@@ -88,6 +90,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.PermissionlessRegistration); err != nil {
+		return err
+	}
+
 	npAddrs, err := ValidatePrecompiles(p.NativePrecompiles)
 	if err != nil {
 		return err
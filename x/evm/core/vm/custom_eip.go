@@ -5,6 +5,7 @@ package vm
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -17,6 +18,12 @@ type OpCodeInfo struct {
 	Name   string
 }
 
+// OpCodeGas defines a constant gas override for an EVM operation.
+type OpCodeGas struct {
+	OpCode      OpCode
+	ConstantGas uint64
+}
+
 // Operation is an utility struct that wraps the private type
 // operation.
 type Operation struct {
@@ -78,6 +85,43 @@ func GetActivatorsEipNames() []string {
 	return keys
 }
 
+// ParseOpCode returns the opcode with the given name. It returns an error
+// if the name does not correspond to a defined opcode.
+func ParseOpCode(name string) (OpCode, error) {
+	op := StringToOp(name)
+	if op.String() != name {
+		return op, fmt.Errorf("undefined opcode %s", name)
+	}
+	return op, nil
+}
+
+// ApplyGasSchedule overrides the constant gas of the operations on the given
+// jump table with the values defined on the gas schedule.
+func ApplyGasSchedule(jt *JumpTable, schedule []OpCodeGas) {
+	for _, opGas := range schedule {
+		jt[opGas.OpCode].SetConstantGas(opGas.ConstantGas)
+	}
+}
+
+// ValidateGasSchedule checks that the operations overridden by the gas
+// schedule are defined on the given jump table. As the gas schedule only
+// overrides the constant gas, operations whose gas is fully dynamic (e.g.
+// SSTORE after Berlin) are rejected too.
+func ValidateGasSchedule(jt *JumpTable, schedule []OpCodeGas) error {
+	undefined := reflect.ValueOf(opUndefined).Pointer()
+	for _, opGas := range schedule {
+		op := jt[opGas.OpCode]
+		if op == nil || reflect.ValueOf(op.execute).Pointer() == undefined {
+			return fmt.Errorf("opcode %s is not defined on the jump table", opGas.OpCode)
+		}
+
+		if op.constantGas == 0 && op.dynamicGas != nil {
+			return fmt.Errorf("opcode %s gas is fully dynamic and cannot be overridden", opGas.OpCode)
+		}
+	}
+	return nil
+}
+
 // newOperation returns an instance of a new EVM operation.
 func newOperation(
 	execute executionFunc,
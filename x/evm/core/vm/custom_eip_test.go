@@ -241,3 +241,35 @@ func TestAddOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOpCode(t *testing.T) {
+	op, err := ParseOpCode("SSTORE")
+	require.NoError(t, err)
+	require.Equal(t, SSTORE, op)
+
+	op, err = ParseOpCode("STOP")
+	require.NoError(t, err)
+	require.Equal(t, STOP, op)
+
+	_, err = ParseOpCode("NOT_AN_OPCODE")
+	require.ErrorContains(t, err, "undefined opcode")
+
+	_, err = ParseOpCode("sstore")
+	require.ErrorContains(t, err, "undefined opcode")
+}
+
+func TestApplyGasSchedule(t *testing.T) {
+	jt := CopyJumpTable(&MergeInstructionSet)
+	logGas := jt[LOG1].GetConstantGas()
+
+	ApplyGasSchedule(jt, []OpCodeGas{
+		{OpCode: SSTORE, ConstantGas: 5_000},
+		{OpCode: CALL, ConstantGas: 1_000},
+	})
+
+	require.Equal(t, uint64(5_000), jt[SSTORE].GetConstantGas())
+	require.Equal(t, uint64(1_000), jt[CALL].GetConstantGas())
+	require.Equal(t, logGas, jt[LOG1].GetConstantGas())
+	// the source jump table is not modified
+	require.NotEqual(t, uint64(5_000), MergeInstructionSet[SSTORE].GetConstantGas())
+}
@@ -438,6 +438,12 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, common.Address{}, gas, ErrDepth
 	}
+	// Check whether the max initcode size has been exceeded. As per EIP-3860,
+	// all the gas is consumed. The initcode of a contract creation transaction
+	// is checked before the execution.
+	if uint64(len(codeAndHash.code)) > evm.maxInitCodeSize() {
+		return nil, common.Address{}, 0, ErrMaxInitCodeSizeExceeded
+	}
 	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
@@ -482,7 +488,7 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	ret, err := evm.interpreter.Run(contract, nil, false)
 
 	// Check whether the max code size has been exceeded, assign err if the case.
-	if err == nil && evm.chainRules.IsEIP158 && len(ret) > params.MaxCodeSize {
+	if err == nil && evm.chainRules.IsEIP158 && uint64(len(ret)) > evm.maxCodeSize() {
 		err = ErrMaxCodeSizeExceeded
 	}
 
@@ -546,5 +552,21 @@ func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *
 	return evm.create(caller, codeAndHash, gas, endowment, contractAddr, CREATE2)
 }
 
+// maxCodeSize returns the maximum size of the deployed contract code.
+func (evm *EVM) maxCodeSize() uint64 {
+	if evm.Config.MaxCodeSize != 0 {
+		return evm.Config.MaxCodeSize
+	}
+	return params.MaxCodeSize
+}
+
+// maxInitCodeSize returns the maximum size of the contract creation code.
+func (evm *EVM) maxInitCodeSize() uint64 {
+	if evm.Config.MaxInitCodeSize != 0 {
+		return evm.Config.MaxInitCodeSize
+	}
+	return 2 * params.MaxCodeSize
+}
+
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
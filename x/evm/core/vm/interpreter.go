@@ -34,6 +34,11 @@ type Config struct {
 	JumpTable *JumpTable // EVM instruction table, automatically populated if unset
 
 	ExtraEips []string // Additional EIPS that are to be enabled
+
+	GasSchedule []OpCodeGas // Constant gas overrides applied to the jump table
+
+	MaxCodeSize     uint64 // Maximum size of the deployed contract code, defaults to EIP-170 if unset
+	MaxInitCodeSize uint64 // Maximum size of the contract creation code, defaults to EIP-3860 if unset
 }
 
 // ScopeContext contains the things that are per-call, such as stack and memory,
@@ -86,6 +91,13 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 			}
 			cfg.JumpTable = copy
 		}
+
+		if len(cfg.GasSchedule) > 0 {
+			// Deep-copy jumptable to prevent modification of opcodes in other tables
+			copy := CopyJumpTable(cfg.JumpTable)
+			ApplyGasSchedule(copy, cfg.GasSchedule)
+			cfg.JumpTable = copy
+		}
 	}
 
 	return &EVMInterpreter{
@@ -74,3 +74,39 @@ func TestLoopInterrupt(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateCodeSizeLimits(t *testing.T) {
+	vmctx := BlockContext{
+		BlockNumber: big.NewInt(0),
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+	}
+
+	// initcode returning 33 zero bytes: push1 0x21 push1 0x00 return
+	initCode := common.Hex2Bytes("60216000f3")
+
+	testCases := []struct {
+		name   string
+		config Config
+		expErr error
+	}{
+		{"default limits", Config{}, nil},
+		{"initcode size exceeded", Config{MaxInitCodeSize: uint64(len(initCode) - 1)}, ErrMaxInitCodeSizeExceeded},
+		{"code size exceeded", Config{MaxCodeSize: 32}, ErrMaxCodeSizeExceeded},
+		{"within limits", Config{MaxCodeSize: 33, MaxInitCodeSize: uint64(len(initCode))}, nil},
+	}
+
+	for _, tc := range testCases {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, tc.config)
+
+		_, _, leftoverGas, err := evm.Create(AccountRef(common.Address{}), initCode, 1_000_000, new(big.Int))
+		if err != tc.expErr {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.expErr, err)
+		}
+		// exceeding the initcode size consumes all the gas
+		if err == ErrMaxInitCodeSizeExceeded && leftoverGas != 0 {
+			t.Errorf("%s: expected no leftover gas, got %d", tc.name, leftoverGas)
+		}
+	}
+}
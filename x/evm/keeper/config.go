@@ -42,8 +42,9 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 	)
 }
 
-// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// VMConfig creates an EVM configuration from the debug setting, the extra EIPs enabled, the
+// opcode gas schedule and the contract code size limits defined on the module parameters.
+// The config generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
@@ -56,9 +57,12 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 	}
 
 	return vm.Config{
-		Debug:     debug,
-		Tracer:    tracer,
-		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		Debug:           debug,
+		Tracer:          tracer,
+		NoBaseFee:       noBaseFee,
+		ExtraEips:       cfg.Params.EIPs(),
+		GasSchedule:     cfg.Params.VMGasSchedule(),
+		MaxCodeSize:     cfg.Params.CodeSizeLimit(),
+		MaxInitCodeSize: cfg.Params.InitCodeSizeLimit(),
 	}
 }
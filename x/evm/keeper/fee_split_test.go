@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyTransactionFeeSplit(t *testing.T) {
+	// burn all the base fees and keep the tips in the fee collector
+	feeSplit := feemarkettypes.NewFeeSplit(
+		sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), "",
+	)
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.FeeSplit = &feeSplit
+
+	keys := keyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{
+			feemarkettypes.ModuleName: feemarketGenesis,
+		}),
+	)
+	tf := factory.New(nw, grpc.NewIntegrationHandler(nw))
+
+	recipient := keys.GetAddr(1)
+	res, err := tf.ExecuteEthTx(keys.GetPrivKey(0), evmtypes.EvmTxArgs{
+		To:     &recipient,
+		Amount: big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	var burned sdk.Coins
+	for _, event := range res.Events {
+		if event.Type != feemarkettypes.EventTypeFeeSplit {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == feemarkettypes.AttributeKeyBurned {
+				burned, err = sdk.ParseCoinsNormalized(attr.Value)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	// the base fee part of the fees paid for the gas used is burned
+	require.True(t, burned.IsAllPositive())
+	require.Equal(t, burned, nw.App.FeeMarketKeeper.GetBurnedFees(nw.GetContext()))
+}
@@ -5,6 +5,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -27,10 +28,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
-func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+// consumed in the transaction. If the fees of the transaction with the given hash were paid by a
+// paymaster, the leftover gas is refunded to it instead and released from its sponsorship.
+// Additionally, the function sets the total gas consumed to the value returned by the EVM
+// execution, thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
+func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, txHash common.Hash, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -42,12 +44,22 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		refundee := msg.From()
+		paymaster, sponsored := k.GetTxFeePayerTransient(ctx, txHash)
+		if sponsored {
+			refundee = paymaster
+		}
+
+		// refund to fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		if sponsored {
+			k.paymasterKeeper.RefundSponsorship(ctx, msg.From(), paymaster, sdkmath.NewIntFromBigInt(remaining))
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -54,6 +54,8 @@ type Keeper struct {
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// erc20Keeper interface needed to instantiate erc20 precompiles
 	erc20Keeper types.Erc20Keeper
+	// paymasterKeeper interface needed to refund the leftover gas of sponsored transactions
+	paymasterKeeper types.PaymasterKeeper
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
@@ -77,6 +79,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
 	erc20Keeper types.Erc20Keeper,
+	paymasterKeeper types.PaymasterKeeper,
 	tracer string,
 	ss paramstypes.Subspace,
 ) *Keeper {
@@ -105,6 +108,7 @@ func NewKeeper(
 		transientKey:     transientKey,
 		tracer:           tracer,
 		erc20Keeper:      erc20Keeper,
+		paymasterKeeper:  paymasterKeeper,
 		ss:               ss,
 	}
 }
@@ -209,6 +213,13 @@ func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) types
 
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
+	// NOTE: only the transactions of finalized blocks are profiled, so that
+	// queries and simulations (e.g. eth_call or eth_estimateGas) don't skew the
+	// profile.
+	if k.tracer == types.TracerProfile && ctx.ExecMode() != sdk.ExecModeFinalize {
+		return types.NewNoOpTracer()
+	}
+
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
 }
 
@@ -320,3 +331,22 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// SetTxFeePayerTransient sets the paymaster that paid the fees of the Ethereum
+// transaction with the given hash, called in ante handler. The leftover gas of
+// the transaction is refunded to this account.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientFeePayerKey(txHash), feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the paymaster that paid the fees of the
+// Ethereum transaction with the given hash, if any.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TransientFeePayerKey(txHash))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
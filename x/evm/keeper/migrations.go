@@ -5,6 +5,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v8 "github.com/evmos/evmos/v20/x/evm/migrations/v8"
+	v9 "github.com/evmos/evmos/v20/x/evm/migrations/v9"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -26,3 +27,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate7to8(ctx sdk.Context) error {
 	return v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate8to9 migrates the store from consensus version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
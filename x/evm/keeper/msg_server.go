@@ -141,6 +141,11 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	currentParams := k.GetParams(ctx)
+	if err := k.ValidatePrecompileRegistry(ctx, currentParams, req.Params.PrecompileRegistry); err != nil {
+		return nil, err
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -105,6 +105,95 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "pass - schedule precompile activation at a future height",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.PrecompileRegistry = []types.PrecompileRegistration{
+					{
+						Address:          types.SlashingPrecompileAddress,
+						ActivationHeight: suite.network.GetContext().BlockHeight() + 10,
+						BaseGas:          3_000,
+					},
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail - schedule precompile activation at a past height",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.PrecompileRegistry = []types.PrecompileRegistration{
+					{
+						Address:          types.EvidencePrecompileAddress,
+						ActivationHeight: suite.network.GetContext().BlockHeight(),
+					},
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: types.ErrInvalidPrecompileRegistration,
+		},
+		{
+			name: "pass - update the gas schedule of an active precompile",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.PrecompileRegistry = []types.PrecompileRegistration{
+					{
+						Address:          types.StakingPrecompileAddress,
+						ActivationHeight: suite.network.GetContext().BlockHeight(),
+						BaseGas:          3_000,
+						PerByteGas:       10,
+					},
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail - reschedule an active precompile",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.PrecompileRegistry = []types.PrecompileRegistration{
+					{
+						Address:          types.StakingPrecompileAddress,
+						ActivationHeight: suite.network.GetContext().BlockHeight() + 10,
+						BaseGas:          3_000,
+					},
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: types.ErrInvalidPrecompileRegistration,
+		},
+		{
+			name: "fail - schedule precompile that is not available in the binary",
+			getMsg: func() *types.MsgUpdateParams {
+				params := types.DefaultParams()
+				params.PrecompileRegistry = []types.PrecompileRegistration{
+					{
+						Address:          "0x0000000000000000000000000000000000000999",
+						ActivationHeight: suite.network.GetContext().BlockHeight() + 10,
+					},
+				}
+				return &types.MsgUpdateParams{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:    params,
+				}
+			},
+			expectedErr: types.ErrInvalidPrecompileRegistration,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,12 +4,14 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"slices"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/utils"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -34,6 +36,10 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	if err := validateGasSchedule(ctx, params); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -44,6 +50,30 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
+// validateGasSchedule checks the gas schedule of the params against the jump
+// table of the fork active at the current block height, with the extra EIPs
+// of the params enabled.
+func validateGasSchedule(ctx sdk.Context, params types.Params) error {
+	if len(params.GasSchedule) == 0 {
+		return nil
+	}
+
+	ethCfg := types.GetEthChainConfig()
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), ethCfg.MergeNetsplitBlock != nil)
+
+	jumpTable := vm.CopyJumpTable(vm.DefaultJumpTable(rules))
+	for _, eip := range params.EIPs() {
+		if err := vm.EnableEIP(eip, jumpTable); err != nil {
+			return err
+		}
+	}
+
+	if err := vm.ValidateGasSchedule(jumpTable, params.VMGasSchedule()); err != nil {
+		return fmt.Errorf("invalid gas schedule: %w", err)
+	}
+	return nil
+}
+
 // GetLegacyParams returns param set for version before migrate
 func (k Keeper) GetLegacyParams(ctx sdk.Context) types.Params {
 	var params types.Params
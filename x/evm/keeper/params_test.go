@@ -107,3 +107,63 @@ func (suite *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetParamsGasSchedule() {
+	testCases := []struct {
+		name        string
+		malleate    func(params *types.Params)
+		errContains string
+	}{
+		{
+			"pass - constant gas operations",
+			func(params *types.Params) {
+				params.GasSchedule = []types.OpCodeGas{
+					{OpCode: "ADD", ConstantGas: 5},
+					{OpCode: "CALL", ConstantGas: 200},
+				}
+			},
+			"",
+		},
+		{
+			"pass - operation enabled by an extra EIP",
+			func(params *types.Params) {
+				params.ExtraEIPs = []string{"ethereum_3855"}
+				params.GasSchedule = []types.OpCodeGas{{OpCode: "PUSH0", ConstantGas: 3}}
+			},
+			"",
+		},
+		{
+			"fail - operation not defined on the jump table",
+			func(params *types.Params) {
+				params.ExtraEIPs = nil
+				params.GasSchedule = []types.OpCodeGas{{OpCode: "PUSH0", ConstantGas: 3}}
+			},
+			"opcode PUSH0 is not defined on the jump table",
+		},
+		{
+			"fail - operation with fully dynamic gas",
+			func(params *types.Params) {
+				params.GasSchedule = []types.OpCodeGas{{OpCode: "SSTORE", ConstantGas: 5_000}}
+			},
+			"opcode SSTORE gas is fully dynamic",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			params := suite.network.App.EvmKeeper.GetParams(ctx)
+			tc.malleate(&params)
+
+			err := suite.network.App.EvmKeeper.SetParams(ctx, params)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(params.GasSchedule, suite.network.App.EvmKeeper.GetParams(ctx).GasSchedule)
+		})
+	}
+}
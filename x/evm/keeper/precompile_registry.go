@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = meteredPrecompile{}
+
+// meteredPrecompile wraps a static precompile to charge the gas defined on its
+// registration instead of the gas defined by the precompile implementation.
+type meteredPrecompile struct {
+	vm.PrecompiledContract
+	registration types.PrecompileRegistration
+}
+
+// newMeteredPrecompile returns a precompile that charges the gas schedule of the
+// given registration.
+func newMeteredPrecompile(
+	precompile vm.PrecompiledContract,
+	registration types.PrecompileRegistration,
+) vm.PrecompiledContract {
+	return meteredPrecompile{
+		PrecompiledContract: precompile,
+		registration:        registration,
+	}
+}
+
+// RequiredGas calculates the gas required by the registered gas schedule.
+func (p meteredPrecompile) RequiredGas(input []byte) uint64 {
+	return p.registration.RequiredGas(input)
+}
+
+// ValidatePrecompileRegistry checks that every precompile on the updated registry
+// is compiled into the binary and that new or modified activations are scheduled
+// for a future block height. Precompiles that are already active, either on the
+// active static precompiles or on the registry, cannot be rescheduled, but their
+// gas schedule can be updated with an activation height at or below the current
+// height.
+func (k Keeper) ValidatePrecompileRegistry(
+	ctx sdk.Context,
+	current types.Params,
+	updated []types.PrecompileRegistration,
+) error {
+	height := ctx.BlockHeight()
+	currentRegistry := types.PrecompileRegistry(current.PrecompileRegistry)
+
+	for _, registration := range updated {
+		address := common.HexToAddress(registration.Address)
+		if _, found := k.precompiles[address]; !found {
+			return errorsmod.Wrapf(
+				types.ErrInvalidPrecompileRegistration,
+				"precompile %s is not available in the binary", registration.Address,
+			)
+		}
+
+		existing, found := currentRegistry.Get(address)
+		if found && existing.ActivationHeight == registration.ActivationHeight {
+			continue
+		}
+
+		if current.IsActiveStaticPrecompile(address, height) {
+			if registration.ActivationHeight > height {
+				return errorsmod.Wrapf(
+					types.ErrInvalidPrecompileRegistration,
+					"precompile %s is already active and cannot be rescheduled to height %d",
+					registration.Address, registration.ActivationHeight,
+				)
+			}
+			// gas schedule update of an active precompile
+			continue
+		}
+
+		if registration.ActivationHeight <= height {
+			return errorsmod.Wrapf(
+				types.ErrInvalidPrecompileRegistration,
+				"activation height %d for precompile %s must be greater than the current height %d",
+				registration.ActivationHeight, registration.Address, height,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestGetStaticPrecompileInstanceRegistry() {
+	suite.SetupTest()
+
+	ctx := suite.network.GetContext()
+	address := common.HexToAddress(types.SlashingPrecompileAddress)
+	activationHeight := ctx.BlockHeight() + 1
+
+	params := suite.network.App.EvmKeeper.GetParams(ctx)
+	params.PrecompileRegistry = []types.PrecompileRegistration{
+		{
+			Address:          types.SlashingPrecompileAddress,
+			ActivationHeight: activationHeight,
+			BaseGas:          1_000,
+			PerByteGas:       10,
+		},
+	}
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+	suite.Require().True(suite.network.App.EvmKeeper.IsAvailableStaticPrecompile(&params, address))
+
+	// the precompile is dormant before the activation height
+	_, found, err := suite.network.App.EvmKeeper.GetStaticPrecompileInstance(ctx, &params, address)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// the precompile is active and charges the registered gas schedule
+	ctx = ctx.WithBlockHeight(activationHeight)
+	precompile, found, err := suite.network.App.EvmKeeper.GetStaticPrecompileInstance(ctx, &params, address)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(address, precompile.Address())
+	suite.Require().Equal(uint64(1_000+10*4), precompile.RequiredGas([]byte{1, 2, 3, 4}))
+}
+
+func (suite *KeeperTestSuite) TestGetStaticPrecompileInstanceRegistryGasUpdate() {
+	suite.SetupTest()
+
+	ctx := suite.network.GetContext()
+	address := common.HexToAddress(types.StakingPrecompileAddress)
+
+	// the staking precompile is active on the active static precompiles and
+	// only its gas schedule is updated on the registry
+	params := suite.network.App.EvmKeeper.GetParams(ctx)
+	suite.Require().True(params.IsActiveStaticPrecompile(address, ctx.BlockHeight()))
+	params.PrecompileRegistry = []types.PrecompileRegistration{
+		{
+			Address:          types.StakingPrecompileAddress,
+			ActivationHeight: ctx.BlockHeight(),
+			BaseGas:          2_000,
+			PerByteGas:       5,
+		},
+	}
+	suite.Require().NoError(suite.network.App.EvmKeeper.ValidatePrecompileRegistry(
+		ctx, suite.network.App.EvmKeeper.GetParams(ctx), params.PrecompileRegistry,
+	))
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+
+	precompile, found, err := suite.network.App.EvmKeeper.GetStaticPrecompileInstance(ctx, &params, address)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2_000+5*4), precompile.RequiredGas([]byte{1, 2, 3, 4}))
+}
@@ -23,7 +23,7 @@ func (k *Keeper) GetPrecompileInstance(
 ) (*Precompiles, bool, error) {
 	params := k.GetParams(ctx)
 	// Get the precompile from the static precompiles
-	if precompile, found, err := k.GetStaticPrecompileInstance(&params, address); err != nil {
+	if precompile, found, err := k.GetStaticPrecompileInstance(ctx, &params, address); err != nil {
 		return nil, false, err
 	} else if found {
 		addressMap := make(map[common.Address]vm.PrecompiledContract)
@@ -190,10 +190,21 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	evmDenom := types.GetEVMCoinDenom()
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, evmDenom); err != nil {
+	if err = k.RefundGas(ctx, msg, txConfig.TxHash, msg.Gas()-res.GasUsed, evmDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// split the fees paid for the gas used between burning and tips
+	gasUsed := new(big.Int).SetUint64(res.GasUsed)
+	fees := new(big.Int).Mul(gasUsed, msg.GasPrice())
+	baseFees := new(big.Int)
+	if cfg.BaseFee != nil {
+		baseFees.Mul(gasUsed, cfg.BaseFee)
+	}
+	if err = k.feeMarketWrapper.DistributeFees(ctx, fees, baseFees); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to distribute fees")
+	}
+
 	if len(logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, bloom)
@@ -292,6 +303,15 @@ func (k *Keeper) ApplyMessageWithConfig(
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
+	// EIP-3860: the contract creation transactions exceeding the initcode size
+	// limit are invalid
+	if contractCreation && uint64(len(msg.Data())) > cfg.Params.InitCodeSizeLimit() {
+		return nil, errorsmod.Wrapf(
+			vm.ErrMaxInitCodeSizeExceeded,
+			"initcode size %d, limit %d", len(msg.Data()), cfg.Params.InitCodeSizeLimit(),
+		)
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
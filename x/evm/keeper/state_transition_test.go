@@ -461,6 +461,7 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 			err = unitNetwork.App.EvmKeeper.RefundGas(
 				unitNetwork.GetContext(),
 				coreMsg,
+				common.Hash{},
 				refund,
 				unitNetwork.GetBaseDenom(),
 			)
@@ -474,6 +475,98 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRefundGasSponsored() {
+	baseDenom := types.GetEVMCoinDenom()
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	paymaster := keyring.GetAddr(1)
+	recipient := utiltx.GenerateAddress()
+
+	gasPrice := big.NewInt(1e9)
+	leftoverGas := uint64(10_000)
+	refund := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(leftoverGas)))
+	fee := sdkmath.NewInt(1e15)
+
+	testCases := []struct {
+		name         string
+		sponsoredTx  bool
+		expRefundee  common.Address
+		expSpentLeft sdkmath.Int
+	}{
+		{
+			name:         "sponsored tx - refund to the paymaster and release the sponsorship",
+			sponsoredTx:  true,
+			expRefundee:  paymaster,
+			expSpentLeft: fee.Sub(refund),
+		},
+		{
+			name:         "other tx of the same block - refund to the sender",
+			sponsoredTx:  false,
+			expRefundee:  sender.Addr,
+			expSpentLeft: fee,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			ctx := unitNetwork.GetContext()
+			app := unitNetwork.App
+
+			_, err := app.PaymasterKeeper.Sponsor(ctx, paymaster, sender.Addr, sdkmath.NewInt(1e18))
+			suite.Require().NoError(err)
+			sponsorship, found := app.PaymasterKeeper.GetSponsorship(ctx, sender.Addr, paymaster)
+			suite.Require().True(found)
+			suite.Require().NoError(app.PaymasterKeeper.ConsumeSponsorship(ctx, sponsorship, fee))
+
+			sponsoredTxHash := common.BytesToHash([]byte("sponsored"))
+			app.EvmKeeper.SetTxFeePayerTransient(ctx, sponsoredTxHash, paymaster)
+
+			txHash := common.BytesToHash([]byte("other"))
+			if tc.sponsoredTx {
+				txHash = sponsoredTxHash
+			}
+
+			coreMsg, err := txFactory.GenerateGethCoreMsg(
+				sender.Priv,
+				types.EvmTxArgs{To: &recipient, GasPrice: gasPrice},
+			)
+			suite.Require().NoError(err)
+
+			balanceBefore := app.BankKeeper.GetBalance(ctx, tc.expRefundee.Bytes(), baseDenom)
+
+			err = app.EvmKeeper.RefundGas(ctx, coreMsg, txHash, leftoverGas, baseDenom)
+			suite.Require().NoError(err)
+
+			balanceAfter := app.BankKeeper.GetBalance(ctx, tc.expRefundee.Bytes(), baseDenom)
+			suite.Require().Equal(refund, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+			sponsorship, found = app.PaymasterKeeper.GetSponsorship(ctx, sender.Addr, paymaster)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expSpentLeft, sponsorship.Spent)
+
+			app.PaymasterKeeper.DeleteSponsorship(ctx, sender.Addr, paymaster)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	suite.SetupTest()
 	testCases := []struct {
@@ -680,6 +773,28 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			true,
 			0,
 		},
+		{
+			"fail - contract creation exceeding the initcode size limit",
+			func() core.Message {
+				sender := suite.keyring.GetKey(0)
+				msg, err := suite.factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+					GasLimit: 100_000,
+					Input:    make([]byte, 33),
+				})
+				suite.Require().NoError(err)
+				return msg
+			},
+			func() types.Params {
+				defaultParams := types.DefaultParams()
+				defaultParams.MaxCodeSize = 16
+				defaultParams.MaxInitCodeSize = 32
+				return defaultParams
+			},
+			feemarkettypes.DefaultParams,
+			true,
+			false,
+			0,
+		},
 		{
 			"fail - fix panic when minimumGasUsed is not uint64",
 			func() core.Message {
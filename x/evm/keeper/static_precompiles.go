@@ -9,28 +9,41 @@ import (
 	"slices"
 
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/evmos/v20/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v20/precompiles/bank"
 	"github.com/evmos/evmos/v20/precompiles/bech32"
 	distprecompile "github.com/evmos/evmos/v20/precompiles/distribution"
+	erc20moduleprecompile "github.com/evmos/evmos/v20/precompiles/erc20module"
 	evidenceprecompile "github.com/evmos/evmos/v20/precompiles/evidence"
+	feegrantprecompile "github.com/evmos/evmos/v20/precompiles/feegrant"
 	govprecompile "github.com/evmos/evmos/v20/precompiles/gov"
+	icaprecompile "github.com/evmos/evmos/v20/precompiles/ica"
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
+	inflationprecompile "github.com/evmos/evmos/v20/precompiles/inflation"
 	"github.com/evmos/evmos/v20/precompiles/p256"
+	paymasterprecompile "github.com/evmos/evmos/v20/precompiles/paymaster"
 	slashingprecompile "github.com/evmos/evmos/v20/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
+	epochskeeper "github.com/evmos/evmos/v20/x/epochs/keeper"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+	inflationkeeper "github.com/evmos/evmos/v20/x/inflation/v1/keeper"
+	paymasterkeeper "github.com/evmos/evmos/v20/x/paymaster/keeper"
 	stakingkeeper "github.com/evmos/evmos/v20/x/staking/keeper"
 	vestingkeeper "github.com/evmos/evmos/v20/x/vesting/keeper"
 )
@@ -51,6 +64,13 @@ func NewAvailableStaticPrecompiles(
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	paymasterKeeper paymasterkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
+	cdc codec.Codec,
+	authzLimiter authzprecompile.MsgLimiter,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -97,7 +117,7 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
-	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper)
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper, cdc)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
@@ -112,6 +132,36 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	paymasterPrecompile, err := paymasterprecompile.NewPrecompile(paymasterKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate paymaster precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, cdc, authzLimiter)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICA precompile: %w", err))
+	}
+
+	erc20ModulePrecompile, err := erc20moduleprecompile.NewPrecompile(erc20Keeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate erc20 module precompile: %w", err))
+	}
+
+	inflationPrecompile, err := inflationprecompile.NewPrecompile(epochsKeeper, inflationKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate inflation precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -125,6 +175,12 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[paymasterPrecompile.Address()] = paymasterPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[erc20ModulePrecompile.Address()] = erc20ModulePrecompile
+	precompiles[inflationPrecompile.Address()] = inflationPrecompile
 
 	return precompiles
 }
@@ -144,23 +200,47 @@ func (k *Keeper) WithStaticPrecompiles(precompiles map[common.Address]vm.Precomp
 }
 
 // GetStaticPrecompileInstance returns the instance of the given static precompile address.
-func (k *Keeper) GetStaticPrecompileInstance(params *types.Params, address common.Address) (vm.PrecompiledContract, bool, error) {
-	if k.IsAvailableStaticPrecompile(params, address) {
-		precompile, found := k.precompiles[address]
-		// If the precompile is within params but not found in the precompiles map it means we have memory
-		// corruption.
-		if !found {
-			panic(fmt.Errorf("precompiled contract not stored in memory: %s", address))
-		}
-		return precompile, true, nil
-	}
-	return nil, false, nil
+// If the precompile is registered with a custom gas schedule on the precompile registry,
+// the returned instance charges the gas defined on the registration.
+func (k *Keeper) GetStaticPrecompileInstance(
+	ctx sdk.Context,
+	params *types.Params,
+	address common.Address,
+) (vm.PrecompiledContract, bool, error) {
+	if !k.IsAvailableStaticPrecompile(params, address) {
+		return nil, false, nil
+	}
+
+	if !slices.Contains(vm.PrecompiledAddressesBerlin, address) &&
+		!params.IsActiveStaticPrecompile(address, ctx.BlockHeight()) {
+		// the precompile is registered but not yet active
+		return nil, false, nil
+	}
+
+	precompile, found := k.precompiles[address]
+	// If the precompile is within params but not found in the precompiles map it means we have memory
+	// corruption.
+	if !found {
+		panic(fmt.Errorf("precompiled contract not stored in memory: %s", address))
+	}
+
+	registration, found := types.PrecompileRegistry(params.PrecompileRegistry).Get(address)
+	if found && registration.HasGasSchedule() {
+		return newMeteredPrecompile(precompile, registration), true, nil
+	}
+
+	return precompile, true, nil
 }
 
 // IsAvailablePrecompile returns true if the given static precompile address is contained in the
-// EVM keeper's available precompiles map.
+// EVM keeper's available precompiles map or scheduled for activation on the precompile registry.
 // This function assumes that the Berlin precompiles cannot be disabled.
 func (k Keeper) IsAvailableStaticPrecompile(params *types.Params, address common.Address) bool {
-	return slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
-		slices.Contains(vm.PrecompiledAddressesBerlin, address)
+	if slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
+		slices.Contains(vm.PrecompiledAddressesBerlin, address) {
+		return true
+	}
+
+	_, found := types.PrecompileRegistry(params.PrecompileRegistry).Get(address)
+	return found
 }
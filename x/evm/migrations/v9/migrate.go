@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v9
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 8 to
+// version 9. It sets the contract code size limits to the EIP-170 limit for the
+// deployed code and the EIP-3860 limit for the contract creation code.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+	params.MaxInitCodeSize = types.DefaultMaxInitCodeSize
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v9_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/encoding"
+	v9 "github.com/evmos/evmos/v20/x/evm/migrations/v9"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// Create a pre migration environment without code size limits.
+	paramsV8 := types.DefaultParams()
+	paramsV8.MaxCodeSize = 0
+	paramsV8.MaxInitCodeSize = 0
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&paramsV8))
+
+	err := v9.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	paramsBz := kvStore.Get(types.KeyPrefixParams)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	require.Equal(t, types.DefaultMaxCodeSize, params.MaxCodeSize)
+	require.Equal(t, types.DefaultMaxInitCodeSize, params.MaxInitCodeSize)
+	require.Equal(t, paramsV8.ExtraEIPs, params.ExtraEIPs)
+	require.Equal(t, paramsV8.ActiveStaticPrecompiles, params.ActiveStaticPrecompiles)
+	require.Equal(t, paramsV8.AccessControl, params.AccessControl)
+}
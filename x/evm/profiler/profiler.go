@@ -0,0 +1,277 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package profiler
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/hashicorp/go-metrics"
+)
+
+// DefaultMaxEntries is the default number of contracts and function selectors
+// retained by a profiler.
+const DefaultMaxEntries = 1000
+
+// DefaultProfiler is the profiler that aggregates the executions traced by the
+// EVM when the node runs with the profile tracer.
+var DefaultProfiler = NewProfiler(DefaultMaxEntries)
+
+// stats holds the number of executions and the gas consumed by a contract,
+// a function selector or an opcode.
+type stats struct {
+	count uint64
+	gas   uint64
+}
+
+// add increases the execution count by one and adds the gas consumed.
+func (s *stats) add(gas uint64) {
+	s.count++
+	s.gas += gas
+}
+
+// txProfile holds the gas consumed during the execution of a single
+// transaction.
+type txProfile struct {
+	contracts map[common.Address]*stats
+	selectors map[[4]byte]*stats
+	opcodes   map[vm.OpCode]*stats
+}
+
+func newTxProfile() *txProfile {
+	return &txProfile{
+		contracts: make(map[common.Address]*stats),
+		selectors: make(map[[4]byte]*stats),
+		opcodes:   make(map[vm.OpCode]*stats),
+	}
+}
+
+// Profiler aggregates the gas consumed per contract address, per 4-byte
+// function selector and per opcode over all the profiled transactions. As
+// contract addresses and selectors are chosen by the transaction senders, only
+// the maxEntries contracts and selectors that consumed the most gas are
+// retained. It is safe for concurrent use.
+type Profiler struct {
+	mu           sync.RWMutex
+	maxEntries   int
+	transactions uint64
+	contracts    map[common.Address]*stats
+	selectors    map[[4]byte]*stats
+	opcodes      map[vm.OpCode]*stats
+}
+
+// NewProfiler returns an empty profiler that retains at most maxEntries
+// contracts and selectors.
+func NewProfiler(maxEntries int) *Profiler {
+	p := &Profiler{maxEntries: maxEntries}
+	p.Reset()
+	return p
+}
+
+// Reset discards all the aggregated executions.
+func (p *Profiler) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.reset()
+}
+
+func (p *Profiler) reset() {
+	p.transactions = 0
+	p.contracts = make(map[common.Address]*stats)
+	p.selectors = make(map[[4]byte]*stats)
+	p.opcodes = make(map[vm.OpCode]*stats)
+}
+
+// record adds the gas consumed by a transaction to the aggregates and reports
+// the gas consumed per opcode to the telemetry sink. Contracts and selectors
+// are not reported to telemetry to keep the number of labels bounded.
+func (p *Profiler) record(tx *txProfile) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.transactions++
+	telemetry.IncrCounter(1, "evm", "profile", "transactions")
+
+	for address, s := range tx.contracts {
+		merge(p.contracts, address, s)
+	}
+	prune(p.contracts, p.maxEntries)
+
+	for selector, s := range tx.selectors {
+		merge(p.selectors, selector, s)
+	}
+	prune(p.selectors, p.maxEntries)
+
+	for op, s := range tx.opcodes {
+		merge(p.opcodes, op, s)
+		emitGasMetrics("opcode", op.String(), s)
+	}
+}
+
+// Profile returns the aggregated executions sorted by gas consumed in
+// descending order. If limit is positive, at most limit entries are returned
+// for each of the contracts, selectors and opcodes.
+func (p *Profiler) Profile(limit int) Profile {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.profile(limit)
+}
+
+// ProfileAndReset returns the aggregated executions like Profile and discards
+// them, so that the next profile only covers the transactions executed
+// afterwards.
+func (p *Profiler) ProfileAndReset(limit int) Profile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	profile := p.profile(limit)
+	p.reset()
+	return profile
+}
+
+func (p *Profiler) profile(limit int) Profile {
+	profile := Profile{
+		Transactions: p.transactions,
+		Contracts:    make([]ContractProfile, 0, len(p.contracts)),
+		Selectors:    make([]SelectorProfile, 0, len(p.selectors)),
+		Opcodes:      make([]OpCodeProfile, 0, len(p.opcodes)),
+	}
+
+	for address, s := range p.contracts {
+		profile.Contracts = append(profile.Contracts, ContractProfile{Address: address, Calls: s.count, Gas: s.gas})
+	}
+	for selector, s := range p.selectors {
+		profile.Selectors = append(profile.Selectors, SelectorProfile{Selector: hexutil.Encode(selector[:]), Calls: s.count, Gas: s.gas})
+	}
+	for op, s := range p.opcodes {
+		profile.Opcodes = append(profile.Opcodes, OpCodeProfile{OpCode: op.String(), Count: s.count, Gas: s.gas})
+	}
+
+	sort.Slice(profile.Contracts, func(i, j int) bool {
+		a, b := profile.Contracts[i], profile.Contracts[j]
+		return a.Gas > b.Gas || (a.Gas == b.Gas && bytes.Compare(a.Address.Bytes(), b.Address.Bytes()) < 0)
+	})
+	sort.Slice(profile.Selectors, func(i, j int) bool {
+		a, b := profile.Selectors[i], profile.Selectors[j]
+		return a.Gas > b.Gas || (a.Gas == b.Gas && a.Selector < b.Selector)
+	})
+	sort.Slice(profile.Opcodes, func(i, j int) bool {
+		a, b := profile.Opcodes[i], profile.Opcodes[j]
+		return a.Gas > b.Gas || (a.Gas == b.Gas && a.OpCode < b.OpCode)
+	})
+
+	if limit > 0 {
+		profile.Contracts = truncate(profile.Contracts, limit)
+		profile.Selectors = truncate(profile.Selectors, limit)
+		profile.Opcodes = truncate(profile.Opcodes, limit)
+	}
+
+	return profile
+}
+
+// Profile is the gas consumption report of the profiled transactions.
+type Profile struct {
+	Transactions uint64            `json:"transactions"`
+	Contracts    []ContractProfile `json:"contracts"`
+	Selectors    []SelectorProfile `json:"selectors"`
+	Opcodes      []OpCodeProfile   `json:"opcodes"`
+}
+
+// ContractProfile is the gas consumed by the code of a contract, excluding the
+// gas consumed by the calls it performs to other contracts.
+type ContractProfile struct {
+	Address common.Address `json:"address"`
+	Calls   uint64         `json:"calls"`
+	Gas     uint64         `json:"gas"`
+}
+
+// SelectorProfile is the gas consumed by the calls to a 4-byte function
+// selector, excluding the gas consumed by the nested calls.
+type SelectorProfile struct {
+	Selector string `json:"selector"`
+	Calls    uint64 `json:"calls"`
+	Gas      uint64 `json:"gas"`
+}
+
+// OpCodeProfile is the gas consumed by the executions of an opcode. The gas
+// forwarded to a call or contract creation is not accounted to the opcode.
+type OpCodeProfile struct {
+	OpCode string `json:"opcode"`
+	Count  uint64 `json:"count"`
+	Gas    uint64 `json:"gas"`
+}
+
+func merge[K comparable](aggregates map[K]*stats, key K, s *stats) {
+	aggregate, ok := aggregates[key]
+	if !ok {
+		aggregate = &stats{}
+		aggregates[key] = aggregate
+	}
+	aggregate.count += s.count
+	aggregate.gas += s.gas
+}
+
+// prune keeps the maxEntries aggregates that consumed the most gas once the
+// aggregates exceed twice that number, so that pruning is amortized over
+// multiple transactions.
+func prune[K comparable](aggregates map[K]*stats, maxEntries int) {
+	if maxEntries <= 0 || len(aggregates) <= 2*maxEntries {
+		return
+	}
+
+	gas := make([]uint64, 0, len(aggregates))
+	for _, s := range aggregates {
+		gas = append(gas, s.gas)
+	}
+	sort.Slice(gas, func(i, j int) bool { return gas[i] > gas[j] })
+
+	// evict the entries below the gas of the last retained entry, and the
+	// ones with the same gas past the limit
+	threshold := gas[maxEntries-1]
+	retained := 0
+	for _, s := range aggregates {
+		if s.gas > threshold {
+			retained++
+		}
+	}
+	for key, s := range aggregates {
+		switch {
+		case s.gas < threshold:
+			delete(aggregates, key)
+		case s.gas == threshold && retained >= maxEntries:
+			delete(aggregates, key)
+		case s.gas == threshold:
+			retained++
+		}
+	}
+}
+
+func truncate[T any](entries []T, limit int) []T {
+	if len(entries) > limit {
+		return entries[:limit]
+	}
+	return entries
+}
+
+func emitGasMetrics(kind, value string, s *stats) {
+	labels := []metrics.Label{telemetry.NewLabel(kind, value)}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"evm", "profile", kind, "count"},
+		float32(s.count),
+		labels,
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{"evm", "profile", kind, "gas"},
+		float32(s.gas),
+		labels,
+	)
+}
@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package profiler
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+var _ vm.EVMLogger = &Tracer{}
+
+// frame is a call frame of the traced execution.
+type frame struct {
+	address     common.Address
+	selector    [4]byte
+	hasSelector bool
+	// childGasUsed is the gas used by the calls performed by the frame.
+	childGasUsed uint64
+
+	// pendingOp is the call or create opcode executed by the frame, whose cost
+	// is known once the execution of the frame continues.
+	pendingOp    vm.OpCode
+	pendingGas   uint64
+	pendingCost  uint64
+	pendingChild uint64
+	hasPending   bool
+}
+
+// Tracer is a vm.EVMLogger that profiles the gas consumed per contract, per
+// function selector and per opcode. The gas consumed by a frame is accounted
+// to its contract and selector excluding the gas used by its nested calls, so
+// that the totals reflect the cost of the contract code itself. The profile of
+// each transaction is recorded on the profiler at the end of the transaction.
+type Tracer struct {
+	profiler *Profiler
+	tx       *txProfile
+	frames   []*frame
+}
+
+// NewTracer returns a tracer that records the transactions profiles on the
+// given profiler.
+func NewTracer(profiler *Profiler) *Tracer {
+	return &Tracer{
+		profiler: profiler,
+		tx:       newTxProfile(),
+	}
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+func (t *Tracer) CaptureTxStart(uint64) {
+	t.tx = newTxProfile()
+	t.frames = nil
+}
+
+// CaptureTxEnd implements vm.EVMLogger interface
+func (t *Tracer) CaptureTxEnd(uint64) {
+	t.profiler.record(t.tx)
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (t *Tracer) CaptureStart(_ *vm.EVM, _ common.Address, to common.Address, create bool, input []byte, _ uint64, _ *big.Int) {
+	t.enter(to, create, input)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (t *Tracer) CaptureEnd(_ []byte, gasUsed uint64, _ time.Duration, _ error) {
+	t.exit(gasUsed)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (t *Tracer) CaptureEnter(typ vm.OpCode, _ common.Address, to common.Address, input []byte, _ uint64, _ *big.Int) {
+	t.enter(to, typ == vm.CREATE || typ == vm.CREATE2, input)
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (t *Tracer) CaptureExit(_ []byte, gasUsed uint64, _ error) {
+	t.exit(gasUsed)
+}
+
+// CaptureState implements vm.EVMLogger interface
+func (t *Tracer) CaptureState(_ uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+	f := t.current()
+	if f == nil {
+		return
+	}
+
+	t.settle(f, gas)
+
+	switch op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL, vm.CREATE, vm.CREATE2:
+		// The cost of the call and create opcodes includes the gas forwarded to
+		// the nested frame, so it's computed from the gas left after the nested
+		// execution returns.
+		f.pendingOp, f.pendingGas, f.pendingCost, f.pendingChild, f.hasPending = op, gas, cost, 0, true
+	default:
+		t.addOpCode(op, cost)
+	}
+}
+
+// CaptureFault implements vm.EVMLogger interface
+func (t *Tracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+// enter pushes a new call frame.
+func (t *Tracer) enter(address common.Address, create bool, input []byte) {
+	f := &frame{address: address}
+	if !create && len(input) >= 4 {
+		copy(f.selector[:], input[:4])
+		f.hasSelector = true
+	}
+	t.frames = append(t.frames, f)
+}
+
+// exit pops the current call frame and accounts the gas it consumed.
+func (t *Tracer) exit(gasUsed uint64) {
+	f := t.current()
+	if f == nil {
+		return
+	}
+	t.frames = t.frames[:len(t.frames)-1]
+
+	// the frame execution halted after a call or create opcode
+	if f.hasPending {
+		f.hasPending = false
+		t.addOpCode(f.pendingOp, sub(f.pendingCost, f.pendingChild))
+	}
+
+	selfGas := sub(gasUsed, f.childGasUsed)
+	add(t.tx.contracts, f.address, selfGas)
+	if f.hasSelector {
+		add(t.tx.selectors, f.selector, selfGas)
+	}
+
+	if parent := t.current(); parent != nil {
+		parent.childGasUsed += gasUsed
+		parent.pendingChild += gasUsed
+	}
+}
+
+// settle accounts the cost of the pending call or create opcode of the frame,
+// given the gas left once the execution of the frame continues.
+func (t *Tracer) settle(f *frame, gas uint64) {
+	if !f.hasPending {
+		return
+	}
+	f.hasPending = false
+	t.addOpCode(f.pendingOp, sub(sub(f.pendingGas, gas), f.pendingChild))
+}
+
+func (t *Tracer) addOpCode(op vm.OpCode, cost uint64) {
+	add(t.tx.opcodes, op, cost)
+}
+
+func (t *Tracer) current() *frame {
+	if len(t.frames) == 0 {
+		return nil
+	}
+	return t.frames[len(t.frames)-1]
+}
+
+func add[K comparable](profile map[K]*stats, key K, gas uint64) {
+	s, ok := profile[key]
+	if !ok {
+		s = &stats{}
+		profile[key] = s
+	}
+	s.add(gas)
+}
+
+// sub returns a - b, or zero if b is greater than a.
+func sub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
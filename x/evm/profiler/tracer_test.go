@@ -0,0 +1,131 @@
+package profiler_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/profiler"
+)
+
+var (
+	caller    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contractA = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contractB = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	selectorA = []byte{0xa9, 0x05, 0x9c, 0xbb}
+	selectorB = []byte{0x70, 0xa0, 0x82, 0x31}
+)
+
+// traceNestedCall traces a call to contract A, which calls contract B
+// forwarding 50000 gas, out of which B uses 20000 to store a value.
+func traceNestedCall(tracer *profiler.Tracer) {
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, caller, contractA, false, append(selectorA, 0x01), 100000, big.NewInt(0))
+	tracer.CaptureState(0, vm.PUSH1, 100000, 3, nil, nil, 1, nil)
+	tracer.CaptureState(2, vm.CALL, 99997, 50100, nil, nil, 1, nil)
+	tracer.CaptureEnter(vm.CALL, contractA, contractB, selectorB, 50000, big.NewInt(0))
+	tracer.CaptureState(0, vm.SSTORE, 50000, 20000, nil, nil, 2, nil)
+	tracer.CaptureState(1, vm.STOP, 30000, 0, nil, nil, 2, nil)
+	tracer.CaptureExit(nil, 20000, nil)
+	tracer.CaptureState(3, vm.POP, 79897, 2, nil, nil, 1, nil)
+	tracer.CaptureState(4, vm.STOP, 79895, 0, nil, nil, 1, nil)
+	tracer.CaptureEnd(nil, 20105, 0, nil)
+	tracer.CaptureTxEnd(79895)
+}
+
+func TestTracerProfile(t *testing.T) {
+	p := profiler.NewProfiler(profiler.DefaultMaxEntries)
+	traceNestedCall(profiler.NewTracer(p))
+
+	profile := p.Profile(0)
+	require.Equal(t, uint64(1), profile.Transactions)
+	require.Equal(t, []profiler.ContractProfile{
+		{Address: contractB, Calls: 1, Gas: 20000},
+		{Address: contractA, Calls: 1, Gas: 105},
+	}, profile.Contracts)
+	require.Equal(t, []profiler.SelectorProfile{
+		{Selector: "0x70a08231", Calls: 1, Gas: 20000},
+		{Selector: "0xa9059cbb", Calls: 1, Gas: 105},
+	}, profile.Selectors)
+	require.Equal(t, []profiler.OpCodeProfile{
+		{OpCode: "SSTORE", Count: 1, Gas: 20000},
+		{OpCode: "CALL", Count: 1, Gas: 100},
+		{OpCode: "PUSH1", Count: 1, Gas: 3},
+		{OpCode: "POP", Count: 1, Gas: 2},
+		{OpCode: "STOP", Count: 2, Gas: 0},
+	}, profile.Opcodes)
+}
+
+func TestProfilerAggregatesAndLimit(t *testing.T) {
+	p := profiler.NewProfiler(profiler.DefaultMaxEntries)
+	traceNestedCall(profiler.NewTracer(p))
+	traceNestedCall(profiler.NewTracer(p))
+
+	profile := p.Profile(1)
+	require.Equal(t, uint64(2), profile.Transactions)
+	require.Equal(t, []profiler.ContractProfile{{Address: contractB, Calls: 2, Gas: 40000}}, profile.Contracts)
+	require.Equal(t, []profiler.SelectorProfile{{Selector: "0x70a08231", Calls: 2, Gas: 40000}}, profile.Selectors)
+	require.Equal(t, []profiler.OpCodeProfile{{OpCode: "SSTORE", Count: 2, Gas: 40000}}, profile.Opcodes)
+
+	p.Reset()
+	profile = p.Profile(0)
+	require.Zero(t, profile.Transactions)
+	require.Empty(t, profile.Contracts)
+	require.Empty(t, profile.Selectors)
+	require.Empty(t, profile.Opcodes)
+}
+
+// traceCall traces a call to the contract with the given selector, which uses
+// the given gas to store a value.
+func traceCall(tracer *profiler.Tracer, contract common.Address, selector []byte, gas uint64) {
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, caller, contract, false, selector, 100000, big.NewInt(0))
+	tracer.CaptureState(0, vm.SSTORE, 100000, gas, nil, nil, 1, nil)
+	tracer.CaptureState(1, vm.STOP, 100000-gas, 0, nil, nil, 1, nil)
+	tracer.CaptureEnd(nil, gas, 0, nil)
+	tracer.CaptureTxEnd(100000 - gas)
+}
+
+func TestProfilerMaxEntries(t *testing.T) {
+	contractC := common.HexToAddress("0x4000000000000000000000000000000000000004")
+	selectorC := []byte{0x09, 0x5e, 0xa7, 0xb3}
+
+	p := profiler.NewProfiler(1)
+	tracer := profiler.NewTracer(p)
+	traceCall(tracer, contractA, selectorA, 100)
+	traceCall(tracer, contractB, selectorB, 300)
+
+	// the entries are pruned once they exceed twice the max entries
+	profile := p.Profile(0)
+	require.Len(t, profile.Contracts, 2)
+	require.Len(t, profile.Selectors, 2)
+
+	traceCall(tracer, contractC, selectorC, 200)
+
+	profile = p.Profile(0)
+	require.Equal(t, uint64(3), profile.Transactions)
+	require.Equal(t, []profiler.ContractProfile{{Address: contractB, Calls: 1, Gas: 300}}, profile.Contracts)
+	require.Equal(t, []profiler.SelectorProfile{{Selector: "0x70a08231", Calls: 1, Gas: 300}}, profile.Selectors)
+	require.Equal(t, []profiler.OpCodeProfile{
+		{OpCode: "SSTORE", Count: 3, Gas: 600},
+		{OpCode: "STOP", Count: 3, Gas: 0},
+	}, profile.Opcodes)
+}
+
+func TestProfilerProfileAndReset(t *testing.T) {
+	p := profiler.NewProfiler(profiler.DefaultMaxEntries)
+	traceNestedCall(profiler.NewTracer(p))
+
+	profile := p.ProfileAndReset(0)
+	require.Equal(t, uint64(1), profile.Transactions)
+	require.Len(t, profile.Contracts, 2)
+
+	profile = p.Profile(0)
+	require.Zero(t, profile.Transactions)
+	require.Empty(t, profile.Contracts)
+	require.Empty(t, profile.Selectors)
+	require.Empty(t, profile.Opcodes)
+}
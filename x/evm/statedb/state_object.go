@@ -159,7 +159,7 @@ func (s *stateObject) Code() []byte {
 		return nil
 	}
 
-	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
+	code := s.db.keeper.GetCode(s.db.readContext(s.address), common.BytesToHash(s.CodeHash()))
 	s.code = code
 
 	return code
@@ -222,7 +222,7 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 		return value
 	}
 	// If no live objects are available, load it from keeper
-	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
+	value := s.db.keeper.GetState(s.db.readContext(s.address), s.Address(), key)
 	s.originStorage[key] = value
 	return value
 }
@@ -41,6 +41,9 @@ type StateDB struct {
 	cacheCtx sdk.Context
 	// writeCache function contains all the changes related to precompile calls.
 	writeCache func()
+	// cacheCtxAccounts are the accounts modified by precompiles through nested
+	// EVM calls, whose state is read from the cacheCtx.
+	cacheCtxAccounts map[common.Address]struct{}
 
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
@@ -68,11 +71,12 @@ type StateDB struct {
 // New creates a new state from a given trie.
 func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	return &StateDB{
-		keeper:       keeper,
-		ctx:          ctx,
-		stateObjects: make(map[common.Address]*stateObject),
-		journal:      newJournal(),
-		accessList:   newAccessList(),
+		keeper:           keeper,
+		ctx:              ctx,
+		cacheCtxAccounts: make(map[common.Address]struct{}),
+		stateObjects:     make(map[common.Address]*stateObject),
+		journal:          newJournal(),
+		accessList:       newAccessList(),
 
 		txConfig: txConfig,
 	}
@@ -99,6 +103,38 @@ func (s *StateDB) GetCacheContext() (sdk.Context, error) {
 	return s.cacheCtx, nil
 }
 
+// ReadFromCacheContext sets the state of the given account to be read from the
+// cache context for the rest of the transaction, so that the changes made to it
+// by a precompile through a nested EVM call are visible to the EVM. It fails if
+// the account state was already loaded in the transaction, as the loaded state
+// would not reflect those changes and would overwrite them on commit.
+func (s *StateDB) ReadFromCacheContext(addr common.Address) error {
+	if _, found := s.cacheCtxAccounts[addr]; found {
+		return nil
+	}
+
+	if s.stateObjects[addr] != nil {
+		return fmt.Errorf("state of account %s already loaded in the transaction", addr)
+	}
+
+	if s.writeCache == nil {
+		if err := s.cache(); err != nil {
+			return err
+		}
+	}
+
+	s.cacheCtxAccounts[addr] = struct{}{}
+	return nil
+}
+
+// readContext returns the context the state of the given account is read from.
+func (s *StateDB) readContext(addr common.Address) sdk.Context {
+	if _, found := s.cacheCtxAccounts[addr]; found {
+		return s.cacheCtx
+	}
+	return s.ctx
+}
+
 // MultiStoreSnapshot returns a copy of the stateDB CacheMultiStore.
 func (s *StateDB) MultiStoreSnapshot() storetypes.CacheMultiStore {
 	if s.writeCache == nil {
@@ -261,7 +297,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		return obj
 	}
 	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	account := s.keeper.GetAccount(s.readContext(addr), addr)
 	if account == nil {
 		return nil
 	}
@@ -321,7 +357,7 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	s.keeper.ForEachStorage(s.readContext(addr), addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
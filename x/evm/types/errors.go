@@ -32,6 +32,7 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidPrecompileRegistration
 )
 
 var (
@@ -88,6 +89,9 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidPrecompileRegistration returns an error if a precompile registration cannot be applied
+	ErrInvalidPrecompileRegistration = errorsmod.Register(ModuleName, codeErrInvalidPrecompileRegistration, "invalid precompile registration")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
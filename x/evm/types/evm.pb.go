@@ -71,6 +71,18 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// precompile_registry defines the static precompiles compiled into the binary
+	// that are scheduled for activation at a given block height, together with
+	// their gas schedule
+	PrecompileRegistry []PrecompileRegistration `protobuf:"bytes,11,rep,name=precompile_registry,json=precompileRegistry,proto3" json:"precompile_registry"`
+	// gas_schedule defines the constant gas overrides for the EVM opcodes
+	GasSchedule []OpCodeGas `protobuf:"bytes,12,rep,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+	// max_code_size defines the maximum size in bytes of the deployed contract code.
+	// The EIP-170 limit is used if set to zero.
+	MaxCodeSize uint64 `protobuf:"varint,13,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size defines the maximum size in bytes of the contract creation code.
+	// The EIP-3860 limit is used if set to zero.
+	MaxInitCodeSize uint64 `protobuf:"varint,14,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +153,163 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetPrecompileRegistry() []PrecompileRegistration {
+	if m != nil {
+		return m.PrecompileRegistry
+	}
+	return nil
+}
+
+func (m *Params) GetGasSchedule() []OpCodeGas {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+// OpCodeGas defines the constant gas charged for an EVM opcode
+type OpCodeGas struct {
+	// op_code is the name of the opcode (eg: SSTORE)
+	OpCode string `protobuf:"bytes,1,opt,name=op_code,json=opCode,proto3" json:"op_code,omitempty"`
+	// constant_gas is the constant gas charged for the execution of the opcode
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (m *OpCodeGas) Reset()         { *m = OpCodeGas{} }
+func (m *OpCodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpCodeGas) ProtoMessage()    {}
+func (*OpCodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *OpCodeGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpCodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpCodeGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpCodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpCodeGas.Merge(m, src)
+}
+func (m *OpCodeGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpCodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpCodeGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpCodeGas proto.InternalMessageInfo
+
+func (m *OpCodeGas) GetOpCode() string {
+	if m != nil {
+		return m.OpCode
+	}
+	return ""
+}
+
+func (m *OpCodeGas) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
+// PrecompileRegistration defines the activation height and the gas schedule of
+// a static precompile that is compiled into the binary
+type PrecompileRegistration struct {
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// activation_height is the block height from which the precompile is active
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// base_gas is the constant gas charged on every call to the precompile
+	BaseGas uint64 `protobuf:"varint,3,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// per_byte_gas is the gas charged for every byte of the call input
+	PerByteGas uint64 `protobuf:"varint,4,opt,name=per_byte_gas,json=perByteGas,proto3" json:"per_byte_gas,omitempty"`
+}
+
+func (m *PrecompileRegistration) Reset()         { *m = PrecompileRegistration{} }
+func (m *PrecompileRegistration) String() string { return proto.CompactTextString(m) }
+func (*PrecompileRegistration) ProtoMessage()    {}
+func (*PrecompileRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *PrecompileRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileRegistration.Merge(m, src)
+}
+func (m *PrecompileRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileRegistration proto.InternalMessageInfo
+
+func (m *PrecompileRegistration) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileRegistration) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PrecompileRegistration) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *PrecompileRegistration) GetPerByteGas() uint64 {
+	if m != nil {
+		return m.PerByteGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -154,7 +323,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +381,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +478,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +554,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +611,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +687,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +802,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +843,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +903,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,6 +1012,8 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 func init() {
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*OpCodeGas)(nil), "ethermint.evm.v1.OpCodeGas")
+	proto.RegisterType((*PrecompileRegistration)(nil), "ethermint.evm.v1.PrecompileRegistration")
 	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -857,128 +1028,141 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x16, 0xa5, 0x91, 0x34, 0x6c, 0x52, 0xe4, 0xa8, 0xf5, 0xb3, 0x5c, 0xae, 0xa3, 0x51, 0x26,
-	0x41, 0xa0, 0x2c, 0x1c, 0x69, 0x57, 0x6b, 0x25, 0xc2, 0x3a, 0x7f, 0xa2, 0x96, 0x4e, 0xc4, 0x68,
-	0xd7, 0x42, 0x53, 0x8e, 0xe1, 0x20, 0xc1, 0xa0, 0x39, 0xd3, 0x26, 0xc7, 0x9a, 0x99, 0x26, 0xa6,
-	0x9b, 0x5c, 0x32, 0x4f, 0x60, 0xec, 0xc9, 0x79, 0x80, 0x0d, 0x0c, 0xe4, 0x92, 0xa3, 0x1f, 0x21,
-	0x47, 0xc3, 0x27, 0x1f, 0x83, 0x00, 0x19, 0x04, 0xdc, 0x83, 0x01, 0x1d, 0xf5, 0x04, 0x41, 0xff,
-	0xf0, 0x57, 0x5a, 0x45, 0xb9, 0x50, 0x5d, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5d, 0x3d, 0xdd, 0x2d,
-	0x50, 0x26, 0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x1e, 0xe9, 0x46, 0x7b, 0xdd, 0xc7, 0xe2, 0xcf,
-	0x6e, 0x3b, 0xa1, 0x9c, 0x42, 0x6b, 0x34, 0xb7, 0x2b, 0x94, 0xdd, 0xc7, 0xe5, 0x55, 0x1c, 0x05,
-	0x31, 0xdd, 0x93, 0xbf, 0xca, 0xa8, 0xbc, 0xde, 0xa4, 0x4d, 0x2a, 0x87, 0x7b, 0x62, 0xa4, 0xb4,
-	0xce, 0x5f, 0x17, 0xc0, 0xd2, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x11, 0x00, 0xa4, 0xc7, 0x13, 0xec,
-	0x92, 0xa0, 0xcd, 0x4a, 0xc6, 0xf6, 0xc2, 0x4e, 0xb6, 0xe2, 0x0c, 0x52, 0x3b, 0x5b, 0x15, 0xda,
-	0xea, 0xc9, 0x19, 0xbb, 0x4a, 0xed, 0xd5, 0x3e, 0x8e, 0xc2, 0xa7, 0xce, 0xd8, 0xd0, 0x41, 0x59,
-	0x29, 0x54, 0x83, 0x36, 0x83, 0xfb, 0x60, 0x03, 0x87, 0x21, 0x7d, 0xe9, 0x76, 0x62, 0x01, 0x4f,
-	0x3c, 0x4e, 0x7c, 0x97, 0xf7, 0x58, 0x69, 0x69, 0x3b, 0xb3, 0x63, 0xa2, 0x35, 0x39, 0xf9, 0xd1,
-	0x78, 0xee, 0xbc, 0x27, 0x7c, 0xf2, 0xa4, 0x1b, 0xb9, 0x5e, 0x0b, 0xc7, 0x31, 0x09, 0x59, 0xc9,
-	0x94, 0xc4, 0xc5, 0x41, 0x6a, 0xe7, 0xaa, 0xbf, 0x7f, 0x7e, 0xac, 0xd5, 0x28, 0x47, 0xba, 0xd1,
-	0x50, 0x80, 0x7f, 0x02, 0x05, 0xec, 0x79, 0x84, 0x31, 0xd7, 0xa3, 0x31, 0x4f, 0x68, 0x58, 0xca,
-	0x6e, 0x67, 0x76, 0x72, 0xfb, 0xf6, 0xee, 0x6c, 0x25, 0x76, 0x8f, 0xa4, 0xdd, 0xb1, 0x32, 0xab,
-	0x6c, 0x7c, 0x9d, 0xda, 0x73, 0x83, 0xd4, 0x5e, 0x99, 0x52, 0xa3, 0x15, 0x3c, 0x29, 0xc2, 0xa7,
-	0xe0, 0x3e, 0xf6, 0x78, 0xd0, 0x25, 0x2e, 0xe3, 0x98, 0x07, 0x9e, 0xdb, 0x4e, 0x88, 0x47, 0xa3,
-	0x76, 0x10, 0x12, 0x56, 0x02, 0x22, 0x3e, 0x74, 0x4f, 0x19, 0xd4, 0xe5, 0xfc, 0xd9, 0x78, 0xfa,
-	0xe9, 0xbd, 0x57, 0xdf, 0x7d, 0xf5, 0x10, 0x92, 0x6e, 0x44, 0xd9, 0x5e, 0x4f, 0x2e, 0x95, 0x2a,
-	0x6f, 0xcd, 0x30, 0x33, 0xd6, 0x7c, 0xcd, 0x30, 0xe7, 0xad, 0x85, 0x9a, 0x61, 0x2e, 0x58, 0x46,
-	0xcd, 0x30, 0x17, 0xad, 0xa5, 0x9a, 0x61, 0x2e, 0x5b, 0x26, 0xca, 0x8a, 0x1a, 0xf8, 0x24, 0xa6,
-	0x11, 0xca, 0x7b, 0x2d, 0x1c, 0xc4, 0x22, 0xb3, 0x4f, 0x83, 0xa6, 0xf3, 0x97, 0x0c, 0x98, 0x0e,
-	0x16, 0x1e, 0x81, 0x25, 0x2f, 0x21, 0x98, 0x93, 0x52, 0x46, 0x26, 0xfd, 0x83, 0xff, 0x91, 0xf4,
-	0x79, 0xbf, 0x4d, 0x2a, 0x86, 0x48, 0x1c, 0x69, 0x47, 0xf8, 0x0b, 0x60, 0x78, 0x38, 0x0c, 0x4b,
-	0xf3, 0xff, 0x2f, 0x80, 0x74, 0x73, 0xfe, 0x9d, 0x01, 0xab, 0xd7, 0x2c, 0xa0, 0x07, 0x72, 0x7a,
-	0x51, 0x78, 0xbf, 0xad, 0x82, 0x2b, 0xec, 0xbf, 0xf3, 0x36, 0x6c, 0x09, 0xfa, 0xc3, 0x41, 0x6a,
-	0x83, 0xb1, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd7, 0x04, 0x90, 0x83, 0x00, 0x1e, 0x59, 0x40, 0x0f,
-	0xac, 0x4d, 0xaf, 0xbc, 0x1b, 0x06, 0x8c, 0x97, 0xe6, 0x65, 0xd3, 0x3c, 0x19, 0xa4, 0xf6, 0x74,
-	0x60, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x9e, 0x42, 0x9d, 0xf4, 0x74, 0xd0, 0x2a, 0x9e, 0x75,
-	0x70, 0xbe, 0x29, 0x82, 0xdc, 0xb1, 0x58, 0x84, 0x63, 0xb9, 0x06, 0xf0, 0x8f, 0xa0, 0xd8, 0xa2,
-	0x11, 0x61, 0x9c, 0x60, 0xdf, 0x6d, 0x84, 0xd4, 0xbb, 0x90, 0xd9, 0x65, 0x2b, 0x4f, 0xfe, 0x95,
-	0xda, 0x1b, 0x1e, 0x65, 0x11, 0x65, 0xcc, 0xbf, 0xd8, 0x0d, 0xe8, 0x5e, 0x84, 0x79, 0x6b, 0xf7,
-	0x24, 0x16, 0xa4, 0x9b, 0x8a, 0x74, 0xc6, 0xd3, 0x41, 0x85, 0x91, 0xa6, 0x22, 0x14, 0xb0, 0x05,
-	0x0a, 0x3e, 0xa6, 0xee, 0xa7, 0x34, 0xb9, 0xd0, 0xe0, 0xf3, 0x12, 0xbc, 0xf2, 0x56, 0xf0, 0x41,
-	0x6a, 0xe7, 0x9f, 0x1d, 0x7d, 0xf8, 0x01, 0x4d, 0x2e, 0x24, 0xc4, 0x55, 0x6a, 0x6f, 0x28, 0xb2,
-	0x69, 0x20, 0x07, 0xe5, 0x7d, 0x4c, 0x47, 0x66, 0xf0, 0x63, 0x60, 0x8d, 0x0c, 0x58, 0xa7, 0xdd,
-	0xa6, 0x09, 0x2f, 0x2d, 0x88, 0x9d, 0x59, 0xf9, 0xc9, 0x20, 0xb5, 0x0b, 0x1a, 0xb2, 0xae, 0x66,
-	0xae, 0x52, 0xfb, 0xde, 0x0c, 0xa8, 0xf6, 0x71, 0x50, 0x41, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x79,
-	0x12, 0xb4, 0x1f, 0x1f, 0x3c, 0xd2, 0x09, 0x18, 0x32, 0x81, 0x5f, 0xdd, 0x96, 0x40, 0xae, 0x7a,
-	0x72, 0xf6, 0xf8, 0xe0, 0xd1, 0x30, 0xfe, 0x35, 0xfd, 0x5d, 0x99, 0x40, 0x71, 0x50, 0x4e, 0x89,
-	0x2a, 0xf8, 0x13, 0xa0, 0x45, 0xb7, 0x85, 0x59, 0xab, 0xb4, 0x28, 0x29, 0x76, 0x44, 0x03, 0x29,
-	0xa4, 0xdf, 0x62, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xff, 0x33, 0x8e, 0x79, 0xd0, 0x89, 0x86, 0x58,
-	0x40, 0x39, 0x0b, 0xab, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0xa5, 0xbb, 0x86, 0x7b, 0x70, 0x53, 0xb8,
-	0x07, 0xd3, 0xe1, 0x2a, 0x9b, 0x11, 0xc7, 0xa1, 0xe6, 0x58, 0xbe, 0x2b, 0xc7, 0xe1, 0x4d, 0x1c,
-	0x87, 0xd3, 0x1c, 0xca, 0x46, 0xf4, 0xe5, 0x4c, 0x9e, 0x25, 0xf3, 0xce, 0x7d, 0x79, 0xad, 0x42,
-	0x85, 0x91, 0x46, 0xa1, 0x5f, 0x80, 0x75, 0x8f, 0xc6, 0x8c, 0x0b, 0x5d, 0x4c, 0xdb, 0x21, 0xd1,
-	0x14, 0x59, 0x49, 0x71, 0x78, 0x1b, 0xc5, 0x03, 0x45, 0x71, 0x93, 0xbb, 0x83, 0xd6, 0xa6, 0xd5,
-	0x8a, 0xcc, 0x05, 0x56, 0x9b, 0x70, 0x92, 0xb0, 0x46, 0x27, 0x69, 0x6a, 0x22, 0x20, 0x89, 0xde,
-	0xbb, 0x8d, 0x48, 0x77, 0xe8, 0xac, 0xab, 0x83, 0x8a, 0x63, 0x95, 0x22, 0xf8, 0x04, 0x14, 0x02,
-	0xc1, 0xda, 0xe8, 0x84, 0x1a, 0x3e, 0x27, 0xe1, 0xf7, 0x6f, 0x83, 0xd7, 0xbb, 0x6a, 0xda, 0xd1,
-	0x41, 0x2b, 0x43, 0x85, 0x82, 0xf6, 0x01, 0x8c, 0x3a, 0x41, 0xe2, 0x36, 0x43, 0xec, 0x05, 0x24,
-	0xd1, 0xf0, 0x79, 0x09, 0xff, 0xd3, 0xdb, 0xe0, 0xef, 0x2b, 0xf8, 0xeb, 0xce, 0x0e, 0xb2, 0x84,
-	0xf2, 0x37, 0x4a, 0xa7, 0x58, 0xea, 0x20, 0xdf, 0x20, 0x49, 0x18, 0xc4, 0x1a, 0x7f, 0x45, 0xe2,
-	0x3f, 0xba, 0x0d, 0x5f, 0x77, 0xd0, 0xa4, 0x9b, 0x83, 0x72, 0x4a, 0x1c, 0x81, 0x86, 0x34, 0xf6,
-	0xe9, 0x10, 0x74, 0xf5, 0xce, 0xa0, 0x93, 0x6e, 0x0e, 0xca, 0x29, 0x51, 0x81, 0x36, 0xc1, 0x1a,
-	0x4e, 0x12, 0xfa, 0x72, 0xa6, 0x20, 0x50, 0x62, 0xff, 0xec, 0x36, 0xec, 0xe1, 0x77, 0xfa, 0xba,
-	0xb7, 0xf8, 0x4e, 0x0b, 0xed, 0x54, 0x49, 0x7c, 0x00, 0x9b, 0x09, 0xee, 0xcf, 0xf0, 0xac, 0xdf,
-	0xb9, 0xf0, 0xd7, 0x9d, 0x1d, 0x64, 0x09, 0xe5, 0x14, 0xcb, 0x67, 0x60, 0x3d, 0x22, 0x49, 0x93,
-	0xb8, 0x31, 0xe1, 0xac, 0x1d, 0x06, 0x5c, 0xf3, 0x6c, 0xdc, 0x79, 0x1f, 0xdc, 0xe4, 0xee, 0x20,
-	0x28, 0xd5, 0x2f, 0xb4, 0x76, 0xd4, 0xa5, 0xac, 0x85, 0xe3, 0x66, 0x0b, 0x07, 0x9a, 0x65, 0xf3,
-	0xce, 0x5d, 0x3a, 0xed, 0xe8, 0xa0, 0x95, 0xa1, 0x62, 0xb4, 0xd4, 0x1e, 0x8e, 0xbd, 0xce, 0x70,
-	0xa9, 0xef, 0xdd, 0x79, 0xa9, 0x27, 0xdd, 0x1c, 0x94, 0x53, 0xa2, 0x02, 0xbd, 0x0f, 0x4c, 0x75,
-	0x5b, 0x09, 0xfc, 0x52, 0x69, 0x3b, 0xb3, 0x63, 0xa0, 0x65, 0x29, 0x9f, 0xf8, 0x70, 0x1d, 0x2c,
-	0xca, 0xfb, 0x4c, 0xe9, 0xbe, 0x20, 0x42, 0x4a, 0x80, 0x65, 0x60, 0xfa, 0xc4, 0x0b, 0x22, 0x1c,
-	0xb2, 0x52, 0x59, 0x3a, 0x8c, 0xe4, 0x9a, 0x61, 0x16, 0xac, 0x62, 0xcd, 0x30, 0x8b, 0x96, 0x55,
-	0x33, 0x4c, 0xcb, 0x5a, 0xad, 0x19, 0xe6, 0x9a, 0xb5, 0x8e, 0x56, 0xfa, 0x34, 0xa4, 0x6e, 0xf7,
-	0x89, 0x8a, 0x00, 0xe5, 0xc8, 0x4b, 0xcc, 0xf4, 0x57, 0x0b, 0x15, 0x3c, 0xcc, 0x71, 0xd8, 0x67,
-	0xba, 0xaa, 0xc8, 0x52, 0xb5, 0x9e, 0x38, 0x03, 0xf7, 0xc0, 0xa2, 0xb8, 0xa5, 0x11, 0x68, 0x81,
-	0x85, 0x0b, 0xd2, 0x57, 0x27, 0x37, 0x12, 0x43, 0x11, 0x62, 0x17, 0x87, 0x1d, 0xa2, 0x0e, 0x5c,
-	0xa4, 0x04, 0xe7, 0x0c, 0x14, 0xcf, 0x13, 0x1c, 0x33, 0x71, 0xc3, 0xa3, 0xf1, 0x29, 0x6d, 0x32,
-	0x08, 0x81, 0x21, 0x0f, 0x1d, 0xe5, 0x2b, 0xc7, 0xf0, 0xc7, 0xc0, 0x08, 0x69, 0x93, 0xc9, 0xab,
-	0x47, 0x6e, 0x7f, 0xe3, 0xfa, 0x3d, 0xe7, 0x94, 0x36, 0x91, 0x34, 0x71, 0xbe, 0x99, 0x07, 0x0b,
-	0xa7, 0xb4, 0x09, 0x4b, 0x60, 0x19, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x43, 0x11, 0x6e, 0x82,
-	0x25, 0x4e, 0xdb, 0x81, 0xa7, 0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a,
-	0x8f, 0xe4, 0x58, 0x5c, 0x98, 0x65, 0x66, 0x6e, 0xdc, 0x89, 0x1a, 0x24, 0x91, 0x87, 0xad, 0x51,
-	0x29, 0x5e, 0xa6, 0x76, 0x4e, 0xea, 0x5f, 0x48, 0x35, 0x9a, 0x14, 0xe0, 0xbb, 0x60, 0x99, 0xf7,
-	0x26, 0x0f, 0xce, 0xb5, 0xcb, 0xd4, 0x2e, 0xf2, 0x71, 0x9a, 0xe2, 0x5c, 0x44, 0x4b, 0xbc, 0x27,
-	0xcf, 0xc7, 0x3d, 0x60, 0xf2, 0x9e, 0x1b, 0xc4, 0x3e, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0xac, 0x5f,
-	0xa6, 0xb6, 0x35, 0x61, 0x7e, 0x22, 0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x17, 0x00, 0x15,
-	0x92, 0x64, 0x50, 0x47, 0xdd, 0xca, 0x65, 0x6a, 0x67, 0xa5, 0x56, 0x62, 0x8f, 0x87, 0xd0, 0x01,
-	0x8b, 0x0a, 0xdb, 0x94, 0xd8, 0xf9, 0xcb, 0xd4, 0x36, 0x43, 0xda, 0x54, 0x98, 0x6a, 0x4a, 0x94,
-	0x2a, 0x21, 0x11, 0xed, 0x12, 0x5f, 0x9e, 0x37, 0x26, 0x1a, 0x8a, 0xce, 0x17, 0xf3, 0xc0, 0x3c,
-	0xef, 0x21, 0xc2, 0x3a, 0x21, 0x87, 0x1f, 0x00, 0x4b, 0xde, 0xe6, 0xb0, 0xc7, 0xdd, 0xa9, 0xd2,
-	0x56, 0x1e, 0x8c, 0x4f, 0x87, 0x59, 0x0b, 0x07, 0x15, 0x87, 0xaa, 0x23, 0x5d, 0xff, 0x75, 0xb0,
-	0xd8, 0x08, 0x29, 0x8d, 0x64, 0x27, 0xe4, 0x91, 0x12, 0xe0, 0xc7, 0xb2, 0x6a, 0x72, 0x95, 0x17,
-	0xe4, 0x4d, 0xf9, 0xfb, 0xd7, 0x57, 0x79, 0xa6, 0x55, 0x2a, 0x0f, 0xc4, 0x3d, 0xf9, 0x2a, 0xb5,
-	0x0b, 0x8a, 0x5b, 0xfb, 0x3b, 0x7f, 0xff, 0xee, 0xab, 0x87, 0x19, 0x51, 0x60, 0xd9, 0x4f, 0x16,
-	0x58, 0x48, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45, 0x42, 0xba, 0x24, 0xe1, 0xc4,
-	0x97, 0x2b, 0x64, 0xa2, 0x91, 0x2c, 0x36, 0x59, 0x13, 0x33, 0xb7, 0xc3, 0x88, 0xaf, 0x96, 0x03,
-	0x2d, 0x37, 0x31, 0xfb, 0x88, 0x11, 0xff, 0xa9, 0xf1, 0xf9, 0x97, 0xf6, 0x9c, 0x83, 0x41, 0x4e,
-	0x5f, 0xa2, 0x3b, 0xed, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf, 0x38, 0x4d, 0x70, 0x93, 0xb8,
-	0x17, 0xa4, 0xaf, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0xf4, 0x19, 0x9a, 0x14, 0x34, 0xc5,
-	0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a, 0x56, 0x88, 0x89, 0xa6, 0xd0,
-	0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x87, 0xeb, 0x4d, 0x35, 0x14, 0x85, 0x47, 0x42, 0x48, 0x8f,
-	0x78, 0xb2, 0x96, 0x06, 0xd2, 0x12, 0x3c, 0x00, 0x2b, 0x7e, 0xc0, 0x70, 0x23, 0x94, 0xaf, 0x2d,
-	0xef, 0x42, 0xa5, 0x5f, 0xb1, 0x2e, 0x53, 0x3b, 0xaf, 0x27, 0xea, 0x42, 0x8f, 0xa6, 0x24, 0xf8,
-	0x3e, 0x28, 0x8e, 0xdd, 0x64, 0xb4, 0xea, 0x91, 0x59, 0x81, 0x97, 0xa9, 0x5d, 0x18, 0x99, 0xca,
-	0x19, 0x34, 0x23, 0xab, 0x6f, 0x53, 0xa3, 0xd3, 0x94, 0x1d, 0x68, 0x22, 0x25, 0x08, 0x6d, 0x18,
-	0x44, 0x01, 0x97, 0x1d, 0xb7, 0x88, 0x94, 0x00, 0xdf, 0x07, 0x59, 0xda, 0x25, 0x49, 0x12, 0xf8,
-	0xf2, 0xf1, 0x27, 0xda, 0xe0, 0x7b, 0xd7, 0xdb, 0x60, 0xe2, 0xb9, 0x80, 0xc6, 0xf6, 0x22, 0x39,
-	0x12, 0xcb, 0x20, 0x23, 0x12, 0xd1, 0xa4, 0x2f, 0x2f, 0x1d, 0x3a, 0x39, 0x35, 0xf1, 0x5c, 0xea,
-	0xd1, 0x94, 0x04, 0x2b, 0x00, 0x6a, 0xb7, 0x84, 0xf0, 0x4e, 0x12, 0xbb, 0xf2, 0x23, 0x90, 0x97,
-	0xbe, 0x72, 0x2b, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x61, 0x8e, 0xd1, 0x35, 0x0d, 0xfc, 0x25, 0x80,
-	0x6a, 0x4d, 0xdc, 0xcf, 0x18, 0x1d, 0x3e, 0x27, 0xf5, 0xad, 0x41, 0xf2, 0xab, 0x59, 0x1d, 0xb3,
-	0xa5, 0xa4, 0x1a, 0xa3, 0x3a, 0x8b, 0x9a, 0x61, 0x1a, 0xd6, 0xa2, 0x7e, 0x9d, 0x0e, 0xeb, 0xa7,
-	0xb3, 0x40, 0x6b, 0x43, 0x79, 0x22, 0xbc, 0x87, 0xff, 0xc8, 0x80, 0x89, 0xb7, 0x1c, 0xfc, 0x39,
-	0x28, 0x1f, 0x1d, 0x1f, 0x57, 0xeb, 0x75, 0xf7, 0xfc, 0x93, 0xb3, 0xaa, 0x7b, 0x56, 0x45, 0xcf,
-	0x4f, 0xea, 0xf5, 0x93, 0x0f, 0x5f, 0x9c, 0x56, 0xeb, 0x75, 0x6b, 0xae, 0xfc, 0xce, 0xab, 0xd7,
-	0xdb, 0xa5, 0xb1, 0xfd, 0x99, 0xa8, 0x27, 0x63, 0x01, 0x8d, 0x43, 0xd1, 0xa9, 0xef, 0x81, 0xcd,
-	0x49, 0x6f, 0x54, 0xad, 0x9f, 0xa3, 0x93, 0xe3, 0xf3, 0xea, 0x33, 0x2b, 0x53, 0x2e, 0xbd, 0x7a,
-	0xbd, 0xbd, 0x3e, 0xf6, 0x44, 0x84, 0xf1, 0x24, 0xf0, 0xc4, 0x4e, 0x39, 0x04, 0xa5, 0x9b, 0x39,
-	0xab, 0xcf, 0xac, 0xf9, 0x72, 0xf9, 0xd5, 0xeb, 0xed, 0xcd, 0x9b, 0x18, 0x89, 0x5f, 0x36, 0x3e,
-	0xff, 0xdb, 0xd6, 0x5c, 0xe5, 0xd7, 0x5f, 0x0f, 0xb6, 0x32, 0xdf, 0x0e, 0xb6, 0x32, 0xff, 0x19,
-	0x6c, 0x65, 0xbe, 0x78, 0xb3, 0x35, 0xf7, 0xed, 0x9b, 0xad, 0xb9, 0x7f, 0xbe, 0xd9, 0x9a, 0xfb,
-	0xc3, 0x8f, 0x9a, 0x01, 0x6f, 0x75, 0x1a, 0xbb, 0x1e, 0x8d, 0xf6, 0xd4, 0xe3, 0x5e, 0xfd, 0x76,
-	0xf7, 0x1f, 0xe9, 0x67, 0xbe, 0x78, 0xab, 0xb2, 0xc6, 0x92, 0xfc, 0xb7, 0xca, 0x93, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xfd, 0x0a, 0x4c, 0xaf, 0x11, 0x00, 0x00,
+	// 2144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0xe3, 0xc6,
+	0xf9, 0xb7, 0x6c, 0xda, 0xa6, 0x47, 0xb2, 0x44, 0x8f, 0x5f, 0x56, 0xab, 0xcd, 0xdf, 0x74, 0xf8,
+	0x2f, 0x0a, 0x37, 0x49, 0xed, 0x5d, 0x6f, 0xdc, 0x2e, 0x36, 0x7d, 0xb3, 0xbc, 0xca, 0xc6, 0xee,
+	0xbe, 0x18, 0x23, 0xa7, 0x41, 0x8a, 0x16, 0xc4, 0x88, 0x9c, 0x50, 0x8c, 0x49, 0x8e, 0xc0, 0x19,
+	0x69, 0xa5, 0xfd, 0x04, 0xc1, 0x9e, 0xd2, 0x4b, 0x4f, 0x5d, 0x20, 0x40, 0x2f, 0x3d, 0xe6, 0x23,
+	0xf4, 0x18, 0xe4, 0x94, 0x63, 0x51, 0xa0, 0x44, 0xe1, 0x3d, 0x04, 0xf0, 0xd1, 0x9f, 0xa0, 0x98,
+	0x17, 0xbd, 0x3b, 0xae, 0x7b, 0x91, 0xf8, 0xbc, 0xfd, 0x7e, 0xcf, 0xcc, 0x3c, 0x9c, 0x79, 0x86,
+	0xa0, 0x42, 0x78, 0x93, 0xa4, 0x71, 0x98, 0xf0, 0x5d, 0xd2, 0x89, 0x77, 0x3b, 0xf7, 0xc4, 0xdf,
+	0x4e, 0x2b, 0xa5, 0x9c, 0x42, 0x6b, 0x60, 0xdb, 0x11, 0xca, 0xce, 0xbd, 0xca, 0x0a, 0x8e, 0xc3,
+	0x84, 0xee, 0xca, 0x5f, 0xe5, 0x54, 0x59, 0x0b, 0x68, 0x40, 0xe5, 0xe3, 0xae, 0x78, 0x52, 0x5a,
+	0xe7, 0xcf, 0xf3, 0x60, 0xe1, 0x04, 0xa7, 0x38, 0x66, 0xf0, 0x00, 0x00, 0xd2, 0xe5, 0x29, 0x76,
+	0x49, 0xd8, 0x62, 0x65, 0x63, 0x6b, 0x6e, 0x7b, 0xa9, 0xea, 0x9c, 0x67, 0xf6, 0x52, 0x4d, 0x68,
+	0x6b, 0x47, 0x27, 0xec, 0x32, 0xb3, 0x57, 0x7a, 0x38, 0x8e, 0x1e, 0x3a, 0x43, 0x47, 0x07, 0x2d,
+	0x49, 0xa1, 0x16, 0xb6, 0x18, 0xdc, 0x03, 0xeb, 0x38, 0x8a, 0xe8, 0x0b, 0xb7, 0x9d, 0x08, 0x78,
+	0xe2, 0x71, 0xe2, 0xbb, 0xbc, 0xcb, 0xca, 0x0b, 0x5b, 0xb9, 0x6d, 0x13, 0xad, 0x4a, 0xe3, 0xc7,
+	0x43, 0xdb, 0x69, 0x57, 0xc4, 0x14, 0x48, 0x27, 0x76, 0xbd, 0x26, 0x4e, 0x12, 0x12, 0xb1, 0xb2,
+	0x29, 0x89, 0x4b, 0xe7, 0x99, 0x9d, 0xaf, 0xfd, 0xee, 0xe9, 0xa1, 0x56, 0xa3, 0x3c, 0xe9, 0xc4,
+	0x7d, 0x01, 0xfe, 0x11, 0x14, 0xb1, 0xe7, 0x11, 0xc6, 0x5c, 0x8f, 0x26, 0x3c, 0xa5, 0x51, 0x79,
+	0x69, 0x2b, 0xb7, 0x9d, 0xdf, 0xb3, 0x77, 0x26, 0x67, 0x62, 0xe7, 0x40, 0xfa, 0x1d, 0x2a, 0xb7,
+	0xea, 0xfa, 0x37, 0x99, 0x3d, 0x73, 0x9e, 0xd9, 0xcb, 0x63, 0x6a, 0xb4, 0x8c, 0x47, 0x45, 0xf8,
+	0x10, 0xdc, 0xc6, 0x1e, 0x0f, 0x3b, 0xc4, 0x65, 0x1c, 0xf3, 0xd0, 0x73, 0x5b, 0x29, 0xf1, 0x68,
+	0xdc, 0x0a, 0x23, 0xc2, 0xca, 0x40, 0xe4, 0x87, 0x6e, 0x29, 0x87, 0xba, 0xb4, 0x9f, 0x0c, 0xcd,
+	0xd0, 0x05, 0xab, 0x43, 0x6f, 0x37, 0x25, 0x41, 0xc8, 0x78, 0xda, 0x2b, 0xe7, 0xb7, 0xe6, 0xb6,
+	0xf3, 0x7b, 0xdb, 0xd3, 0xf9, 0x0d, 0x63, 0x91, 0xf2, 0xc5, 0x3c, 0xa4, 0x49, 0xd5, 0x10, 0x89,
+	0x22, 0xd8, 0x9a, 0xb4, 0xf6, 0xe0, 0x23, 0x50, 0x08, 0x30, 0x73, 0x99, 0xd7, 0x24, 0x7e, 0x3b,
+	0x22, 0xe5, 0x82, 0x44, 0xbe, 0x33, 0x8d, 0xfc, 0xbc, 0x75, 0x48, 0x7d, 0xf2, 0x18, 0x33, 0x0d,
+	0x96, 0x0f, 0x30, 0xab, 0xeb, 0x28, 0xe8, 0x80, 0xe5, 0x18, 0x77, 0x5d, 0x8f, 0xfa, 0xc4, 0x65,
+	0xe1, 0x4b, 0x52, 0x5e, 0xde, 0xca, 0x6d, 0x1b, 0x28, 0x1f, 0xe3, 0xae, 0x88, 0xaa, 0x87, 0x2f,
+	0x09, 0x7c, 0x17, 0x40, 0xe1, 0x13, 0x26, 0x21, 0x1f, 0x71, 0x2c, 0x4a, 0xc7, 0x52, 0x8c, 0xbb,
+	0x47, 0x49, 0xc8, 0xfb, 0xce, 0x0f, 0x6f, 0xbd, 0xfa, 0xfe, 0xeb, 0x77, 0x20, 0xe9, 0xc4, 0x94,
+	0xed, 0x76, 0x65, 0x89, 0xaa, 0xb2, 0x3a, 0x36, 0xcc, 0x9c, 0x35, 0x7b, 0x6c, 0x98, 0xb3, 0xd6,
+	0xdc, 0xb1, 0x61, 0xce, 0x59, 0xc6, 0xb1, 0x61, 0xce, 0x5b, 0x0b, 0xc7, 0x86, 0xb9, 0x68, 0x99,
+	0x68, 0x49, 0xac, 0xbd, 0x4f, 0x12, 0x1a, 0xa3, 0x82, 0xd7, 0xc4, 0x61, 0x22, 0x56, 0xf4, 0xb3,
+	0x30, 0x70, 0x1e, 0x83, 0xa5, 0xc1, 0x00, 0xe0, 0x2d, 0xb0, 0x48, 0x5b, 0x32, 0x87, 0x72, 0x6e,
+	0x2b, 0xb7, 0xbd, 0x84, 0x16, 0xa8, 0xb4, 0xc1, 0xb7, 0x41, 0xc1, 0xa3, 0x09, 0xe3, 0x38, 0xe1,
+	0x6e, 0x80, 0x59, 0x79, 0x56, 0x8d, 0xa2, 0xaf, 0x7b, 0x8c, 0x99, 0xf3, 0x97, 0x1c, 0xd8, 0xb8,
+	0x7a, 0x92, 0x61, 0x19, 0x2c, 0x62, 0xdf, 0x4f, 0x09, 0x63, 0x1a, 0xb6, 0x2f, 0xc2, 0x77, 0xc1,
+	0x8a, 0x5c, 0x60, 0xe9, 0xe7, 0x36, 0x49, 0x18, 0x34, 0xb9, 0x04, 0x9f, 0x43, 0xd6, 0xd0, 0xf0,
+	0x91, 0xd4, 0xc3, 0xdb, 0xc0, 0x6c, 0x60, 0x46, 0x64, 0x02, 0x73, 0x32, 0x81, 0x45, 0x21, 0x8b,
+	0xc4, 0xb7, 0x40, 0xa1, 0x45, 0x52, 0xb7, 0xd1, 0xe3, 0xca, 0x6c, 0x48, 0x33, 0x68, 0x91, 0xb4,
+	0xda, 0xe3, 0xc2, 0xc3, 0xf9, 0x53, 0x0e, 0x8c, 0x17, 0x23, 0x3c, 0x00, 0x0b, 0x5e, 0x4a, 0x30,
+	0x57, 0x63, 0xcd, 0xef, 0xfd, 0xff, 0x7f, 0x29, 0xea, 0xd3, 0x5e, 0x8b, 0xe8, 0x25, 0xd6, 0x81,
+	0xf0, 0x97, 0xc0, 0xf0, 0x70, 0x14, 0x95, 0x67, 0xff, 0x57, 0x00, 0x19, 0xe6, 0xfc, 0x2b, 0x07,
+	0x56, 0xa6, 0x3c, 0xa0, 0x07, 0xf2, 0xfa, 0xa5, 0xe3, 0xbd, 0x96, 0x4a, 0xae, 0xb8, 0xf7, 0xd6,
+	0x0f, 0x61, 0x4b, 0xd0, 0x1f, 0x9d, 0x67, 0x36, 0x18, 0xca, 0x97, 0x99, 0x0d, 0xd5, 0xfe, 0x31,
+	0x02, 0xe4, 0x20, 0x80, 0x07, 0x1e, 0xd0, 0x03, 0xab, 0xe3, 0x6f, 0xb6, 0x1b, 0x85, 0x4c, 0x4c,
+	0xbd, 0xd8, 0x14, 0xee, 0x9f, 0x67, 0xf6, 0x78, 0x62, 0x4f, 0x42, 0xc6, 0x2f, 0x33, 0xbb, 0x32,
+	0x86, 0x3a, 0x1a, 0xe9, 0xa0, 0x15, 0x3c, 0x19, 0xe0, 0x7c, 0x5b, 0x02, 0xf9, 0x43, 0x51, 0x6c,
+	0x87, 0xb2, 0xd6, 0xe0, 0x1f, 0x40, 0xa9, 0x49, 0x63, 0xc2, 0x38, 0xc1, 0xbe, 0xdb, 0x88, 0xa8,
+	0x77, 0xa6, 0xea, 0xa1, 0x7a, 0xff, 0x9f, 0x99, 0xbd, 0xee, 0x51, 0x16, 0x53, 0xc6, 0xfc, 0xb3,
+	0x9d, 0x90, 0xee, 0xc6, 0x98, 0x37, 0x77, 0x8e, 0x12, 0x41, 0xba, 0xa1, 0x48, 0x27, 0x22, 0x1d,
+	0x54, 0x1c, 0x68, 0xaa, 0x42, 0x01, 0x9b, 0xa0, 0xe8, 0x63, 0xea, 0x7e, 0x46, 0xd3, 0x33, 0x0d,
+	0x3e, 0x2b, 0xc1, 0xab, 0x3f, 0x08, 0x7e, 0x9e, 0xd9, 0x85, 0x47, 0x07, 0xcf, 0x3f, 0xa4, 0xe9,
+	0x99, 0x84, 0xb8, 0xcc, 0xec, 0x75, 0x45, 0x36, 0x0e, 0xe4, 0xa0, 0x82, 0x8f, 0xe9, 0xc0, 0x0d,
+	0x7e, 0x02, 0xac, 0x81, 0x03, 0x6b, 0xb7, 0x5a, 0x34, 0xe5, 0xb2, 0x20, 0xcd, 0xea, 0x4f, 0xcf,
+	0x33, 0xbb, 0xa8, 0x21, 0xeb, 0xca, 0x72, 0x99, 0xd9, 0xb7, 0x26, 0x40, 0x75, 0x8c, 0x83, 0x8a,
+	0x1a, 0x56, 0xbb, 0xc2, 0x06, 0x28, 0x90, 0xb0, 0x75, 0x6f, 0xff, 0xae, 0x1e, 0x80, 0x21, 0x07,
+	0xf0, 0xeb, 0xeb, 0x06, 0x90, 0xaf, 0x1d, 0x9d, 0xdc, 0xdb, 0xbf, 0xdb, 0xcf, 0x7f, 0x55, 0x51,
+	0x8d, 0xa2, 0x38, 0x28, 0xaf, 0x44, 0x95, 0xfc, 0x11, 0xd0, 0xa2, 0xdb, 0xc4, 0xac, 0x59, 0x9e,
+	0x97, 0x14, 0xdb, 0xa2, 0x80, 0x14, 0xd2, 0x47, 0x98, 0x35, 0x87, 0xb3, 0xde, 0xe8, 0xbd, 0xc4,
+	0x09, 0x0f, 0xdb, 0x71, 0x1f, 0x0b, 0xa8, 0x60, 0xe1, 0x35, 0x48, 0x77, 0x5f, 0xa7, 0xbb, 0x70,
+	0xd3, 0x74, 0xf7, 0xaf, 0x4a, 0x77, 0x7f, 0x3c, 0x5d, 0xe5, 0x33, 0xe0, 0x78, 0xa0, 0x39, 0x16,
+	0x6f, 0xca, 0xf1, 0xe0, 0x2a, 0x8e, 0x07, 0xe3, 0x1c, 0xca, 0x47, 0xd4, 0xe5, 0xc4, 0x38, 0xcb,
+	0xe6, 0x8d, 0xeb, 0x72, 0x6a, 0x86, 0x8a, 0x03, 0x8d, 0x42, 0x3f, 0x03, 0x6b, 0xfd, 0x7d, 0x32,
+	0x4c, 0x68, 0x2b, 0x22, 0x9a, 0x62, 0x49, 0x52, 0x3c, 0xb8, 0x8e, 0xe2, 0x8e, 0xa2, 0xb8, 0x2a,
+	0xdc, 0x41, 0xab, 0xe3, 0x6a, 0x45, 0xe6, 0x02, 0xab, 0x45, 0x38, 0x49, 0x59, 0xa3, 0x9d, 0x06,
+	0x9a, 0x08, 0x48, 0xa2, 0xf7, 0xaf, 0x23, 0xd2, 0x15, 0x3a, 0x19, 0xea, 0xa0, 0xd2, 0x50, 0xa5,
+	0x08, 0x3e, 0x05, 0xc5, 0x50, 0xb0, 0x36, 0xda, 0x91, 0x86, 0xcf, 0x4b, 0xf8, 0xbd, 0xeb, 0xe0,
+	0xf5, 0x5b, 0x35, 0x1e, 0xe8, 0xa0, 0xe5, 0xbe, 0x42, 0x41, 0xfb, 0x00, 0xc6, 0xed, 0x30, 0x75,
+	0x83, 0x08, 0x7b, 0xa1, 0xd8, 0xcd, 0x25, 0x7c, 0x41, 0xc2, 0xff, 0xec, 0x3a, 0xf8, 0xdb, 0x0a,
+	0x7e, 0x3a, 0xd8, 0x41, 0x96, 0x50, 0x3e, 0x56, 0x3a, 0xc5, 0x52, 0x07, 0x85, 0x06, 0x49, 0xa3,
+	0x30, 0xd1, 0xf8, 0xcb, 0x12, 0xff, 0xee, 0x75, 0xf8, 0xba, 0x82, 0x46, 0xc3, 0x1c, 0x94, 0x57,
+	0xe2, 0x00, 0x34, 0xa2, 0x89, 0x4f, 0xfb, 0xa0, 0x2b, 0x37, 0x06, 0x1d, 0x0d, 0x73, 0x50, 0x5e,
+	0x89, 0x0a, 0x34, 0x00, 0xab, 0x38, 0x4d, 0xe9, 0x8b, 0x89, 0x09, 0x81, 0x12, 0xfb, 0xe7, 0xd7,
+	0x61, 0xf7, 0xf7, 0xe9, 0xe9, 0x68, 0xb1, 0x4f, 0x0b, 0xed, 0xd8, 0x94, 0xf8, 0x00, 0x06, 0x29,
+	0xee, 0x4d, 0xf0, 0xac, 0xdd, 0x78, 0xe2, 0xa7, 0x83, 0x1d, 0x64, 0x09, 0xe5, 0x18, 0xcb, 0xe7,
+	0x60, 0x2d, 0x26, 0x69, 0x40, 0xdc, 0x84, 0x70, 0xd6, 0x8a, 0x42, 0xae, 0x79, 0xd6, 0x6f, 0xfc,
+	0x1e, 0x5c, 0x15, 0xee, 0x20, 0x28, 0xd5, 0xcf, 0xb4, 0x76, 0x50, 0xa5, 0xac, 0x89, 0x93, 0xa0,
+	0x89, 0x43, 0xcd, 0xb2, 0x71, 0xe3, 0x2a, 0x1d, 0x0f, 0x74, 0xd0, 0x72, 0x5f, 0x31, 0x58, 0x6a,
+	0x0f, 0x27, 0x5e, 0xbb, 0xbf, 0xd4, 0xb7, 0x6e, 0xbc, 0xd4, 0xa3, 0x61, 0x0e, 0xca, 0x2b, 0x51,
+	0x81, 0xde, 0x06, 0xa6, 0xea, 0xca, 0x42, 0xbf, 0x5c, 0x56, 0xad, 0x8d, 0x94, 0x8f, 0x7c, 0xb8,
+	0x06, 0xe6, 0x65, 0xdf, 0x56, 0xbe, 0x2d, 0x5b, 0x27, 0x25, 0xc0, 0x0a, 0x30, 0x7d, 0xe2, 0x85,
+	0x31, 0x8e, 0x58, 0xb9, 0x22, 0x03, 0x06, 0xf2, 0xb1, 0x61, 0x16, 0xad, 0xd2, 0xb1, 0x61, 0x96,
+	0x2c, 0xeb, 0xd8, 0x30, 0x2d, 0x6b, 0xe5, 0xd8, 0x30, 0x57, 0xad, 0x35, 0xb4, 0xdc, 0xa3, 0x11,
+	0x75, 0x3b, 0xf7, 0x55, 0x06, 0x28, 0x4f, 0x5e, 0x60, 0xa6, 0x77, 0x2d, 0x54, 0xf4, 0x30, 0xc7,
+	0x51, 0x8f, 0xe9, 0x59, 0x45, 0x96, 0x9a, 0xeb, 0x91, 0x33, 0x70, 0x17, 0xcc, 0x8b, 0x2e, 0x9c,
+	0x40, 0x0b, 0xcc, 0x9d, 0x91, 0x9e, 0xee, 0xe4, 0xc4, 0xa3, 0x48, 0xb1, 0x83, 0xa3, 0x36, 0x51,
+	0x07, 0x2e, 0x52, 0x82, 0x73, 0x02, 0x4a, 0xa7, 0x29, 0x4e, 0x98, 0xe8, 0xe3, 0x68, 0xf2, 0x84,
+	0x06, 0x0c, 0x42, 0x60, 0xc8, 0x43, 0x47, 0xc5, 0xca, 0x67, 0xf8, 0x13, 0x60, 0x44, 0x34, 0x60,
+	0xb2, 0xf5, 0xc8, 0xef, 0xad, 0x4f, 0xf7, 0x39, 0x4f, 0x68, 0x80, 0xa4, 0x8b, 0xf3, 0xed, 0x2c,
+	0x98, 0x7b, 0x42, 0x83, 0x6b, 0xfa, 0xc9, 0x0d, 0xb0, 0xc0, 0x69, 0x2b, 0xf4, 0x14, 0xdc, 0x12,
+	0xd2, 0x92, 0x20, 0xf6, 0x31, 0xc7, 0xf2, 0x94, 0x2e, 0x20, 0xf9, 0x2c, 0x2e, 0x44, 0x72, 0x64,
+	0x6e, 0xd2, 0x8e, 0x1b, 0x24, 0x55, 0x3d, 0x63, 0xb5, 0x74, 0x91, 0xd9, 0x79, 0xa9, 0x7f, 0x26,
+	0xd5, 0x68, 0x54, 0x80, 0xef, 0x81, 0x45, 0xde, 0x1d, 0x3d, 0x38, 0x57, 0x2f, 0x32, 0xbb, 0xc4,
+	0x87, 0xc3, 0x14, 0xe7, 0x22, 0x5a, 0xe0, 0x5d, 0xf1, 0x0f, 0x77, 0x81, 0xc9, 0x45, 0x5f, 0xef,
+	0x93, 0xae, 0x3c, 0x1b, 0x8d, 0xea, 0xda, 0x45, 0x66, 0x5b, 0x23, 0xee, 0x47, 0xc2, 0x86, 0x16,
+	0x79, 0x57, 0x3e, 0xc0, 0xf7, 0x00, 0x50, 0x29, 0x49, 0x06, 0x75, 0xd4, 0x2d, 0x5f, 0x64, 0xf6,
+	0x92, 0xd4, 0x4a, 0xec, 0xe1, 0x23, 0x74, 0xc0, 0xbc, 0xc2, 0x36, 0x25, 0x76, 0xe1, 0x22, 0xb3,
+	0xcd, 0x88, 0x06, 0x0a, 0x53, 0x99, 0xc4, 0x54, 0xa5, 0x24, 0xa6, 0x1d, 0xe2, 0xcb, 0xf3, 0xc6,
+	0x44, 0x7d, 0xd1, 0xf9, 0x72, 0x16, 0x98, 0xa7, 0x5d, 0x44, 0x58, 0x3b, 0xe2, 0xf0, 0x43, 0x60,
+	0xc9, 0x6e, 0x0e, 0x7b, 0xdc, 0x1d, 0x9b, 0xda, 0xea, 0x9d, 0xe1, 0xe9, 0x30, 0xe9, 0xe1, 0xa0,
+	0x52, 0x5f, 0x75, 0xa0, 0xe7, 0x7f, 0x0d, 0xcc, 0x37, 0x22, 0x4a, 0x63, 0x59, 0x09, 0x05, 0xa4,
+	0x04, 0xf8, 0x89, 0x9c, 0x35, 0xb9, 0xca, 0x73, 0xb2, 0x53, 0x7e, 0x7b, 0x7a, 0x95, 0x27, 0x4a,
+	0xa5, 0x7a, 0x47, 0xf4, 0xc9, 0x97, 0x99, 0x5d, 0x54, 0xdc, 0x3a, 0xde, 0xf9, 0xdb, 0xf7, 0x5f,
+	0xbf, 0x93, 0x13, 0x13, 0x2c, 0xeb, 0xc9, 0x02, 0x73, 0x29, 0xe1, 0x72, 0xe5, 0x0a, 0x48, 0x3c,
+	0x8a, 0xf7, 0x22, 0x25, 0x1d, 0x92, 0x72, 0xe2, 0xcb, 0x15, 0x32, 0xd1, 0x40, 0x16, 0x2f, 0x99,
+	0xb8, 0xd1, 0xb5, 0x19, 0xf1, 0xd5, 0x72, 0xa0, 0xc5, 0x00, 0xb3, 0x8f, 0x19, 0xf1, 0x1f, 0x1a,
+	0x5f, 0x7c, 0x65, 0xcf, 0x38, 0x18, 0xe4, 0x75, 0x13, 0xdd, 0x6e, 0x45, 0xe4, 0x9a, 0x32, 0xdb,
+	0x03, 0x05, 0xc6, 0x69, 0x8a, 0x03, 0xe2, 0x9e, 0x91, 0x9e, 0x2e, 0x36, 0x55, 0x3a, 0x5a, 0xff,
+	0x5b, 0xd2, 0x63, 0x68, 0x54, 0xd0, 0x14, 0x5f, 0x19, 0x20, 0x7f, 0x9a, 0x62, 0x8f, 0xe8, 0x96,
+	0x58, 0x14, 0xac, 0x10, 0xd3, 0xfe, 0x85, 0x4b, 0x49, 0x82, 0x9b, 0x87, 0x31, 0xa1, 0x6d, 0xae,
+	0x5f, 0xaa, 0xbe, 0x28, 0x22, 0x52, 0x42, 0xba, 0xc4, 0xd3, 0x77, 0x20, 0x2d, 0xc1, 0x7d, 0xb0,
+	0xec, 0x87, 0x0c, 0x37, 0x22, 0x79, 0x9b, 0xf6, 0xce, 0xd4, 0xf0, 0xab, 0xd6, 0x45, 0x66, 0x17,
+	0xb4, 0xa1, 0x2e, 0xf4, 0x68, 0x4c, 0x82, 0x1f, 0x80, 0xd2, 0x30, 0x4c, 0x66, 0xab, 0x3e, 0x22,
+	0x54, 0xe1, 0x45, 0x66, 0x17, 0x07, 0xae, 0xd2, 0x82, 0x26, 0x64, 0xb5, 0x37, 0x35, 0xda, 0x81,
+	0xac, 0x40, 0x13, 0x29, 0x41, 0x68, 0xa3, 0x30, 0x0e, 0xb9, 0xac, 0xb8, 0x79, 0xa4, 0x04, 0xf8,
+	0x01, 0x58, 0xa2, 0x1d, 0x92, 0xa6, 0xa1, 0x2f, 0x2f, 0xf7, 0xa2, 0x0c, 0xfe, 0x6f, 0xba, 0x0c,
+	0x46, 0xae, 0x0b, 0x68, 0xe8, 0x2f, 0x06, 0x47, 0x12, 0x99, 0x64, 0x4c, 0x62, 0x2a, 0xef, 0xf9,
+	0x83, 0xc1, 0x29, 0xc3, 0x53, 0xa9, 0x47, 0x63, 0x12, 0xac, 0x02, 0xa8, 0xc3, 0x52, 0xc2, 0xdb,
+	0x69, 0xe2, 0xca, 0x4d, 0xa0, 0x20, 0x63, 0xe5, 0xab, 0xa8, 0xac, 0x48, 0x1a, 0x1f, 0x61, 0x8e,
+	0xd1, 0x94, 0x06, 0xfe, 0x0a, 0x40, 0xb5, 0x26, 0xee, 0xe7, 0x8c, 0xf6, 0xaf, 0xcd, 0xba, 0x6b,
+	0x90, 0xfc, 0xca, 0xaa, 0x73, 0xb6, 0x94, 0x74, 0xcc, 0xa8, 0x1e, 0xc5, 0xb1, 0x61, 0x1a, 0xd6,
+	0xbc, 0xbe, 0x85, 0xf7, 0xe7, 0x4f, 0x8f, 0x02, 0xad, 0xf6, 0xe5, 0x91, 0xf4, 0xde, 0xf9, 0x7b,
+	0x0e, 0x8c, 0xdc, 0xe5, 0xe0, 0x2f, 0x40, 0xe5, 0xe0, 0xf0, 0xb0, 0x56, 0xaf, 0xbb, 0xa7, 0x9f,
+	0x9e, 0xd4, 0xdc, 0x93, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0xf6, 0xa4, 0x56, 0xaf,
+	0x5b, 0x33, 0x95, 0xb7, 0x5e, 0xbd, 0xde, 0x2a, 0x0f, 0xfd, 0x4f, 0xc4, 0x7c, 0x32, 0x16, 0xd2,
+	0x24, 0x12, 0x95, 0xfa, 0x3e, 0xd8, 0x18, 0x8d, 0x46, 0xb5, 0xfa, 0x29, 0x3a, 0x3a, 0x3c, 0xad,
+	0x3d, 0xb2, 0x72, 0x95, 0xf2, 0xab, 0xd7, 0x5b, 0x6b, 0xc3, 0x48, 0x44, 0x18, 0x4f, 0x43, 0xf1,
+	0xb9, 0x08, 0x3e, 0x00, 0xe5, 0xab, 0x39, 0x6b, 0x8f, 0xac, 0xd9, 0x4a, 0xe5, 0xd5, 0xeb, 0xad,
+	0x8d, 0xab, 0x18, 0x89, 0x5f, 0x31, 0xbe, 0xf8, 0xeb, 0xe6, 0x4c, 0xf5, 0x37, 0xdf, 0x9c, 0x6f,
+	0xe6, 0xbe, 0x3b, 0xdf, 0xcc, 0xfd, 0xfb, 0x7c, 0x33, 0xf7, 0xe5, 0x9b, 0xcd, 0x99, 0xef, 0xde,
+	0x6c, 0xce, 0xfc, 0xe3, 0xcd, 0xe6, 0xcc, 0xef, 0x7f, 0x1c, 0x84, 0xbc, 0xd9, 0x6e, 0xec, 0x78,
+	0x34, 0xde, 0x55, 0x1f, 0x31, 0xd4, 0x6f, 0x67, 0xef, 0xae, 0xfe, 0x9c, 0x21, 0xee, 0xaa, 0xac,
+	0xb1, 0x20, 0x3f, 0x9b, 0xdd, 0xff, 0xcf, 0x00, 0xf2, 0x2e, 0x91, 0x94, 0x8f, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1001,6 +1185,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitCodeSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.GasSchedule) > 0 {
+		for iNdEx := len(m.GasSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PrecompileRegistry) > 0 {
+		for iNdEx := len(m.PrecompileRegistry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileRegistry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1051,6 +1273,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpCodeGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpCodeGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpCodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConstantGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OpCode) > 0 {
+		i -= len(m.OpCode)
+		copy(dAtA[i:], m.OpCode)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.OpCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerByteGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.PerByteGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1840,6 +2142,62 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.PrecompileRegistry) > 0 {
+		for _, e := range m.PrecompileRegistry {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.GasSchedule) > 0 {
+		for _, e := range m.GasSchedule {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitCodeSize))
+	}
+	return n
+}
+
+func (m *OpCodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OpCode)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovEvm(uint64(m.ConstantGas))
+	}
+	return n
+}
+
+func (m *PrecompileRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvm(uint64(m.ActivationHeight))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovEvm(uint64(m.BaseGas))
+	}
+	if m.PerByteGas != 0 {
+		n += 1 + sovEvm(uint64(m.PerByteGas))
+	}
 	return n
 }
 
@@ -2325,6 +2683,352 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileRegistry = append(m.PrecompileRegistry, PrecompileRegistration{})
+			if err := m.PrecompileRegistry[len(m.PrecompileRegistry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSchedule = append(m.GasSchedule, OpCodeGas{})
+			if err := m.GasSchedule[len(m.GasSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpCodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpCodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpCodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerByteGas", wireType)
+			}
+			m.PerByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -53,6 +53,13 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
+	DistributeFees(ctx sdk.Context, fees, baseFees sdk.Coins) error
+}
+
+// PaymasterKeeper defines the expected interface needed to refund the
+// leftover gas of sponsored transactions to the paymaster sponsorships.
+type PaymasterKeeper interface {
+	RefundSponsorship(ctx sdk.Context, sender, paymaster common.Address, amount math.Int)
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
@@ -37,6 +37,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
@@ -49,10 +50,11 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -60,6 +62,12 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// TransientFeePayerKey returns the transient key under which the paymaster that
+// paid the fees of the Ethereum transaction with the given hash is stored.
+func TransientFeePayerKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientFeePayer, txHash.Bytes()...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
@@ -51,6 +51,17 @@ var (
 			AccessControlList: DefaultCreateAllowlistAddresses,
 		},
 	}
+	// DefaultMaxCodeSize defines the default maximum size of the deployed contract
+	// code as per EIP-170
+	DefaultMaxCodeSize = uint64(params.MaxCodeSize)
+	// DefaultMaxInitCodeSize defines the default maximum size of the contract
+	// creation code as per EIP-3860
+	DefaultMaxInitCodeSize = 2 * DefaultMaxCodeSize
+	// MaxCodeSizeLimit defines the upper bound of the max code size param
+	MaxCodeSizeLimit = 4 * DefaultMaxCodeSize
+	// MaxInitCodeSizeLimit defines the upper bound of the max initcode size
+	// param
+	MaxInitCodeSizeLimit = 2 * MaxCodeSizeLimit
 )
 
 // NewParams creates a new Params instance
@@ -78,6 +89,8 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		MaxCodeSize:             DefaultMaxCodeSize,
+		MaxInitCodeSize:         DefaultMaxInitCodeSize,
 	}
 }
 
@@ -117,6 +130,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := PrecompileRegistry(p.PrecompileRegistry).Validate(); err != nil {
+		return err
+	}
+
+	if err := validateGasSchedule(p.GasSchedule); err != nil {
+		return err
+	}
+
+	if err := validateCodeSizes(p.MaxCodeSize, p.MaxInitCodeSize); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -127,6 +152,23 @@ func (p Params) EIPs() []string {
 	return eips
 }
 
+// VMGasSchedule returns the gas schedule as the constant gas overrides
+// applied to the EVM jump table.
+//
+// NOTE: the gas schedule is expected to be validated, undefined opcodes
+// are skipped.
+func (p Params) VMGasSchedule() []vm.OpCodeGas {
+	schedule := make([]vm.OpCodeGas, 0, len(p.GasSchedule))
+	for _, opGas := range p.GasSchedule {
+		op, err := vm.ParseOpCode(opGas.OpCode)
+		if err != nil {
+			continue
+		}
+		schedule = append(schedule, vm.OpCodeGas{OpCode: op, ConstantGas: opGas.ConstantGas})
+	}
+	return schedule
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -137,6 +179,36 @@ func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// IsActiveStaticPrecompile returns true if the given precompile address is
+// either contained in the active static precompiles or registered with an
+// activation height lower or equal than the provided one.
+func (p Params) IsActiveStaticPrecompile(address common.Address, height int64) bool {
+	if slices.Contains(p.ActiveStaticPrecompiles, address.String()) {
+		return true
+	}
+
+	registration, found := PrecompileRegistry(p.PrecompileRegistry).Get(address)
+	return found && registration.IsActive(height)
+}
+
+// CodeSizeLimit returns the maximum size of the deployed contract code,
+// defaulting to the EIP-170 limit if unset.
+func (p Params) CodeSizeLimit() uint64 {
+	if p.MaxCodeSize == 0 {
+		return DefaultMaxCodeSize
+	}
+	return p.MaxCodeSize
+}
+
+// InitCodeSizeLimit returns the maximum size of the contract creation code,
+// defaulting to the EIP-3860 limit if unset.
+func (p Params) InitCodeSizeLimit() uint64 {
+	if p.MaxInitCodeSize == 0 {
+		return DefaultMaxInitCodeSize
+	}
+	return p.MaxInitCodeSize
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
@@ -229,6 +301,54 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateGasSchedule(i interface{}) error {
+	schedule, ok := i.([]OpCodeGas)
+	if !ok {
+		return fmt.Errorf("invalid gas schedule type: %T", i)
+	}
+
+	seenOpCodes := make(map[string]struct{})
+	for _, opGas := range schedule {
+		if _, err := vm.ParseOpCode(opGas.OpCode); err != nil {
+			return fmt.Errorf("invalid gas schedule: %w", err)
+		}
+
+		if _, ok := seenOpCodes[opGas.OpCode]; ok {
+			return fmt.Errorf("duplicate opcode in gas schedule: %s", opGas.OpCode)
+		}
+		seenOpCodes[opGas.OpCode] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateCodeSizes(maxCodeSize, maxInitCodeSize uint64) error {
+	if maxCodeSize > MaxCodeSizeLimit {
+		return fmt.Errorf("max code size %d cannot be greater than %d", maxCodeSize, MaxCodeSizeLimit)
+	}
+
+	if maxInitCodeSize > MaxInitCodeSizeLimit {
+		return fmt.Errorf("max initcode size %d cannot be greater than %d", maxInitCodeSize, MaxInitCodeSizeLimit)
+	}
+
+	// zero values fall back to the EIP-170 and EIP-3860 limits
+	if maxCodeSize == 0 {
+		maxCodeSize = DefaultMaxCodeSize
+	}
+	if maxInitCodeSize == 0 {
+		maxInitCodeSize = DefaultMaxInitCodeSize
+	}
+
+	if maxInitCodeSize < maxCodeSize {
+		return fmt.Errorf(
+			"max initcode size %d cannot be lower than the max code size %d",
+			maxInitCodeSize, maxCodeSize,
+		)
+	}
+
+	return nil
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
@@ -45,6 +45,80 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid gas schedule",
+			params: Params{
+				GasSchedule: []OpCodeGas{
+					{OpCode: "ADD", ConstantGas: 5},
+					{OpCode: "CALL", ConstantGas: 200},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid gas schedule opcode",
+			params: Params{
+				GasSchedule: []OpCodeGas{
+					{OpCode: "FOO", ConstantGas: 5_000},
+				},
+			},
+			errContains: "undefined opcode FOO",
+		},
+		{
+			name: "duplicate gas schedule opcode",
+			params: Params{
+				GasSchedule: []OpCodeGas{
+					{OpCode: "SSTORE", ConstantGas: 5_000},
+					{OpCode: "SSTORE", ConstantGas: 1_000},
+				},
+			},
+			errContains: "duplicate opcode in gas schedule",
+		},
+		{
+			name: "valid code size limits",
+			params: Params{
+				MaxCodeSize:     DefaultMaxCodeSize,
+				MaxInitCodeSize: DefaultMaxInitCodeSize,
+			},
+			expPass: true,
+		},
+		{
+			name: "valid default code size limits",
+			params: Params{
+				MaxCodeSize: 2 * DefaultMaxCodeSize,
+			},
+			expPass: true,
+		},
+		{
+			name: "default initcode size lower than code size",
+			params: Params{
+				MaxCodeSize: 3 * DefaultMaxCodeSize,
+			},
+			errContains: "max initcode size 49152 cannot be lower than the max code size 73728",
+		},
+		{
+			name: "code size greater than the limit",
+			params: Params{
+				MaxCodeSize:     MaxCodeSizeLimit + 1,
+				MaxInitCodeSize: MaxInitCodeSizeLimit,
+			},
+			errContains: "max code size 98305 cannot be greater than 98304",
+		},
+		{
+			name: "initcode size greater than the limit",
+			params: Params{
+				MaxInitCodeSize: MaxInitCodeSizeLimit + 1,
+			},
+			errContains: "max initcode size 196609 cannot be greater than 196608",
+		},
+		{
+			name: "initcode size lower than code size",
+			params: Params{
+				MaxCodeSize:     DefaultMaxCodeSize,
+				MaxInitCodeSize: DefaultMaxCodeSize - 1,
+			},
+			errContains: "max initcode size 24575 cannot be lower than the max code size 24576",
+		},
 	}
 
 	for _, tc := range testCases {
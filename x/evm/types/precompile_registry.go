@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/types"
+)
+
+// PrecompileRegistry represents the list of static precompiles that are
+// scheduled for activation together with their gas schedule.
+type PrecompileRegistry []PrecompileRegistration
+
+// Validate performs a stateless validation of the registry entries.
+func (pr PrecompileRegistry) Validate() error {
+	seen := make(map[common.Address]struct{}, len(pr))
+	for _, registration := range pr {
+		if err := registration.Validate(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(registration.Address)
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicate precompile registration %s", registration.Address)
+		}
+		seen[address] = struct{}{}
+	}
+	return nil
+}
+
+// Get returns the registration for the given precompile address.
+func (pr PrecompileRegistry) Get(address common.Address) (PrecompileRegistration, bool) {
+	for _, registration := range pr {
+		if common.HexToAddress(registration.Address) == address {
+			return registration, true
+		}
+	}
+	return PrecompileRegistration{}, false
+}
+
+// Validate performs a stateless validation of the registration fields.
+func (r PrecompileRegistration) Validate() error {
+	if err := types.ValidateAddress(r.Address); err != nil {
+		return fmt.Errorf("invalid precompile registration address %s", r.Address)
+	}
+
+	if r.ActivationHeight < 0 {
+		return fmt.Errorf("activation height cannot be negative: %d", r.ActivationHeight)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the precompile is active at the given block height.
+func (r PrecompileRegistration) IsActive(height int64) bool {
+	return height >= r.ActivationHeight
+}
+
+// HasGasSchedule returns true if the registration overrides the gas required
+// by the precompile implementation.
+func (r PrecompileRegistration) HasGasSchedule() bool {
+	return r.BaseGas != 0 || r.PerByteGas != 0
+}
+
+// RequiredGas returns the gas required to call the precompile with the given
+// input according to the registered gas schedule. The result is capped to
+// the max uint64 value to prevent an overflow.
+func (r PrecompileRegistration) RequiredGas(input []byte) uint64 {
+	inputLen := uint64(len(input))
+	if r.PerByteGas != 0 && inputLen > (math.MaxUint64-r.BaseGas)/r.PerByteGas {
+		return math.MaxUint64
+	}
+	return r.BaseGas + r.PerByteGas*inputLen
+}
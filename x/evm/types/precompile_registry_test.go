@@ -0,0 +1,90 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompileRegistryValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		registry    PrecompileRegistry
+		errContains string
+	}{
+		{
+			name:     "pass - empty registry",
+			registry: PrecompileRegistry{},
+		},
+		{
+			name: "pass - valid registrations",
+			registry: PrecompileRegistry{
+				{Address: SlashingPrecompileAddress, ActivationHeight: 100},
+				{Address: EvidencePrecompileAddress, ActivationHeight: 200, BaseGas: 1_000, PerByteGas: 3},
+			},
+		},
+		{
+			name: "fail - invalid address",
+			registry: PrecompileRegistry{
+				{Address: "0x123", ActivationHeight: 100},
+			},
+			errContains: "invalid precompile registration address",
+		},
+		{
+			name: "fail - negative activation height",
+			registry: PrecompileRegistry{
+				{Address: SlashingPrecompileAddress, ActivationHeight: -1},
+			},
+			errContains: "activation height cannot be negative",
+		},
+		{
+			name: "fail - duplicate registration",
+			registry: PrecompileRegistry{
+				{Address: SlashingPrecompileAddress, ActivationHeight: 100},
+				{Address: SlashingPrecompileAddress, ActivationHeight: 200},
+			},
+			errContains: "duplicate precompile registration",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.registry.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestPrecompileRegistrationRequiredGas(t *testing.T) {
+	registration := PrecompileRegistration{
+		Address:    SlashingPrecompileAddress,
+		BaseGas:    2_000,
+		PerByteGas: 16,
+	}
+	require.True(t, registration.HasGasSchedule())
+	require.Equal(t, uint64(2_000), registration.RequiredGas(nil))
+	require.Equal(t, uint64(2_000+16*4), registration.RequiredGas([]byte{1, 2, 3, 4}))
+
+	registration.BaseGas = math.MaxUint64 - 1
+	require.Equal(t, uint64(math.MaxUint64), registration.RequiredGas([]byte{1}))
+
+	require.False(t, PrecompileRegistration{Address: SlashingPrecompileAddress}.HasGasSchedule())
+}
+
+func TestParamsIsActiveStaticPrecompile(t *testing.T) {
+	params := DefaultParams()
+	params.PrecompileRegistry = []PrecompileRegistration{
+		{Address: SlashingPrecompileAddress, ActivationHeight: 10},
+	}
+
+	require.True(t, params.IsActiveStaticPrecompile(common.HexToAddress(StakingPrecompileAddress), 1))
+	require.False(t, params.IsActiveStaticPrecompile(common.HexToAddress(SlashingPrecompileAddress), 9))
+	require.True(t, params.IsActiveStaticPrecompile(common.HexToAddress(SlashingPrecompileAddress), 10))
+	require.False(t, params.IsActiveStaticPrecompile(common.HexToAddress(EvidencePrecompileAddress), 10))
+}
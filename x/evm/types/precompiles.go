@@ -17,6 +17,12 @@ const (
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	PaymasterPrecompileAddress    = "0x0000000000000000000000000000000000000808"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000809"
+	FeegrantPrecompileAddress     = "0x000000000000000000000000000000000000080A"
+	ICAPrecompileAddress          = "0x000000000000000000000000000000000000080B"
+	ERC20ModulePrecompileAddress  = "0x000000000000000000000000000000000000080C"
+	InflationPrecompileAddress    = "0x000000000000000000000000000000000000080D"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -34,4 +40,10 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	PaymasterPrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+	ICAPrecompileAddress,
+	ERC20ModulePrecompileAddress,
+	InflationPrecompileAddress,
 }
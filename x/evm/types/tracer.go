@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v20/x/evm/core/logger"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/profiler"
 )
 
 const (
@@ -19,6 +20,7 @@ const (
 	TracerJSON       = "json"
 	TracerStruct     = "struct"
 	TracerMarkdown   = "markdown"
+	TracerProfile    = "profile"
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
@@ -39,6 +41,8 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 		return logger.NewMarkdownLogger(logCfg, os.Stdout) // TODO: Stderr ?
 	case TracerStruct:
 		return logger.NewStructLogger(logCfg)
+	case TracerProfile:
+		return profiler.NewTracer(profiler.DefaultProfiler)
 	default:
 		return NewNoOpTracer()
 	}
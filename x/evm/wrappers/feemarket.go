@@ -6,6 +6,7 @@ package wrappers
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -56,3 +57,13 @@ func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	params.MinGasPrice = types.ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
 	return params
 }
+
+// DistributeFees converts the given fees and base fees, in the evm denom with 18
+// decimals, to the bank module decimals and splits them with the feemarket
+// fee split.
+func (w FeeMarketWrapper) DistributeFees(ctx sdk.Context, fees, baseFees *big.Int) error {
+	denom := types.GetEVMCoinDenom()
+	feeCoins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(types.ConvertAmountFrom18DecimalsBigInt(fees))))
+	baseFeeCoins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(types.ConvertAmountFrom18DecimalsBigInt(baseFees))))
+	return w.FeeMarketKeeper.DistributeFees(ctx, feeCoins, baseFeeCoins)
+}
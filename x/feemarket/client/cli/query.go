@@ -3,6 +3,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBaseFeeHistoryCmd(),
+		GetBurnedFeesCmd(),
 	)
 	return cmd
 }
@@ -116,3 +120,78 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBaseFeeHistoryCmd queries the base fee history within an optional height range
+func GetBaseFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history [start-height] [end-height]",
+		Short: "Get the base fee and block gas of the most recent blocks",
+		Long: `Get the base fee and block gas of the most recent blocks kept in the base fee history.
+If the start or end height is not provided or is 0, the range is left open on that side.`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			heights := make([]int64, 2)
+			for i, arg := range args {
+				if heights[i], err = strconv.ParseInt(arg, 10, 64); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBaseFeeHistoryRequest{
+				StartHeight: heights[0],
+				EndHeight:   heights[1],
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.BaseFeeHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "base fee history")
+	return cmd
+}
+
+// GetBurnedFeesCmd queries the cumulative burned transaction fees
+func GetBurnedFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Get the cumulative amount of transaction fees burned by the fee split",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
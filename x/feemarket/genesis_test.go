@@ -0,0 +1,35 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+func TestBaseFeeHistoryGenesis(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	entries := []types.BaseFeeHistoryEntry{
+		{Height: 1, BaseFee: sdkmath.LegacyNewDec(10), GasWanted: 100, GasUsed: 50},
+		{Height: 2, BaseFee: sdkmath.LegacyNewDec(20), GasWanted: 200, GasUsed: 150},
+	}
+	for _, entry := range entries {
+		nw.App.FeeMarketKeeper.SetBaseFeeHistoryEntry(ctx, entry)
+	}
+
+	// the base fee history is exported and imported with the genesis state
+	genesis := feemarket.ExportGenesis(ctx, nw.App.FeeMarketKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, entries, genesis.BaseFeeHistory)
+
+	imported := network.NewUnitTestNetwork()
+	importedCtx := imported.GetContext()
+	feemarket.InitGenesis(importedCtx, imported.App.FeeMarketKeeper, *genesis)
+	require.Equal(t, entries, imported.App.FeeMarketKeeper.GetAllBaseFeeHistory(importedCtx))
+}
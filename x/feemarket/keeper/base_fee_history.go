@@ -0,0 +1,135 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// GetBaseFeeHistoryEntry returns the base fee history entry of the given block
+// height.
+func (k Keeper) GetBaseFeeHistoryEntry(ctx sdk.Context, height int64) (types.BaseFeeHistoryEntry, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	bz := store.Get(types.BaseFeeHistoryKey(height))
+	if len(bz) == 0 {
+		return types.BaseFeeHistoryEntry{}, false
+	}
+
+	var entry types.BaseFeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// SetBaseFeeHistoryEntry stores the given entry in the base fee history.
+func (k Keeper) SetBaseFeeHistoryEntry(ctx sdk.Context, entry types.BaseFeeHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	store.Set(types.BaseFeeHistoryKey(entry.Height), k.cdc.MustMarshal(&entry))
+}
+
+// GetBaseFeeHistory returns the base fee history entries ordered by ascending
+// height. The entries are limited to the given height range, where a zero
+// start or end height leaves the range open on that side.
+func (k Keeper) GetBaseFeeHistory(
+	ctx sdk.Context,
+	startHeight, endHeight int64,
+	pagination *query.PageRequest,
+) ([]types.BaseFeeHistoryEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+
+	entries := []types.BaseFeeHistoryEntry{}
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var entry types.BaseFeeHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if (startHeight > 0 && entry.Height < startHeight) || (endHeight > 0 && entry.Height > endHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			entries = append(entries, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// GetAllBaseFeeHistory returns all the base fee history entries ordered by
+// ascending height.
+func (k Keeper) GetAllBaseFeeHistory(ctx sdk.Context) []types.BaseFeeHistoryEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	entries := []types.BaseFeeHistoryEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BaseFeeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// recordBaseFeeHistory adds the base fee, block gas and block gas limit of the
+// current block to the base fee history and removes the entries that fall out of the window
+// defined by the base fee history length param.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) recordBaseFeeHistory(ctx sdk.Context, gasWanted, gasUsed uint64) {
+	length := k.GetParams(ctx).BaseFeeHistoryLength
+	if length > 0 {
+		baseFee := k.GetBaseFee(ctx)
+		if baseFee.IsNil() {
+			baseFee = math.LegacyZeroDec()
+		}
+
+		// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+		gasLimit := int64(-1)
+		if consParams := ctx.ConsensusParams(); consParams.Block != nil {
+			gasLimit = consParams.Block.MaxGas
+		}
+
+		k.SetBaseFeeHistoryEntry(ctx, types.BaseFeeHistoryEntry{
+			Height:    ctx.BlockHeight(),
+			BaseFee:   baseFee,
+			GasWanted: gasWanted,
+			GasUsed:   gasUsed,
+			GasLimit:  gasLimit,
+		})
+	}
+
+	height := ctx.BlockHeight()
+	if height > 0 && length < uint64(height) {
+		k.pruneBaseFeeHistory(ctx, height-int64(length)) //nolint:gosec // G115 -- length is lower than the block height
+	}
+}
+
+// pruneBaseFeeHistory deletes the base fee history entries with a height lower
+// than or equal to the given height. Entries are pruned from the oldest one,
+// so that shrinking the history length removes all the entries out of the
+// window.
+func (k Keeper) pruneBaseFeeHistory(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(types.BaseFeeHistoryKey(height)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
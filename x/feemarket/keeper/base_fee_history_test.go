@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseFeeHistory(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	qc := nw.GetFeeMarketClient()
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultBaseFeeHistoryLength, params.BaseFeeHistoryLength)
+	params.BaseFeeHistoryLength = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	maxGas := ctx.ConsensusParams().Block.MaxGas
+
+	// end blocks 100 to 104 with increasing gas used
+	for height := int64(100); height <= 104; height++ {
+		meter := storetypes.NewGasMeter(uint64(1000000000))
+		meter.ConsumeGas(uint64(height), "test")
+		blockCtx := ctx.WithBlockHeight(height).WithBlockGasMeter(meter)
+		k.SetBaseFee(blockCtx, sdkmath.LegacyNewDec(height))
+		require.NoError(t, k.EndBlock(blockCtx))
+	}
+
+	// only the last 3 blocks are kept
+	entries, _, err := k.GetBaseFeeHistory(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		height := int64(102 + i)
+		require.Equal(t, height, entry.Height)
+		require.Equal(t, sdkmath.LegacyNewDec(height), entry.BaseFee)
+		require.Equal(t, uint64(height), entry.GasUsed)
+		require.Equal(t, uint64(height), entry.GasWanted)
+		require.Equal(t, maxGas, entry.GasLimit)
+	}
+
+	_, found := k.GetBaseFeeHistoryEntry(ctx, 101)
+	require.False(t, found)
+
+	// query within a height range
+	res, err := qc.BaseFeeHistory(ctx, &types.QueryBaseFeeHistoryRequest{StartHeight: 103, EndHeight: 103})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, int64(103), res.Entries[0].Height)
+
+	res, err = qc.BaseFeeHistory(ctx, &types.QueryBaseFeeHistoryRequest{
+		StartHeight: 103,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, int64(103), res.Entries[0].Height)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	_, err = qc.BaseFeeHistory(ctx, &types.QueryBaseFeeHistoryRequest{StartHeight: 104, EndHeight: 103})
+	require.ErrorContains(t, err, "start height 104 is greater than end height 103")
+
+	_, err = qc.BaseFeeHistory(ctx, &types.QueryBaseFeeHistoryRequest{StartHeight: -1})
+	require.ErrorContains(t, err, "heights cannot be negative")
+
+	// shrinking the history length prunes the entries out of the window
+	params.BaseFeeHistoryLength = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	blockCtx := ctx.WithBlockHeight(105).WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+	require.NoError(t, k.EndBlock(blockCtx))
+
+	entries, _, err = k.GetBaseFeeHistory(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, int64(105), entries[0].Height)
+
+	// a zero history length disables the history
+	params.BaseFeeHistoryLength = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	blockCtx = ctx.WithBlockHeight(106).WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+	require.NoError(t, k.EndBlock(blockCtx))
+
+	entries, _, err = k.GetBaseFeeHistory(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,165 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// IsFeeSplitEnabled returns true if the fee split params are set.
+func (k Keeper) IsFeeSplitEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FeeSplit != nil
+}
+
+// DistributeFees splits the fees of a transaction, held by the fee collector
+// module account, according to the fee split params. The base fees are the
+// part of the fees covering the base fee and the rest of the fees are tips.
+// The burned fees are added to the cumulative burned fees and the fees not
+// assigned by the fee split stay in the fee collector, to be distributed by
+// x/distribution. It is a no-op if the fee split is not enabled.
+func (k Keeper) DistributeFees(ctx sdk.Context, fees, baseFees sdk.Coins) error {
+	feeSplit := k.GetParams(ctx).FeeSplit
+	if feeSplit == nil {
+		return nil
+	}
+
+	// NOTE: the fee split is not charged to the transaction since the block
+	// proposer is unknown when the gas is estimated by simulating it
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// NOTE: the fee collector pools the fees of all the transactions so the
+	// fees cannot exceed its balance
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	available := sdk.Coins{}
+	for _, fee := range fees {
+		balance := k.bankKeeper.GetBalance(ctx, feeCollector, fee.Denom)
+		if balance.IsPositive() {
+			available = available.Add(sdk.NewCoin(fee.Denom, math.MinInt(fee.Amount, balance.Amount)))
+		}
+	}
+
+	if available.IsZero() {
+		return nil
+	}
+
+	burn, proposerTip, communityPoolTip, moduleTip := feeSplit.Split(available, baseFees)
+
+	if !burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, burn); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn fees %s", burn)
+		}
+		k.addBurnedFees(ctx, burn)
+	}
+
+	// the proposer tip is not sent if the proposer is unknown, e.g. when
+	// simulating a transaction
+	proposer := k.getProposerAccount(ctx)
+	if proposer == nil {
+		proposerTip = sdk.Coins{}
+	}
+
+	if !proposerTip.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer, proposerTip); err != nil {
+			return errorsmod.Wrapf(err, "failed to send tip %s to proposer %s", proposerTip, proposer)
+		}
+	}
+
+	if !communityPoolTip.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolTip, feeCollector); err != nil {
+			return errorsmod.Wrapf(err, "failed to fund community pool with tip %s", communityPoolTip)
+		}
+	}
+
+	// the tip module account is checked on params update but the module
+	// account could be removed by a later upgrade
+	if k.accountKeeper.GetModuleAddress(feeSplit.TipModule) == nil {
+		moduleTip = sdk.Coins{}
+	}
+
+	if !moduleTip.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feeSplit.TipModule, moduleTip); err != nil {
+			return errorsmod.Wrapf(err, "failed to send tip %s to module %s", moduleTip, feeSplit.TipModule)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyBurned, burn.String()),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyProposerTip, proposerTip.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolTip, communityPoolTip.String()),
+			sdk.NewAttribute(types.AttributeKeyTipModule, feeSplit.TipModule),
+			sdk.NewAttribute(types.AttributeKeyTipModuleTip, moduleTip.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetBurnedFees returns the cumulative fees burned by the fee split.
+func (k Keeper) GetBurnedFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return burned
+}
+
+// addBurnedFees adds the given coins to the cumulative burned fees.
+func (k Keeper) addBurnedFees(ctx sdk.Context, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurnedFees)
+	for _, coin := range coins {
+		amount := coin.Amount
+		if bz := store.Get([]byte(coin.Denom)); bz != nil {
+			var burned math.Int
+			if err := burned.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			amount = amount.Add(burned)
+		}
+
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// getProposerAccount returns the account of the operator of the current block
+// proposer or nil if the proposer is not found.
+func (k Keeper) getProposerAccount(ctx sdk.Context) sdk.AccAddress {
+	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	if consAddr.Empty() {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return nil
+	}
+
+	return sdk.AccAddress(valAddr)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistributeFees(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	bk := nw.App.BankKeeper
+	denom := nw.GetBaseDenom()
+
+	feeSplit := types.NewFeeSplit(
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyNewDecWithPrec(25, 2),
+		sdkmath.LegacyNewDecWithPrec(1, 1),
+		erc20types.ModuleName,
+	)
+	params := k.GetParams(ctx)
+	params.FeeSplit = &feeSplit
+	require.NoError(t, k.SetParams(ctx, params))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(t, bk.MintCoins(ctx, inflationtypes.ModuleName, fees))
+	require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, inflationtypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	feeCollector := nw.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	erc20Module := nw.App.AccountKeeper.GetModuleAddress(erc20types.ModuleName)
+	supply := bk.GetSupply(ctx, denom)
+	communityPool, err := nw.App.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	validator := nw.GetValidators()[0]
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	proposer := sdk.AccAddress(valAddr)
+	proposerBalance := bk.GetBalance(ctx, proposer, denom)
+
+	ctx = ctx.WithProposer(consAddr)
+	require.NoError(t, k.DistributeFees(ctx, fees, sdk.NewCoins(sdk.NewInt64Coin(denom, 600))))
+
+	// half of the base fees is burned
+	require.Equal(t, supply.Amount.SubRaw(300), bk.GetSupply(ctx, denom).Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 300)), k.GetBurnedFees(ctx))
+
+	// the tips are split between the proposer, the community pool and the tip module
+	require.Equal(t, proposerBalance.Amount.AddRaw(200), bk.GetBalance(ctx, proposer, denom).Amount)
+	newCommunityPool, err := nw.App.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		communityPool.CommunityPool.AmountOf(denom).Add(sdkmath.LegacyNewDec(100)),
+		newCommunityPool.CommunityPool.AmountOf(denom),
+	)
+	require.Equal(t, int64(40), bk.GetBalance(ctx, erc20Module, denom).Amount.Int64())
+
+	// the rest stays in the fee collector
+	require.Equal(t, int64(360), bk.GetBalance(ctx, feeCollector, denom).Amount.Int64())
+
+	// fees are capped to the fee collector balance and burned fees accumulate
+	require.NoError(t, k.DistributeFees(ctx, fees, fees))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 480)), k.GetBurnedFees(ctx))
+
+	res, err := nw.GetFeeMarketClient().BurnedFees(ctx, &types.QueryBurnedFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 480)), res.Burned)
+}
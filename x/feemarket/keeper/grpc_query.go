@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -48,3 +50,38 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BaseFeeHistory implements the Query/BaseFeeHistory gRPC method
+func (k Keeper) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+
+	if req.EndHeight > 0 && req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is greater than end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries, pageRes, err := k.GetBaseFeeHistory(ctx, req.StartHeight, req.EndHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBaseFeeHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BurnedFees implements the Query/BurnedFees gRPC method
+func (k Keeper) BurnedFees(c context.Context, _ *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedFeesResponse{
+		Burned: k.GetBurnedFees(ctx),
+	}, nil
+}
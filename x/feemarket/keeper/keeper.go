@@ -28,11 +28,18 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper, sk types.StakingKeeper,
+	ss paramstypes.Subspace,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -40,11 +47,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		authority:    authority,
-		transientKey: transientKey,
-		ss:           ss,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		authority:     authority,
+		transientKey:  transientKey,
+		ss:            ss,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		stakingKeeper: sk,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v4 "github.com/evmos/evmos/v20/x/feemarket/migrations/v4"
 	v5 "github.com/evmos/evmos/v20/x/feemarket/migrations/v5"
+	v6 "github.com/evmos/evmos/v20/x/feemarket/migrations/v6"
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
@@ -32,3 +33,8 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
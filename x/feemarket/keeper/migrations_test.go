@@ -37,6 +37,10 @@ func TestMigrations(t *testing.T) {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate5to6",
+			migrator.Migrate5to6,
+		},
 	}
 
 	for _, tc := range testCases {
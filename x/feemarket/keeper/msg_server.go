@@ -7,6 +7,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -21,6 +22,13 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the tip module must be a registered module account to receive tips
+	feeSplit := req.Params.FeeSplit
+	if feeSplit != nil && feeSplit.TipModuleShare.IsPositive() && k.accountKeeper.GetModuleAddress(feeSplit.TipModule) == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "module account %s does not exist", feeSplit.TipModule)
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 5 to
+// version 6. Specifically, it sets the base fee history length, which is decoded as 0
+// from the params stored by the previous version, to its default value.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	paramsBz := store.Get(types.ParamsKey)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.BaseFeeHistoryLength = types.DefaultBaseFeeHistoryLength
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v6_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/evmos/evmos/v20/encoding"
+	v6 "github.com/evmos/evmos/v20/x/feemarket/migrations/v6"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	kvStore := ctx.KVStore(storeKey)
+
+	// the params stored by the previous version have no base fee history length
+	v5Params := types.DefaultParams()
+	v5Params.BaseFeeHistoryLength = 0
+
+	v5ParamsBz, err := cdc.Marshal(&v5Params)
+	require.NoError(t, err)
+
+	kvStore.Set(types.ParamsKey, v5ParamsBz)
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	paramsBz := kvStore.Get(types.ParamsKey)
+	var migratedParams types.Params
+	cdc.MustUnmarshal(paramsBz, &migratedParams)
+
+	expParams := v5Params
+	expParams.BaseFeeHistoryLength = types.DefaultBaseFeeHistoryLength
+	require.Equal(t, expParams, migratedParams)
+}
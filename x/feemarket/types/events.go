@@ -5,6 +5,13 @@ package types
 // feemarket module events
 const (
 	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeSplit  = "fee_split"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee          = "base_fee"
+	AttributeKeyBurned           = "burned"
+	AttributeKeyProposer         = "proposer"
+	AttributeKeyProposerTip      = "proposer_tip"
+	AttributeKeyCommunityPoolTip = "community_pool_tip"
+	AttributeKeyTipModule        = "tip_module"
+	AttributeKeyTipModuleTip     = "tip_module_tip"
 )
@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeLane creates a new FeeLane instance
+func NewFeeLane(
+	name string,
+	msgTypeURLs []string,
+	evmAddressClasses []EVMAddressClass,
+	minGasPriceMultiplier sdkmath.LegacyDec,
+	priority int64,
+) FeeLane {
+	return FeeLane{
+		Name:                  name,
+		MsgTypeUrls:           msgTypeURLs,
+		EvmAddressClasses:     evmAddressClasses,
+		MinGasPriceMultiplier: minGasPriceMultiplier,
+		Priority:              priority,
+	}
+}
+
+// MinGasPrice returns the min gas price required from the transactions of the
+// lane given the global min gas price.
+func (l FeeLane) MinGasPrice(minGasPrice sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return minGasPrice.Mul(l.MinGasPriceMultiplier)
+}
+
+// BaseFee returns the base fee required from the transactions of the lane
+// given the block base fee.
+func (l FeeLane) BaseFee(baseFee sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return baseFee.Mul(l.MinGasPriceMultiplier)
+}
+
+// ApplyPriority adds the lane priority to the given fee based priority,
+// capped at the max int64 value.
+func (l FeeLane) ApplyPriority(priority int64) int64 {
+	if priority > math.MaxInt64-l.Priority {
+		return math.MaxInt64
+	}
+	return priority + l.Priority
+}
+
+// Validate performs a stateless validation of the fee lane.
+func (l FeeLane) Validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return fmt.Errorf("fee lane name cannot be blank")
+	}
+
+	if len(l.MsgTypeUrls) == 0 && len(l.EvmAddressClasses) == 0 {
+		return fmt.Errorf("fee lane %s must match at least one msg type URL or EVM address class", l.Name)
+	}
+
+	for _, typeURL := range l.MsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid msg type URL %q in fee lane %s", typeURL, l.Name)
+		}
+	}
+
+	for _, class := range l.EvmAddressClasses {
+		if _, ok := EVMAddressClass_name[int32(class)]; !ok || class == EVM_ADDRESS_CLASS_UNSPECIFIED {
+			return fmt.Errorf("invalid EVM address class %s in fee lane %s", class, l.Name)
+		}
+	}
+
+	if l.MinGasPriceMultiplier.IsNil() || l.MinGasPriceMultiplier.IsNegative() {
+		return fmt.Errorf("invalid min gas price multiplier %s in fee lane %s", l.MinGasPriceMultiplier, l.Name)
+	}
+
+	if l.Priority < 0 {
+		return fmt.Errorf("priority cannot be negative in fee lane %s: %d", l.Name, l.Priority)
+	}
+
+	return nil
+}
+
+// FeeLaneForMsgs returns the fee lane that lists the type URLs of all the
+// given Cosmos messages.
+func (p Params) FeeLaneForMsgs(msgs []sdk.Msg) (FeeLane, bool) {
+	if len(msgs) == 0 {
+		return FeeLane{}, false
+	}
+
+	for _, lane := range p.FeeLanes {
+		matched := true
+		for _, msg := range msgs {
+			if !slices.Contains(lane.MsgTypeUrls, sdk.MsgTypeURL(msg)) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return lane, true
+		}
+	}
+
+	return FeeLane{}, false
+}
+
+// FeeLaneForEVMAddressClass returns the fee lane that lists the given class of
+// Ethereum transaction `to` address.
+func (p Params) FeeLaneForEVMAddressClass(class EVMAddressClass) (FeeLane, bool) {
+	for _, lane := range p.FeeLanes {
+		if slices.Contains(lane.EvmAddressClasses, class) {
+			return lane, true
+		}
+	}
+
+	return FeeLane{}, false
+}
+
+func validateFeeLanes(i interface{}) error {
+	lanes, ok := i.([]FeeLane)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool, len(lanes))
+	typeURLs := make(map[string]bool)
+	classes := make(map[EVMAddressClass]bool)
+
+	for _, lane := range lanes {
+		if err := lane.Validate(); err != nil {
+			return err
+		}
+
+		if names[lane.Name] {
+			return fmt.Errorf("duplicate fee lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+
+		for _, typeURL := range lane.MsgTypeUrls {
+			if typeURLs[typeURL] {
+				return fmt.Errorf("msg type URL %s is matched by more than one fee lane", typeURL)
+			}
+			typeURLs[typeURL] = true
+		}
+
+		for _, class := range lane.EvmAddressClasses {
+			if classes[class] {
+				return fmt.Errorf("EVM address class %s is matched by more than one fee lane", class)
+			}
+			classes[class] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *ParamsTestSuite) TestFeeLanesValidate() {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	one := sdkmath.LegacyOneDec()
+
+	testCases := []struct {
+		name     string
+		lanes    []FeeLane
+		expError bool
+	}{
+		{"no lanes", nil, false},
+		{
+			"valid",
+			[]FeeLane{
+				NewFeeLane("bank", []string{sendURL}, nil, sdkmath.LegacyNewDecWithPrec(5, 1), 10),
+				NewFeeLane("calls", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_CONTRACT, EVM_ADDRESS_CLASS_PRECOMPILE}, one, 0),
+			},
+			false,
+		},
+		{"blank name", []FeeLane{NewFeeLane(" ", []string{sendURL}, nil, one, 0)}, true},
+		{"no msg type URL nor EVM address class", []FeeLane{NewFeeLane("bank", nil, nil, one, 0)}, true},
+		{"invalid msg type URL", []FeeLane{NewFeeLane("bank", []string{"MsgSend"}, nil, one, 0)}, true},
+		{"unspecified EVM address class", []FeeLane{NewFeeLane("calls", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_UNSPECIFIED}, one, 0)}, true},
+		{"unknown EVM address class", []FeeLane{NewFeeLane("calls", nil, []EVMAddressClass{10}, one, 0)}, true},
+		{"nil multiplier", []FeeLane{NewFeeLane("bank", []string{sendURL}, nil, sdkmath.LegacyDec{}, 0)}, true},
+		{"negative multiplier", []FeeLane{NewFeeLane("bank", []string{sendURL}, nil, sdkmath.LegacyNewDec(-1), 0)}, true},
+		{"negative priority", []FeeLane{NewFeeLane("bank", []string{sendURL}, nil, one, -1)}, true},
+		{
+			"duplicate lane name",
+			[]FeeLane{
+				NewFeeLane("bank", []string{sendURL}, nil, one, 0),
+				NewFeeLane("bank", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_ACCOUNT}, one, 0),
+			},
+			true,
+		},
+		{
+			"msg type URL in two lanes",
+			[]FeeLane{
+				NewFeeLane("bank", []string{sendURL}, nil, one, 0),
+				NewFeeLane("send", []string{sendURL}, nil, one, 0),
+			},
+			true,
+		},
+		{
+			"EVM address class in two lanes",
+			[]FeeLane{
+				NewFeeLane("calls", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_CONTRACT}, one, 0),
+				NewFeeLane("contracts", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_CONTRACT}, one, 0),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.FeeLanes = tc.lanes
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestFeeLaneMatching() {
+	params := DefaultParams()
+	params.FeeLanes = []FeeLane{
+		NewFeeLane("bank", []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, nil, sdkmath.LegacyNewDecWithPrec(5, 1), 10),
+		NewFeeLane("calls", nil, []EVMAddressClass{EVM_ADDRESS_CLASS_CONTRACT}, sdkmath.LegacyNewDec(2), 20),
+	}
+
+	lane, found := params.FeeLaneForMsgs([]sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}})
+	suite.Require().True(found)
+	suite.Require().Equal("bank", lane.Name)
+
+	// all the messages must be listed by the lane
+	_, found = params.FeeLaneForMsgs([]sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgUpdateParams{}})
+	suite.Require().False(found)
+
+	_, found = params.FeeLaneForMsgs(nil)
+	suite.Require().False(found)
+
+	lane, found = params.FeeLaneForEVMAddressClass(EVM_ADDRESS_CLASS_CONTRACT)
+	suite.Require().True(found)
+	suite.Require().Equal("calls", lane.Name)
+	suite.Require().Equal(sdkmath.LegacyNewDec(20), lane.MinGasPrice(sdkmath.LegacyNewDec(10)))
+	suite.Require().Equal(sdkmath.LegacyNewDec(40), lane.BaseFee(sdkmath.LegacyNewDec(20)))
+	suite.Require().Equal(int64(25), lane.ApplyPriority(5))
+	suite.Require().Equal(int64(math.MaxInt64), lane.ApplyPriority(math.MaxInt64-1))
+
+	_, found = params.FeeLaneForEVMAddressClass(EVM_ADDRESS_CLASS_ACCOUNT)
+	suite.Require().False(found)
+}
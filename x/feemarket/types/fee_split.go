@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeSplit creates a new FeeSplit instance
+func NewFeeSplit(
+	baseFeeBurnShare, tipProposerShare, tipCommunityPoolShare, tipModuleShare math.LegacyDec,
+	tipModule string,
+) FeeSplit {
+	return FeeSplit{
+		BaseFeeBurnShare:      baseFeeBurnShare,
+		TipProposerShare:      tipProposerShare,
+		TipCommunityPoolShare: tipCommunityPoolShare,
+		TipModuleShare:        tipModuleShare,
+		TipModule:             tipModule,
+	}
+}
+
+// Validate performs a stateless validation of the fee split shares.
+func (fs FeeSplit) Validate() error {
+	shares := []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"base fee burn", fs.BaseFeeBurnShare},
+		{"tip proposer", fs.TipProposerShare},
+		{"tip community pool", fs.TipCommunityPoolShare},
+		{"tip module", fs.TipModuleShare},
+	}
+
+	for _, share := range shares {
+		if share.value.IsNil() {
+			return fmt.Errorf("%s share cannot be nil", share.name)
+		}
+
+		if share.value.IsNegative() || share.value.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s share must be between 0 and 1: %s", share.name, share.value)
+		}
+	}
+
+	tipShares := fs.TipProposerShare.Add(fs.TipCommunityPoolShare).Add(fs.TipModuleShare)
+	if tipShares.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of the tip shares cannot be greater than 1: %s", tipShares)
+	}
+
+	if fs.TipModuleShare.IsPositive() && strings.TrimSpace(fs.TipModule) == "" {
+		return fmt.Errorf("tip module cannot be blank when the tip module share is positive")
+	}
+
+	return nil
+}
+
+// Split splits the given transaction fees, of which the base fees are the part
+// covering the base fee, and returns the fees to burn and the tips to send to
+// the block proposer, the community pool and the tip module. The base fees are
+// capped to the fees and the fees not returned in any of the amounts are left
+// unassigned.
+func (fs FeeSplit) Split(fees, baseFees sdk.Coins) (burn, proposer, communityPool, module sdk.Coins) {
+	baseFees = baseFees.Min(fees)
+	tips := fees.Sub(baseFees...)
+
+	return mulCoins(baseFees, fs.BaseFeeBurnShare),
+		mulCoins(tips, fs.TipProposerShare),
+		mulCoins(tips, fs.TipCommunityPoolShare),
+		mulCoins(tips, fs.TipModuleShare)
+}
+
+// mulCoins multiplies the amount of each coin by the given share, truncating
+// the result.
+func mulCoins(coins sdk.Coins, share math.LegacyDec) sdk.Coins {
+	res := sdk.Coins{}
+	for _, coin := range coins {
+		amount := share.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			res = res.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return res
+}
+
+func validateFeeSplit(i interface{}) error {
+	feeSplit, ok := i.(*FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if feeSplit == nil {
+		return nil
+	}
+
+	return feeSplit.Validate()
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *ParamsTestSuite) TestFeeSplitValidate() {
+	zero := sdkmath.LegacyZeroDec()
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+	one := sdkmath.LegacyOneDec()
+
+	testCases := []struct {
+		name     string
+		feeSplit FeeSplit
+		expError bool
+	}{
+		{"burn all base fees", NewFeeSplit(one, zero, zero, zero, ""), false},
+		{"valid", NewFeeSplit(half, half, sdkmath.LegacyNewDecWithPrec(3, 1), sdkmath.LegacyNewDecWithPrec(2, 1), "erc20"), false},
+		{"nil share", NewFeeSplit(sdkmath.LegacyDec{}, zero, zero, zero, ""), true},
+		{"negative share", NewFeeSplit(one, sdkmath.LegacyNewDec(-1), zero, zero, ""), true},
+		{"share greater than 1", NewFeeSplit(sdkmath.LegacyNewDec(2), zero, zero, zero, ""), true},
+		{"tip shares greater than 1", NewFeeSplit(one, half, half, sdkmath.LegacyNewDecWithPrec(1, 1), "erc20"), true},
+		{"blank tip module", NewFeeSplit(one, zero, zero, half, " "), true},
+		{"blank tip module with zero share", NewFeeSplit(one, zero, zero, zero, ""), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.feeSplit.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	// the fee split is disabled by default
+	params := DefaultParams()
+	suite.Require().Nil(params.FeeSplit)
+	suite.Require().NoError(params.Validate())
+
+	invalid := NewFeeSplit(sdkmath.LegacyNewDec(2), zero, zero, zero, "")
+	params.FeeSplit = &invalid
+	suite.Require().Error(params.Validate())
+}
+
+func (suite *ParamsTestSuite) TestFeeSplitSplit() {
+	feeSplit := NewFeeSplit(
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyNewDecWithPrec(25, 2),
+		sdkmath.LegacyNewDecWithPrec(1, 1),
+		"erc20",
+	)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000), sdk.NewInt64Coin("uatom", 99))
+	baseFees := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 600))
+
+	burn, proposer, communityPool, module := feeSplit.Split(fees, baseFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 300)), burn)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 200), sdk.NewInt64Coin("uatom", 49)), proposer)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100), sdk.NewInt64Coin("uatom", 24)), communityPool)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 40), sdk.NewInt64Coin("uatom", 9)), module)
+
+	// base fees are capped to the fees
+	burn, proposer, _, _ = feeSplit.Split(fees, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 5000)))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 500)), burn)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 49)), proposer)
+}
@@ -25,6 +25,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EVMAddressClass enumerates the classes of the `to` address of an Ethereum
+// transaction.
+type EVMAddressClass int32
+
+const (
+	// EVM_ADDRESS_CLASS_UNSPECIFIED defines an invalid/undefined class.
+	EVM_ADDRESS_CLASS_UNSPECIFIED EVMAddressClass = 0
+	// EVM_ADDRESS_CLASS_CONTRACT_CREATION - the transaction has no `to` address.
+	EVM_ADDRESS_CLASS_CONTRACT_CREATION EVMAddressClass = 1
+	// EVM_ADDRESS_CLASS_ACCOUNT - the `to` address is an externally owned account.
+	EVM_ADDRESS_CLASS_ACCOUNT EVMAddressClass = 2
+	// EVM_ADDRESS_CLASS_CONTRACT - the `to` address is a contract with code.
+	EVM_ADDRESS_CLASS_CONTRACT EVMAddressClass = 3
+	// EVM_ADDRESS_CLASS_PRECOMPILE - the `to` address is an active static precompile.
+	EVM_ADDRESS_CLASS_PRECOMPILE EVMAddressClass = 4
+)
+
+var EVMAddressClass_name = map[int32]string{
+	0: "EVM_ADDRESS_CLASS_UNSPECIFIED",
+	1: "EVM_ADDRESS_CLASS_CONTRACT_CREATION",
+	2: "EVM_ADDRESS_CLASS_ACCOUNT",
+	3: "EVM_ADDRESS_CLASS_CONTRACT",
+	4: "EVM_ADDRESS_CLASS_PRECOMPILE",
+}
+
+var EVMAddressClass_value = map[string]int32{
+	"EVM_ADDRESS_CLASS_UNSPECIFIED":       0,
+	"EVM_ADDRESS_CLASS_CONTRACT_CREATION": 1,
+	"EVM_ADDRESS_CLASS_ACCOUNT":           2,
+	"EVM_ADDRESS_CLASS_CONTRACT":          3,
+	"EVM_ADDRESS_CLASS_PRECOMPILE":        4,
+}
+
+func (x EVMAddressClass) String() string {
+	return proto.EnumName(EVMAddressClass_name, int32(x))
+}
+
+func (EVMAddressClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +85,18 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_history_length defines the number of most recent blocks for which
+	// the base fee and block gas are kept in the base fee history. A length of 0
+	// disables the history.
+	BaseFeeHistoryLength uint64 `protobuf:"varint,9,opt,name=base_fee_history_length,json=baseFeeHistoryLength,proto3" json:"base_fee_history_length,omitempty"`
+	// fee_lanes defines the min gas price multiplier and mempool priority of the
+	// transactions matched by a Cosmos msg type URL or an EVM `to` address class.
+	FeeLanes []FeeLane `protobuf:"bytes,10,rep,name=fee_lanes,json=feeLanes,proto3" json:"fee_lanes"`
+	// fee_split defines how the fees paid by EVM and Cosmos transactions are
+	// burned and distributed. If it is not set, the fees of Cosmos transactions
+	// are burned and the fees of EVM transactions are distributed by
+	// x/distribution.
+	FeeSplit *FeeSplit `protobuf:"bytes,11,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +160,176 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeHistoryLength() uint64 {
+	if m != nil {
+		return m.BaseFeeHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetFeeLanes() []FeeLane {
+	if m != nil {
+		return m.FeeLanes
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSplit() *FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return nil
+}
+
+// FeeLane defines the min gas price multiplier and mempool priority of the
+// transactions matched by the lane.
+type FeeLane struct {
+	// name identifies the fee lane
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls are the Cosmos msg type URLs matched by the lane. A Cosmos
+	// transaction is matched when all its messages are listed.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// evm_address_classes are the classes of the `to` address of the Ethereum
+	// transactions matched by the lane.
+	EvmAddressClasses []EVMAddressClass `protobuf:"varint,3,rep,packed,name=evm_address_classes,json=evmAddressClasses,proto3,enum=ethermint.feemarket.v1.EVMAddressClass" json:"evm_address_classes,omitempty"`
+	// min_gas_price_multiplier multiplies the min gas price and the base fee
+	// required from the transactions of the lane. Ethereum transactions still
+	// pay at least the block base fee.
+	MinGasPriceMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_gas_price_multiplier,json=minGasPriceMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_multiplier"`
+	// priority is added to the fee based mempool priority of the transactions
+	// of the lane
+	Priority int64 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *FeeLane) Reset()         { *m = FeeLane{} }
+func (m *FeeLane) String() string { return proto.CompactTextString(m) }
+func (*FeeLane) ProtoMessage()    {}
+func (*FeeLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeLane.Merge(m, src)
+}
+func (m *FeeLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeLane proto.InternalMessageInfo
+
+func (m *FeeLane) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeLane) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *FeeLane) GetEvmAddressClasses() []EVMAddressClass {
+	if m != nil {
+		return m.EvmAddressClasses
+	}
+	return nil
+}
+
+func (m *FeeLane) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// FeeSplit defines the share of the base fee part of the transaction fees that
+// is burned and how the tip part, paid on top of the base fee, is split between
+// the block proposer, the community pool and a module account. The tip part not
+// assigned by any share stays in the fee collector and is distributed by
+// x/distribution.
+type FeeSplit struct {
+	// base_fee_burn_share is the share of the base fee part that is burned. The
+	// rest stays in the fee collector.
+	BaseFeeBurnShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee_burn_share,json=baseFeeBurnShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_share"`
+	// tip_proposer_share is the share of the tip part sent to the operator of
+	// the block proposer
+	TipProposerShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=tip_proposer_share,json=tipProposerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_proposer_share"`
+	// tip_community_pool_share is the share of the tip part sent to the
+	// community pool
+	TipCommunityPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=tip_community_pool_share,json=tipCommunityPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_community_pool_share"`
+	// tip_module_share is the share of the tip part sent to the tip_module
+	// module account
+	TipModuleShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=tip_module_share,json=tipModuleShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_module_share"`
+	// tip_module is the name of the module account receiving the
+	// tip_module_share of the tip part
+	TipModule string `protobuf:"bytes,5,opt,name=tip_module,json=tipModule,proto3" json:"tip_module,omitempty"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+func (m *FeeSplit) GetTipModule() string {
+	if m != nil {
+		return m.TipModule
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.EVMAddressClass", EVMAddressClass_name, EVMAddressClass_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeLane)(nil), "ethermint.feemarket.v1.FeeLane")
+	proto.RegisterType((*FeeSplit)(nil), "ethermint.feemarket.v1.FeeSplit")
 }
 
 func init() {
@@ -116,34 +337,61 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x5d, 0xb3, 0xed, 0x76, 0xeb, 0xb2, 0xd2, 0x62, 0x15, 0x14, 0xb5, 0x22, 0x5d, 0x81, 0x84,
-	0xa2, 0x0a, 0x25, 0x94, 0xde, 0x90, 0xb8, 0x6c, 0xab, 0x82, 0x50, 0x91, 0xaa, 0x1c, 0x38, 0x70,
-	0xb1, 0x9c, 0x74, 0x9a, 0x8c, 0x1a, 0xdb, 0xab, 0xd8, 0x8d, 0xd8, 0x5f, 0xe0, 0xc4, 0x67, 0x70,
-	0xec, 0x67, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xcd, 0xa1, 0xbf, 0x81, 0x1a, 0xd3, 0xa4, 0x1c,
-	0xf7, 0x32, 0x1a, 0xbf, 0xf7, 0xe6, 0x79, 0xec, 0x19, 0xfa, 0x0a, 0x6c, 0x0e, 0xa5, 0x44, 0x65,
-	0xa3, 0x33, 0x00, 0x29, 0xca, 0x73, 0xb0, 0x51, 0xb5, 0xd7, 0x1d, 0xc2, 0x59, 0xa9, 0xad, 0x66,
-	0xcf, 0x5a, 0x5d, 0xd8, 0x51, 0xd5, 0xde, 0xd6, 0x13, 0x21, 0x51, 0xe9, 0xa8, 0x89, 0x4e, 0xba,
-	0xb5, 0x99, 0xe9, 0x4c, 0x37, 0x69, 0x74, 0x97, 0x39, 0xf4, 0x45, 0xdd, 0xa7, 0x83, 0x13, 0x51,
-	0x0a, 0x69, 0x98, 0x4f, 0x37, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0xcf, 0x00, 0x3c, 0x32, 0x21, 0xc1,
-	0x30, 0x5e, 0x57, 0x7a, 0x2a, 0x0c, 0x1c, 0x01, 0xb0, 0xf7, 0x74, 0xfb, 0x9e, 0xe4, 0x69, 0x2e,
-	0x54, 0x06, 0xfc, 0x14, 0x94, 0x96, 0xa8, 0x84, 0xd5, 0xa5, 0xf7, 0x68, 0x42, 0x82, 0x51, 0xec,
-	0x25, 0x4e, 0x7d, 0xd0, 0x08, 0x0e, 0x3b, 0x9e, 0xed, 0xd3, 0xa7, 0x50, 0x08, 0x63, 0x31, 0x45,
-	0x3b, 0xe7, 0xf2, 0xa2, 0xb0, 0x38, 0x2b, 0x10, 0x4a, 0xaf, 0xdf, 0x14, 0x6e, 0x76, 0xe4, 0xe7,
-	0x96, 0x63, 0x2f, 0xe9, 0x08, 0x94, 0x48, 0x0a, 0xe0, 0x39, 0x60, 0x96, 0x5b, 0x6f, 0x75, 0x42,
-	0x82, 0x7e, 0xfc, 0xd8, 0x81, 0x1f, 0x1b, 0x8c, 0x1d, 0xd0, 0x61, 0xdb, 0xf5, 0x60, 0x42, 0x82,
-	0xf5, 0x69, 0x70, 0x75, 0xb3, 0xd3, 0xfb, 0x7d, 0xb3, 0xb3, 0x9d, 0x6a, 0x23, 0xb5, 0x31, 0xa7,
-	0xe7, 0x21, 0xea, 0x48, 0x0a, 0x9b, 0x87, 0xc7, 0x90, 0x89, 0x74, 0x7e, 0x08, 0xe9, 0xcf, 0xdb,
-	0xcb, 0x5d, 0x12, 0xaf, 0xfd, 0xeb, 0x97, 0x1d, 0xd3, 0x91, 0x44, 0xc5, 0x33, 0x61, 0xf8, 0xac,
-	0xc4, 0x14, 0xbc, 0xb5, 0x25, 0x9d, 0x36, 0x24, 0xaa, 0x0f, 0xc2, 0x9c, 0xdc, 0x15, 0xb3, 0x2f,
-	0x94, 0xdd, 0xbb, 0x3d, 0x78, 0xe9, 0x70, 0x49, 0xcb, 0xb1, 0xb3, 0xec, 0xfe, 0xe3, 0xdd, 0xf3,
-	0xef, 0xb7, 0x97, 0xbb, 0x1e, 0x54, 0x52, 0x9b, 0xe8, 0xdb, 0x83, 0xd5, 0x70, 0x23, 0xfc, 0xb4,
-	0x32, 0x5c, 0x19, 0xaf, 0xc6, 0x63, 0x54, 0x68, 0x51, 0x14, 0xed, 0x2c, 0xa7, 0x47, 0x57, 0x0b,
-	0x9f, 0x5c, 0x2f, 0x7c, 0xf2, 0x67, 0xe1, 0x93, 0x1f, 0xb5, 0xdf, 0xbb, 0xae, 0xfd, 0xde, 0xaf,
-	0xda, 0xef, 0x7d, 0x7d, 0x9d, 0xa1, 0xcd, 0x2f, 0x92, 0x30, 0xd5, 0x32, 0x72, 0xb6, 0x2e, 0x56,
-	0x6f, 0xdf, 0xfc, 0x77, 0x81, 0x9d, 0xcf, 0xc0, 0x24, 0x83, 0x66, 0x69, 0xf6, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe9, 0x83, 0xfe, 0x9f, 0x02, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0xb1, 0x93, 0xd8, 0x63, 0x52, 0xdc, 0x21, 0x85, 0xc5, 0x25, 0xce, 0x92, 0x4a,
+	0x74, 0x15, 0x21, 0x9b, 0xa6, 0xe2, 0x82, 0xd4, 0x83, 0xbd, 0x76, 0xda, 0x20, 0x3b, 0xb1, 0xd6,
+	0x4e, 0x90, 0xb8, 0x8c, 0xc6, 0xf6, 0xcb, 0xee, 0xa8, 0x3b, 0x3b, 0xab, 0x99, 0xb1, 0x85, 0xff,
+	0x03, 0xc4, 0x09, 0x8e, 0x5c, 0xe1, 0xc2, 0xb1, 0x7f, 0x04, 0x87, 0x1e, 0x7b, 0x44, 0x1c, 0x2a,
+	0x94, 0x1c, 0xfa, 0x6f, 0xa0, 0xfd, 0x11, 0xdb, 0x25, 0xb4, 0x92, 0x2f, 0xab, 0xd9, 0xf7, 0xbe,
+	0xef, 0xb3, 0x6f, 0xe6, 0x7d, 0xc7, 0x46, 0x5f, 0x80, 0xf6, 0x41, 0x72, 0x16, 0xea, 0xc6, 0x25,
+	0x00, 0xa7, 0xf2, 0x39, 0xe8, 0xc6, 0xec, 0xd1, 0xf2, 0xa5, 0x1e, 0x49, 0xa1, 0x05, 0xfe, 0x78,
+	0xa1, 0xab, 0x2f, 0x53, 0xb3, 0x47, 0xd5, 0xbb, 0x94, 0xb3, 0x50, 0x34, 0x92, 0x67, 0x2a, 0xad,
+	0xee, 0x7a, 0xc2, 0x13, 0xc9, 0xb2, 0x11, 0xaf, 0xd2, 0xe8, 0xc1, 0x6f, 0x9b, 0x68, 0xab, 0x4f,
+	0x25, 0xe5, 0x0a, 0xd7, 0x50, 0x39, 0x14, 0x64, 0x44, 0x15, 0x90, 0x4b, 0x00, 0xd3, 0xb0, 0x0c,
+	0xbb, 0xe8, 0x96, 0x42, 0xd1, 0xa2, 0x0a, 0x8e, 0x01, 0xf0, 0x13, 0x74, 0xff, 0x26, 0x49, 0xc6,
+	0x3e, 0x0d, 0x3d, 0x20, 0x13, 0x08, 0x05, 0x67, 0x21, 0xd5, 0x42, 0x9a, 0x1b, 0x96, 0x61, 0xef,
+	0xb8, 0xe6, 0x28, 0x55, 0x3b, 0x89, 0xa0, 0xbd, 0xcc, 0xe3, 0xc7, 0xe8, 0x1e, 0x04, 0x54, 0x69,
+	0x36, 0x66, 0x7a, 0x4e, 0xf8, 0x34, 0xd0, 0x2c, 0x0a, 0x18, 0x48, 0x33, 0x9f, 0x14, 0xee, 0x2e,
+	0x93, 0xbd, 0x45, 0x0e, 0x3f, 0x40, 0x3b, 0x10, 0xd2, 0x51, 0x00, 0xc4, 0x07, 0xe6, 0xf9, 0xda,
+	0xdc, 0xb4, 0x0c, 0x3b, 0xef, 0x7e, 0x90, 0x06, 0x9f, 0x25, 0x31, 0xec, 0xa0, 0xe2, 0xa2, 0xeb,
+	0x2d, 0xcb, 0xb0, 0x4b, 0x2d, 0xfb, 0xe5, 0xeb, 0xfd, 0xdc, 0xdf, 0xaf, 0xf7, 0xef, 0x8f, 0x85,
+	0xe2, 0x42, 0xa9, 0xc9, 0xf3, 0x3a, 0x13, 0x0d, 0x4e, 0xb5, 0x5f, 0xef, 0x82, 0x47, 0xc7, 0xf3,
+	0x36, 0x8c, 0xff, 0x78, 0xf3, 0xe2, 0xd0, 0x70, 0xb7, 0xb3, 0x7e, 0x71, 0x17, 0xed, 0x70, 0x16,
+	0x12, 0x8f, 0x2a, 0x12, 0x49, 0x36, 0x06, 0x73, 0x7b, 0x4d, 0x52, 0x99, 0xb3, 0xf0, 0x29, 0x55,
+	0xfd, 0xb8, 0x18, 0x5f, 0x20, 0x7c, 0x43, 0x5b, 0xd9, 0x69, 0x71, 0x4d, 0x64, 0x25, 0x45, 0xae,
+	0x9c, 0xc7, 0xd7, 0xe8, 0x93, 0xc5, 0x0c, 0x7c, 0xa6, 0xb4, 0x90, 0x73, 0x12, 0x40, 0xe8, 0x69,
+	0xdf, 0x2c, 0x59, 0x86, 0x5d, 0x70, 0x77, 0xb3, 0xfd, 0x3c, 0x4b, 0x93, 0xdd, 0x24, 0x87, 0x9f,
+	0xa2, 0x52, 0x5c, 0x11, 0xd0, 0x10, 0x94, 0x89, 0xac, 0xbc, 0x5d, 0x3e, 0xda, 0xaf, 0xff, 0xbf,
+	0x75, 0xea, 0xc7, 0x00, 0x5d, 0x1a, 0x42, 0xab, 0x14, 0xb7, 0x99, 0xf6, 0x51, 0xbc, 0x4c, 0x63,
+	0x0a, 0x3f, 0x49, 0x41, 0x2a, 0x0a, 0x98, 0x36, 0xcb, 0x96, 0x61, 0x97, 0x8f, 0xac, 0xf7, 0x80,
+	0x06, 0xb1, 0x2e, 0x29, 0x4f, 0x56, 0xdf, 0xec, 0xfd, 0xf4, 0xe6, 0xc5, 0xa1, 0x09, 0x33, 0x2e,
+	0x54, 0xe3, 0x87, 0x15, 0x67, 0xa7, 0x0e, 0xfc, 0xb6, 0x50, 0x2c, 0x54, 0x36, 0xdd, 0x0a, 0x0b,
+	0x99, 0x66, 0x34, 0x58, 0x58, 0xf1, 0xe0, 0xd7, 0x0d, 0xb4, 0x9d, 0xb5, 0x85, 0x31, 0x2a, 0x84,
+	0x94, 0xa7, 0xf6, 0x2c, 0xb9, 0xc9, 0x1a, 0x1f, 0xa0, 0x1d, 0xae, 0x3c, 0xa2, 0xe7, 0x11, 0x90,
+	0xa9, 0x0c, 0x94, 0xb9, 0x61, 0xe5, 0xed, 0x92, 0x5b, 0xe6, 0xca, 0x1b, 0xce, 0x23, 0x38, 0x97,
+	0x81, 0xc2, 0xdf, 0xa1, 0x8f, 0x60, 0xc6, 0x09, 0x9d, 0x4c, 0x24, 0x28, 0x45, 0xc6, 0x01, 0x55,
+	0x0a, 0x94, 0x99, 0xb7, 0xf2, 0xf6, 0x9d, 0xa3, 0x87, 0xef, 0xda, 0x43, 0xe7, 0xa2, 0xd7, 0x4c,
+	0x2b, 0x9c, 0xb8, 0xc0, 0xbd, 0x0b, 0x33, 0xbe, 0x1a, 0x00, 0x85, 0x29, 0x32, 0xdf, 0x32, 0xce,
+	0xea, 0xc0, 0x0b, 0x6b, 0x0e, 0xfc, 0xde, 0x8a, 0x87, 0x56, 0xa6, 0x5e, 0x45, 0xc5, 0x48, 0x32,
+	0x21, 0x99, 0x9e, 0x67, 0x17, 0x60, 0xf1, 0x7e, 0xf0, 0x4b, 0x1e, 0x15, 0x6f, 0x4e, 0x3a, 0xde,
+	0xe4, 0xc2, 0x1e, 0xa3, 0xa9, 0x0c, 0x89, 0xf2, 0xa9, 0xcc, 0xce, 0x6a, 0x1d, 0xdf, 0x65, 0x26,
+	0x6a, 0x4d, 0x65, 0x38, 0x88, 0x09, 0xb1, 0x9f, 0x35, 0x8b, 0x48, 0x24, 0x45, 0x24, 0x14, 0xc8,
+	0x8c, 0xbb, 0xb1, 0x2e, 0x57, 0xb3, 0xa8, 0x9f, 0x21, 0x52, 0x2e, 0x45, 0x66, 0xcc, 0x1d, 0x0b,
+	0xce, 0xa7, 0x61, 0xfc, 0xbb, 0x10, 0x09, 0x11, 0x64, 0xf4, 0xfc, 0xba, 0x87, 0xa7, 0x59, 0xe4,
+	0xdc, 0x80, 0xfa, 0x42, 0x04, 0xe9, 0x27, 0x5c, 0x14, 0x7f, 0x96, 0x70, 0x31, 0x99, 0x06, 0x90,
+	0xa1, 0xd7, 0x9d, 0xcb, 0x1d, 0xcd, 0xa2, 0x5e, 0x02, 0x48, 0x99, 0x7b, 0x08, 0x2d, 0x99, 0xc9,
+	0x48, 0x4a, 0x6e, 0x69, 0xa1, 0x39, 0xfc, 0xd3, 0x40, 0x1f, 0xfe, 0xc7, 0x39, 0xf8, 0x73, 0xb4,
+	0xd7, 0xb9, 0xe8, 0x91, 0x66, 0xbb, 0xed, 0x76, 0x06, 0x03, 0xe2, 0x74, 0x9b, 0x83, 0x01, 0x39,
+	0x3f, 0x1d, 0xf4, 0x3b, 0xce, 0xc9, 0xf1, 0x49, 0xa7, 0x5d, 0xc9, 0xe1, 0x87, 0xe8, 0xc1, 0x6d,
+	0x89, 0x73, 0x76, 0x3a, 0x74, 0x9b, 0xce, 0x90, 0x38, 0x6e, 0xa7, 0x39, 0x3c, 0x39, 0x3b, 0xad,
+	0x18, 0x78, 0x0f, 0x7d, 0x7a, 0x5b, 0xd8, 0x74, 0x9c, 0xb3, 0xf3, 0xd3, 0x61, 0x65, 0x03, 0xd7,
+	0x50, 0xf5, 0xdd, 0x9c, 0x4a, 0x1e, 0x5b, 0xe8, 0xb3, 0xdb, 0xf9, 0xbe, 0xdb, 0x71, 0xce, 0x7a,
+	0xfd, 0x93, 0x6e, 0xa7, 0x52, 0xa8, 0x16, 0x7e, 0xfc, 0xbd, 0x96, 0x6b, 0x1d, 0xbf, 0xbc, 0xaa,
+	0x19, 0xaf, 0xae, 0x6a, 0xc6, 0x3f, 0x57, 0x35, 0xe3, 0xe7, 0xeb, 0x5a, 0xee, 0xd5, 0x75, 0x2d,
+	0xf7, 0xd7, 0x75, 0x2d, 0xf7, 0xfd, 0x97, 0x1e, 0xd3, 0xfe, 0x74, 0x54, 0x1f, 0x0b, 0xde, 0x48,
+	0x6f, 0x73, 0xfa, 0x9c, 0x1d, 0x7d, 0xf5, 0xd6, 0xbd, 0x8e, 0x6f, 0xa4, 0x1a, 0x6d, 0x25, 0x7f,
+	0x35, 0x8f, 0xff, 0x1d, 0x00, 0x17, 0xf2, 0x31, 0xe6, 0xd5, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +414,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeemarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FeeLanes) > 0 {
+		for iNdEx := len(m.FeeLanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.BaseFeeHistoryLength != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeHistoryLength))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -224,6 +503,148 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinGasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.MinGasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EvmAddressClasses) > 0 {
+		dAtA3 := make([]byte, len(m.EvmAddressClasses)*10)
+		var j2 int
+		for _, num := range m.EvmAddressClasses {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintFeemarket(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintFeemarket(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TipModule) > 0 {
+		i -= len(m.TipModule)
+		copy(dAtA[i:], m.TipModule)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.TipModule)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TipModuleShare.Size()
+		i -= size
+		if _, err := m.TipModuleShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TipCommunityPoolShare.Size()
+		i -= size
+		if _, err := m.TipCommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TipProposerShare.Size()
+		i -= size
+		if _, err := m.TipProposerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseFeeBurnShare.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -259,6 +680,71 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeHistoryLength != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeHistoryLength))
+	}
+	if len(m.FeeLanes) > 0 {
+		for _, e := range m.FeeLanes {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if len(m.EvmAddressClasses) > 0 {
+		l = 0
+		for _, e := range m.EvmAddressClasses {
+			l += sovFeemarket(uint64(e))
+		}
+		n += 1 + sovFeemarket(uint64(l)) + l
+	}
+	l = m.MinGasPriceMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.Priority != 0 {
+		n += 1 + sovFeemarket(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFeeBurnShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.TipProposerShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.TipCommunityPoolShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.TipModuleShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.TipModule)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
 	return n
 }
 
@@ -476,6 +962,549 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistoryLength", wireType)
+			}
+			m.BaseFeeHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLanes = append(m.FeeLanes, FeeLane{})
+			if err := m.FeeLanes[len(m.FeeLanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &FeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v EVMAddressClass
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EVMAddressClass(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EvmAddressClasses = append(m.EvmAddressClasses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.EvmAddressClasses) == 0 {
+					m.EvmAddressClasses = make([]EVMAddressClass, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EVMAddressClass
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFeemarket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EVMAddressClass(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EvmAddressClasses = append(m.EvmAddressClasses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddressClasses", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipProposerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipProposerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipCommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipCommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipModuleShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipModuleShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
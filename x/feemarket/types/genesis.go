@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -11,15 +13,38 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, blockGas uint64) *GenesisState {
+func NewGenesisState(params Params, blockGas uint64, baseFeeHistory []BaseFeeHistoryEntry) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		BlockGas: blockGas,
+		Params:         params,
+		BlockGas:       blockGas,
+		BaseFeeHistory: baseFeeHistory,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if uint64(len(gs.BaseFeeHistory)) > gs.Params.BaseFeeHistoryLength {
+		return fmt.Errorf(
+			"base fee history has %d entries, more than the history length %d",
+			len(gs.BaseFeeHistory), gs.Params.BaseFeeHistoryLength,
+		)
+	}
+
+	var lastHeight int64
+	for _, entry := range gs.BaseFeeHistory {
+		if entry.Height <= lastHeight {
+			return fmt.Errorf("base fee history entries must have positive and ascending heights, got %d after %d", entry.Height, lastHeight)
+		}
+		if entry.BaseFee.IsNil() || entry.BaseFee.IsNegative() {
+			return fmt.Errorf("invalid base fee of base fee history entry at height %d: %s", entry.Height, entry.BaseFee)
+		}
+		lastHeight = entry.Height
+	}
+
+	return nil
 }
@@ -31,6 +31,8 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// base_fee_history is the base fee history of the most recent blocks.
+	BaseFeeHistory []BaseFeeHistoryEntry `protobuf:"bytes,4,rep,name=base_fee_history,json=baseFeeHistory,proto3" json:"base_fee_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +82,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBaseFeeHistory() []BaseFeeHistoryEntry {
+	if m != nil {
+		return m.BaseFeeHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -89,24 +98,27 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x6a, 0x38, 0x0c, 0x44, 0xe8, 0x83, 0xa8, 0x13, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x1d, 0x17, 0x8f, 0x3b, 0xc4,
-	0xe6, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x47, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x2e, 0xd1, 0x0b, 0x00, 0xab, 0x72,
-	0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x8d, 0x42, 0xd2,
-	0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5, 0x12, 0xcc, 0x0a, 0x8c, 0x1a,
-	0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e, 0x26, 0x01, 0xe6, 0x20, 0x8e,
-	0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x4f, 0x2d, 0xcb, 0xcd, 0x2f, 0x86, 0x92, 0x65, 0x46, 0x06, 0xfa, 0x15, 0x48, 0x5e, 0x2d, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x4a,
-	0xda, 0x75, 0x5f, 0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x33, 0xb6, 0x94, 0x36, 0x15, 0xa9, 0x41, 0xa4, 0x54, 0x18, 0x4b, 0x11, 0x29, 0x2a,
+	0x33, 0xb6, 0x9e, 0xc0, 0x82, 0xad, 0xb8, 0x92, 0xba, 0x73, 0x53, 0x27, 0xe5, 0x35, 0x0d, 0x75,
+	0x32, 0x71, 0x66, 0x1a, 0xcc, 0x2d, 0x3c, 0x86, 0x4b, 0x8f, 0xd1, 0x65, 0x97, 0xae, 0xa4, 0x24,
+	0x0b, 0xaf, 0x21, 0x26, 0xb1, 0x56, 0x30, 0x9b, 0xc7, 0xe3, 0xcd, 0xf7, 0xcf, 0xff, 0xf3, 0x9b,
+	0x47, 0xa0, 0xa7, 0x20, 0xb9, 0xeb, 0x69, 0x3a, 0x01, 0xe0, 0x4c, 0xce, 0x40, 0xd3, 0xa0, 0x43,
+	0x1d, 0xf0, 0x40, 0xb9, 0x8a, 0xf8, 0x52, 0x68, 0x61, 0xed, 0xaf, 0x29, 0xb2, 0xa6, 0x48, 0xd0,
+	0x69, 0xec, 0x32, 0xee, 0x7a, 0x82, 0x26, 0x33, 0x45, 0x1b, 0xc7, 0x39, 0x1f, 0xfe, 0xea, 0x52,
+	0xae, 0x95, 0xc3, 0x3d, 0xcd, 0x41, 0x86, 0x19, 0xb3, 0xe7, 0x08, 0x47, 0x24, 0x2b, 0xfd, 0xde,
+	0xd2, 0x6b, 0x6b, 0x85, 0xcc, 0xed, 0x41, 0x1a, 0xef, 0x4e, 0x33, 0x0d, 0xd6, 0xa5, 0x59, 0xf2,
+	0x99, 0x64, 0x5c, 0xd5, 0x51, 0x13, 0xb5, 0xab, 0x5d, 0x4c, 0xfe, 0x8f, 0x4b, 0x6e, 0x13, 0xaa,
+	0x57, 0x59, 0x7c, 0x1c, 0x1a, 0xaf, 0x9f, 0x6f, 0x27, 0x68, 0x98, 0x09, 0xad, 0x03, 0xb3, 0x62,
+	0x3f, 0x8a, 0xf1, 0x6c, 0xe4, 0x30, 0x55, 0x2f, 0x34, 0x51, 0xbb, 0x38, 0x2c, 0x27, 0x87, 0x01,
+	0x53, 0xd6, 0x83, 0x59, 0xb3, 0x99, 0x82, 0xd1, 0x04, 0x60, 0x34, 0x75, 0x95, 0x16, 0x32, 0xac,
+	0x17, 0x9b, 0x85, 0x76, 0xb5, 0x7b, 0x9a, 0xe7, 0xd4, 0x63, 0x0a, 0xfa, 0x00, 0xd7, 0x29, 0x7d,
+	0xe5, 0x69, 0x19, 0x6e, 0xda, 0xee, 0xd8, 0x7f, 0xde, 0x6f, 0x8a, 0xe5, 0xad, 0x5a, 0x61, 0x58,
+	0xfe, 0x71, 0xe9, 0xf5, 0x17, 0x11, 0x46, 0xcb, 0x08, 0xa3, 0x55, 0x84, 0xd1, 0x4b, 0x8c, 0x8d,
+	0x65, 0x8c, 0x8d, 0xf7, 0x18, 0x1b, 0xf7, 0x67, 0x8e, 0xab, 0xa7, 0x73, 0x9b, 0x8c, 0x05, 0xa7,
+	0x10, 0x70, 0xa1, 0xb2, 0x19, 0x74, 0xcf, 0xe9, 0xf3, 0x46, 0x93, 0x3a, 0xf4, 0x41, 0xd9, 0xa5,
+	0xa4, 0xb1, 0x8b, 0xaf, 0x01, 0x00, 0x27, 0x91, 0x8f, 0xac, 0xe6, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseFeeHistory) > 0 {
+		for iNdEx := len(m.BaseFeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +195,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.BaseFeeHistory) > 0 {
+		for _, e := range m.BaseFeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -259,6 +291,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeHistory = append(m.BaseFeeHistory, BaseFeeHistoryEntry{})
+			if err := m.BaseFeeHistory[len(m.BaseFeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,8 +30,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
@@ -38,9 +40,50 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			NewGenesisState(
 				DefaultParams(),
 				uint64(1),
+				[]BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.LegacyNewDec(1)},
+					{Height: 2, BaseFee: math.LegacyNewDec(2)},
+				},
 			),
 			true,
 		},
+		{
+			"base fee history heights not ascending",
+			NewGenesisState(
+				DefaultParams(),
+				uint64(1),
+				[]BaseFeeHistoryEntry{
+					{Height: 2, BaseFee: math.LegacyNewDec(1)},
+					{Height: 2, BaseFee: math.LegacyNewDec(2)},
+				},
+			),
+			false,
+		},
+		{
+			"base fee history entry with negative base fee",
+			NewGenesisState(
+				DefaultParams(),
+				uint64(1),
+				[]BaseFeeHistoryEntry{{Height: 1, BaseFee: math.LegacyNewDec(-1)}},
+			),
+			false,
+		},
+		{
+			"base fee history longer than the history length",
+			func() *GenesisState {
+				params := DefaultParams()
+				params.BaseFeeHistoryLength = 1
+				return NewGenesisState(
+					params,
+					uint64(1),
+					[]BaseFeeHistoryEntry{
+						{Height: 1, BaseFee: math.LegacyNewDec(1)},
+						{Height: 2, BaseFee: math.LegacyNewDec(2)},
+					},
+				)
+			}(),
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
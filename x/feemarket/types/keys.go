@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -22,6 +24,8 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBaseFeeHistory
+	prefixBurnedFees
 )
 
 const (
@@ -31,9 +35,17 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
+	KeyPrefixBurnedFees     = []byte{prefixBurnedFees}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
 )
+
+// BaseFeeHistoryKey returns the key of the base fee history entry of the given
+// block height, relative to the base fee history prefix.
+func BaseFeeHistoryKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height)) //nolint:gosec // G115 -- block heights are positive
+}
@@ -21,6 +21,9 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeHistoryLength keeps the base fee of the last 100 blocks,
+	// which matches the default JSON-RPC fee history cap
+	DefaultBaseFeeHistoryLength = uint64(100)
 )
 
 // Parameter keys
@@ -33,6 +36,9 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyBaseFeeHistoryLength     = []byte("BaseFeeHistoryLength")
+	ParamStoreKeyFeeLanes                 = []byte("FeeLanes")
+	ParamStoreKeyFeeSplit                 = []byte("FeeSplit")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -50,6 +56,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeHistoryLength, &p.BaseFeeHistoryLength, validateBaseFeeHistoryLength),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeLanes, &p.FeeLanes, validateFeeLanes),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeSplit, &p.FeeSplit, validateFeeSplit),
 	}
 }
 
@@ -84,6 +93,7 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeHistoryLength:     DefaultBaseFeeHistoryLength,
 	}
 }
 
@@ -105,6 +115,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeLanes(p.FeeLanes); err != nil {
+		return err
+	}
+
+	if err := validateFeeSplit(p.FeeSplit); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -205,3 +223,11 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateBaseFeeHistoryLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
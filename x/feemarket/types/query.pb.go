@@ -7,6 +7,9 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -275,6 +278,291 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBaseFeeHistoryRequest defines the request type for querying the base
+// fee history.
+type QueryBaseFeeHistoryRequest struct {
+	// start_height is the lowest block height of the returned entries. It is
+	// ignored if 0.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the highest block height of the returned entries. It is
+	// ignored if 0.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryBaseFeeHistoryRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryBaseFeeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBaseFeeHistoryResponse returns the base fee history entries ordered by
+// ascending height.
+type QueryBaseFeeHistoryResponse struct {
+	// entries are the base fee history entries within the height range
+	Entries []BaseFeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetEntries() []BaseFeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BaseFeeHistoryEntry defines the base fee and block gas of a block kept in
+// the base fee history.
+type BaseFeeHistoryEntry struct {
+	// height is the block height of the entry
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the EIP1559 base fee of the block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// gas_wanted is the block gas wanted used for the base fee calculation of
+	// the next block
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the block max gas of the consensus params of the block, -1
+	// if the block gas is unlimited
+	GasLimit int64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BaseFeeHistoryEntry) Reset()         { *m = BaseFeeHistoryEntry{} }
+func (m *BaseFeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeHistoryEntry) ProtoMessage()    {}
+func (*BaseFeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *BaseFeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeHistoryEntry.Merge(m, src)
+}
+func (m *BaseFeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeHistoryEntry proto.InternalMessageInfo
+
+func (m *BaseFeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeHistoryEntry) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BaseFeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BaseFeeHistoryEntry) GetGasLimit() int64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// QueryBurnedFeesRequest defines the request type for querying the cumulative
+// burned transaction fees.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse returns the cumulative burned transaction fees.
+type QueryBurnedFeesResponse struct {
+	// burned is the cumulative amount of transaction fees burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{10}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +570,11 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryResponse")
+	proto.RegisterType((*BaseFeeHistoryEntry)(nil), "ethermint.feemarket.v1.BaseFeeHistoryEntry")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "ethermint.feemarket.v1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "ethermint.feemarket.v1.QueryBurnedFeesResponse")
 }
 
 func init() {
@@ -289,37 +582,60 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x5a, 0x64, 0x8d, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x1d, 0x1b, 0x6f, 0xde, 0x0c, 0x52, 0x2f, 0x1e, 0x74, 0x4f, 0xe2, 0xa5, 0x4c, 0xd6, 0xd7, 0xcd,
-	0x92, 0xce, 0xbe, 0xed, 0xce, 0x24, 0x98, 0xab, 0xe0, 0xc5, 0x83, 0x08, 0xfe, 0x09, 0x2f, 0x82,
-	0x3f, 0xa3, 0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0x11, 0xfc, 0x1b, 0x92, 0x99, 0xd9, 0xe8, 0x6a,
-	0xa2, 0xb9, 0x0c, 0x8f, 0xb7, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x8f, 0xa5, 0x01, 0xe8, 0x3e, 0x14,
-	0x32, 0xcd, 0x34, 0x3f, 0x00, 0x90, 0xa2, 0x18, 0x80, 0xe6, 0xa3, 0x5d, 0x7e, 0x34, 0x84, 0x62,
-	0x1c, 0xe6, 0x05, 0x6a, 0x64, 0x5b, 0x73, 0x4c, 0x38, 0xc7, 0x84, 0xa3, 0x5d, 0xef, 0x82, 0x90,
-	0x69, 0x86, 0xdc, 0xbc, 0x16, 0xea, 0xdd, 0x58, 0x42, 0xf7, 0x6b, 0xce, 0xe2, 0x9a, 0x09, 0x26,
-	0x68, 0x4a, 0x3e, 0xab, 0x5c, 0xb7, 0x95, 0x20, 0x26, 0x87, 0xc0, 0x45, 0x9e, 0x72, 0x91, 0x65,
-	0xa8, 0x85, 0x4e, 0x31, 0x53, 0xf6, 0x6b, 0xd0, 0xa4, 0xec, 0xe9, 0xcc, 0xd5, 0x13, 0x51, 0x08,
-	0xa9, 0x22, 0x38, 0x1a, 0x82, 0xd2, 0xc1, 0x33, 0x7a, 0xb1, 0xd2, 0x55, 0x39, 0x66, 0x0a, 0xd8,
-	0x03, 0xda, 0xc8, 0x4d, 0xe7, 0x12, 0xb9, 0x4a, 0x6e, 0x9e, 0xeb, 0xf8, 0xe1, 0xe2, 0x25, 0x42,
-	0x3b, 0xd7, 0xdd, 0x38, 0x3e, 0x6d, 0xd7, 0x3e, 0xfc, 0xf8, 0xb4, 0x4d, 0x22, 0x37, 0x18, 0x6c,
-	0x3a, 0xe6, 0xae, 0x50, 0xb0, 0x07, 0x50, 0x0a, 0x46, 0xb4, 0x59, 0x6d, 0x3b, 0xc5, 0xfb, 0x74,
-	0xbd, 0x27, 0x14, 0xec, 0x1f, 0x00, 0x18, 0xcd, 0x8d, 0x6e, 0xfb, 0xeb, 0x69, 0xfb, 0x72, 0x8c,
-	0x4a, 0xa2, 0x52, 0x2f, 0x06, 0x61, 0x8a, 0x5c, 0x0a, 0xdd, 0x0f, 0x1f, 0x43, 0x22, 0xe2, 0xf1,
-	0x43, 0x88, 0xa3, 0xb5, 0x9e, 0xe5, 0x08, 0xb6, 0x4a, 0xce, 0x43, 0x8c, 0x07, 0x8f, 0xc4, 0x7c,
-	0xb9, 0x5b, 0x74, 0xf3, 0x8f, 0xbe, 0x13, 0x3b, 0x4f, 0xeb, 0x89, 0xb0, 0xbb, 0xd5, 0xa3, 0x59,
-	0xd9, 0xf9, 0x58, 0xa7, 0x67, 0x0d, 0x96, 0xbd, 0x26, 0xb4, 0x61, 0xb7, 0x62, 0xdb, 0xcb, 0xb6,
-	0xfe, 0x3b, 0x48, 0xef, 0xf6, 0x4a, 0x58, 0xab, 0x1f, 0x04, 0xaf, 0x3e, 0x7f, 0x7f, 0x7f, 0xa6,
-	0xc5, 0x3c, 0x0e, 0x23, 0x89, 0xaa, 0x7a, 0x6c, 0x9b, 0x1f, 0x7b, 0x43, 0xe8, 0x9a, 0x0b, 0x89,
-	0xfd, 0x9b, 0xbc, 0x9a, 0xb0, 0xb7, 0xb3, 0x1a, 0xd8, 0x59, 0xb9, 0x66, 0xac, 0xf8, 0xac, 0xb5,
-	0xc8, 0x4a, 0x79, 0x11, 0xf6, 0x96, 0xd0, 0xf5, 0x32, 0x45, 0xf6, 0x1f, 0x81, 0xea, 0x11, 0xbc,
-	0x3b, 0x2b, 0xa2, 0x9d, 0x9f, 0xeb, 0xc6, 0x4f, 0x9b, 0x5d, 0x59, 0xe8, 0x67, 0x86, 0xde, 0x4f,
-	0x84, 0xea, 0xee, 0x1d, 0x4f, 0x7c, 0x72, 0x32, 0xf1, 0xc9, 0xb7, 0x89, 0x4f, 0xde, 0x4d, 0xfd,
-	0xda, 0xc9, 0xd4, 0xaf, 0x7d, 0x99, 0xfa, 0xb5, 0xe7, 0x3b, 0x49, 0xaa, 0xfb, 0xc3, 0x5e, 0x18,
-	0xa3, 0x74, 0x14, 0xf6, 0x1d, 0x75, 0xee, 0xf2, 0x97, 0xbf, 0xd1, 0xe9, 0x71, 0x0e, 0xaa, 0xd7,
-	0x30, 0x3f, 0xc7, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x02, 0x20, 0xd0, 0xc9, 0x03,
-	0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x64, 0x9b, 0x34, 0x53, 0x84, 0x60, 0xb6, 0x1b, 0xb2, 0x6e, 0xd7, 0x61, 0xad,
+	0xa5, 0x1b, 0xba, 0x5d, 0x0f, 0xc9, 0x8a, 0x0b, 0x37, 0xb2, 0x90, 0xed, 0xa1, 0x87, 0x12, 0x09,
+	0x81, 0xb8, 0x44, 0x93, 0xf8, 0xd5, 0xb1, 0x52, 0x7b, 0x52, 0xcf, 0x24, 0x90, 0x2b, 0x88, 0x0b,
+	0x07, 0x84, 0x84, 0xc4, 0x9f, 0x00, 0x88, 0x13, 0x47, 0xfe, 0x84, 0x72, 0xab, 0xc4, 0x05, 0x71,
+	0x28, 0xa8, 0x45, 0xe2, 0xca, 0x9f, 0x80, 0xe6, 0x87, 0xd3, 0xb8, 0x4d, 0xdb, 0x70, 0x71, 0xec,
+	0x37, 0xdf, 0x79, 0xef, 0xf3, 0xde, 0xbc, 0x79, 0x41, 0x2e, 0x88, 0x01, 0x24, 0x51, 0x18, 0x0b,
+	0x72, 0x00, 0x10, 0xd1, 0x64, 0x08, 0x82, 0x4c, 0x1a, 0xe4, 0x68, 0x0c, 0xc9, 0xd4, 0x1b, 0x25,
+	0x4c, 0x30, 0x5c, 0x99, 0x69, 0xbc, 0x99, 0xc6, 0x9b, 0x34, 0xec, 0x57, 0x69, 0x14, 0xc6, 0x8c,
+	0xa8, 0xa7, 0x96, 0xda, 0xdb, 0x7d, 0xc6, 0x23, 0xc6, 0x49, 0x8f, 0x72, 0xd0, 0x3e, 0xc8, 0xa4,
+	0xd1, 0x03, 0x41, 0x1b, 0x64, 0x44, 0x83, 0x30, 0xa6, 0x22, 0x64, 0xb1, 0xd1, 0x3a, 0xf3, 0xda,
+	0x54, 0xd5, 0x67, 0x61, 0xba, 0xbe, 0x75, 0x0d, 0xda, 0x05, 0x83, 0xd6, 0xad, 0x07, 0x2c, 0x60,
+	0xea, 0x95, 0xc8, 0x37, 0x63, 0xdd, 0x0c, 0x18, 0x0b, 0x0e, 0x81, 0xd0, 0x51, 0x48, 0x68, 0x1c,
+	0x33, 0xa1, 0x42, 0x73, 0xbd, 0xea, 0xae, 0x23, 0xfc, 0x81, 0xa4, 0xdb, 0xa7, 0x09, 0x8d, 0x78,
+	0x07, 0x8e, 0xc6, 0xc0, 0x85, 0xfb, 0x31, 0xba, 0x9b, 0xb1, 0xf2, 0x11, 0x8b, 0x39, 0xe0, 0x77,
+	0x51, 0x71, 0xa4, 0x2c, 0x55, 0xeb, 0x75, 0xab, 0xbe, 0xd6, 0x74, 0xbc, 0xc5, 0x05, 0xf1, 0xf4,
+	0xbe, 0x56, 0xf9, 0xf8, 0xb4, 0x96, 0xfb, 0xf1, 0x9f, 0x9f, 0xb7, 0xad, 0x8e, 0xd9, 0xe8, 0xde,
+	0x33, 0x9e, 0x5b, 0x94, 0x43, 0x1b, 0x20, 0x0d, 0xd8, 0x41, 0xeb, 0x59, 0xb3, 0x89, 0xf8, 0x0e,
+	0x5a, 0x95, 0x55, 0xe9, 0x1e, 0x00, 0xa8, 0x98, 0xe5, 0x56, 0xed, 0x8f, 0xd3, 0xda, 0x86, 0x2e,
+	0x18, 0xf7, 0x87, 0x5e, 0xc8, 0x48, 0x44, 0xc5, 0xc0, 0xdb, 0x83, 0x80, 0xf6, 0xa7, 0xef, 0x41,
+	0xbf, 0x53, 0xea, 0x69, 0x1f, 0x6e, 0x25, 0xf5, 0x79, 0xc8, 0xfa, 0xc3, 0x17, 0x74, 0x96, 0xdc,
+	0x9b, 0xe8, 0xde, 0x25, 0xbb, 0x09, 0xf6, 0x0a, 0x2a, 0x04, 0x54, 0xe7, 0x56, 0xe8, 0xc8, 0x57,
+	0xf7, 0x7b, 0x0b, 0xd9, 0xf3, 0x5c, 0xbb, 0x21, 0x17, 0x2c, 0x99, 0x1a, 0x4f, 0xf8, 0x21, 0x7a,
+	0x89, 0x0b, 0x9a, 0x88, 0xee, 0x00, 0xc2, 0x60, 0x20, 0xcc, 0xce, 0x35, 0x65, 0xdb, 0x55, 0x26,
+	0xfc, 0x00, 0x21, 0x88, 0xfd, 0x54, 0x90, 0x57, 0x82, 0x32, 0xc4, 0xbe, 0x59, 0x6e, 0x23, 0x74,
+	0xd1, 0x0e, 0xd5, 0x82, 0xaa, 0xea, 0x96, 0xa7, 0xd3, 0xf3, 0x64, 0x22, 0x9e, 0xee, 0x3f, 0xd3,
+	0x15, 0xde, 0x3e, 0x0d, 0xd2, 0x9a, 0x75, 0xe6, 0x76, 0xba, 0xbf, 0x58, 0x68, 0x63, 0x21, 0xa8,
+	0x49, 0x6d, 0x1f, 0x95, 0x20, 0x16, 0x49, 0x08, 0x32, 0xbd, 0x42, 0x7d, 0xad, 0xf9, 0xe4, 0xba,
+	0xa3, 0xcb, 0x3a, 0x78, 0x3f, 0x16, 0xc9, 0x74, 0xfe, 0x1c, 0x53, 0x37, 0xf8, 0x45, 0x86, 0x3c,
+	0xaf, 0xc8, 0x1f, 0xdf, 0x4a, 0xae, 0x71, 0x32, 0xe8, 0xbf, 0x5a, 0xe8, 0xee, 0x82, 0xa0, 0xb8,
+	0x82, 0x8a, 0x99, 0xb2, 0x9a, 0x2f, 0xfc, 0x7c, 0xae, 0x25, 0xf2, 0xaa, 0x25, 0xea, 0x12, 0xef,
+	0x96, 0xb6, 0x30, 0xf4, 0xa6, 0x37, 0xe4, 0xb1, 0x04, 0x94, 0x77, 0x3f, 0xa5, 0xb1, 0x00, 0x5f,
+	0xd5, 0xfd, 0x4e, 0xa7, 0x1c, 0x50, 0xfe, 0x91, 0x32, 0xe0, 0xfb, 0x68, 0x55, 0x2e, 0x8f, 0x39,
+	0xf8, 0xd5, 0x3b, 0x6a, 0xb1, 0x14, 0x50, 0xfe, 0x21, 0x07, 0x1f, 0x6f, 0x20, 0xa9, 0xeb, 0x1e,
+	0x86, 0x51, 0x28, 0xaa, 0x2b, 0x8a, 0x4c, 0x6a, 0xf7, 0xe4, 0xb7, 0x5b, 0x45, 0x15, 0x7d, 0x0a,
+	0xe3, 0x24, 0x06, 0xbf, 0x0d, 0x30, 0x6b, 0xba, 0x2f, 0x2c, 0xf4, 0xda, 0x95, 0x25, 0x73, 0x38,
+	0x03, 0x54, 0xec, 0x29, 0xab, 0x39, 0x9b, 0xfb, 0x99, 0x32, 0xa6, 0x05, 0x7c, 0xce, 0xc2, 0xb8,
+	0xf5, 0xb6, 0x4c, 0xf5, 0xa7, 0x3f, 0x6b, 0xf5, 0x20, 0x14, 0x83, 0x71, 0xcf, 0xeb, 0xb3, 0x88,
+	0x68, 0xb1, 0xf9, 0x79, 0xca, 0xfd, 0x21, 0x11, 0xd3, 0x11, 0x70, 0xb5, 0x81, 0x9b, 0xdb, 0xa7,
+	0xfd, 0x37, 0xff, 0x5d, 0x41, 0x2b, 0x8a, 0x02, 0x7f, 0x69, 0xa1, 0xa2, 0xbe, 0xa5, 0x78, 0xfb,
+	0xba, 0x56, 0xb8, 0x3a, 0x18, 0xec, 0x27, 0x4b, 0x69, 0x75, 0x5e, 0xae, 0xfb, 0xf9, 0x6f, 0x7f,
+	0x7f, 0x9b, 0xdf, 0xc4, 0x36, 0x81, 0x89, 0x24, 0xcc, 0x0c, 0x2f, 0x3d, 0x0f, 0xf0, 0x57, 0x16,
+	0x2a, 0x99, 0xd3, 0xc7, 0x37, 0x3b, 0xcf, 0x4e, 0x0c, 0x7b, 0x67, 0x39, 0xb1, 0x41, 0x79, 0xa4,
+	0x50, 0x1c, 0xbc, 0xb9, 0x08, 0x25, 0x6d, 0x27, 0xfc, 0xb5, 0x85, 0x56, 0xd3, 0xa9, 0x80, 0x6f,
+	0x09, 0x90, 0x1d, 0x2a, 0xf6, 0xd3, 0x25, 0xd5, 0x86, 0xe7, 0x0d, 0xc5, 0x53, 0xc3, 0x0f, 0x16,
+	0xf2, 0x48, 0x75, 0x37, 0xa0, 0x1c, 0xff, 0x60, 0xa1, 0x97, 0xb3, 0x77, 0x03, 0x37, 0x97, 0xc9,
+	0x3b, 0x3b, 0xa7, 0xec, 0x67, 0xff, 0x6b, 0x8f, 0x41, 0xdc, 0x51, 0x88, 0x5b, 0xf8, 0xd1, 0x4d,
+	0x25, 0xeb, 0x0e, 0x0c, 0xd6, 0x77, 0x16, 0x42, 0x17, 0xad, 0x8d, 0xbd, 0x9b, 0x23, 0x5e, 0xbe,
+	0x1e, 0x36, 0x59, 0x5a, 0x6f, 0xe8, 0x1e, 0x2b, 0xba, 0x87, 0xb8, 0xb6, 0x90, 0x4e, 0xe9, 0x25,
+	0x1f, 0x6f, 0xb5, 0x8f, 0xcf, 0x1c, 0xeb, 0xe4, 0xcc, 0xb1, 0xfe, 0x3a, 0x73, 0xac, 0x6f, 0xce,
+	0x9d, 0xdc, 0xc9, 0xb9, 0x93, 0xfb, 0xfd, 0xdc, 0xc9, 0x7d, 0xb2, 0x33, 0x77, 0x87, 0xb4, 0x13,
+	0xfd, 0x9c, 0x34, 0xdf, 0x22, 0x9f, 0xcd, 0x39, 0x54, 0xb7, 0xa9, 0x57, 0x54, 0xff, 0x97, 0xcf,
+	0xfe, 0x1b, 0x00, 0x3a, 0x75, 0x15, 0xaf, 0x28, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +656,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and block gas of the most recent
+	// blocks kept in the base fee history, within an optional height range.
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	// BurnedFees queries the cumulative amount of transaction fees burned by the
+	// fee split.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +699,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -385,6 +725,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and block gas of the most recent
+	// blocks kept in the base fee history, within an optional height range.
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	// BurnedFees queries the cumulative amount of transaction fees burned by the
+	// fee split.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +746,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +811,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +863,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -645,55 +1041,262 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -719,6 +1322,91 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BaseFeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1801,544 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BaseFeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package forward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward/keeper"
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	// ensure forward module account is set on genesis, so that the tokens
+	// passing through are not converted to ERC20
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		// NOTE: shouldn't occur
+		panic("the forward module account has not been set")
+	}
+
+	SetReceiverAccount(ctx, accountKeeper)
+
+	for _, packet := range data.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+}
+
+// SetReceiverAccount creates the module account that receives the tokens of the
+// forwarded packets if it does not exist. It is not a blocked module account,
+// as the transfer application credits it with the tokens of the forwarded
+// packets.
+func SetReceiverAccount(ctx sdk.Context, accountKeeper authkeeper.AccountKeeper) {
+	if accountKeeper.HasAccount(ctx, types.ReceiverAddress) {
+		return
+	}
+
+	acc := accountKeeper.NewAccount(ctx, authtypes.NewEmptyModuleAccount(types.ReceiverName))
+	accountKeeper.SetAccount(ctx, acc)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		InFlightPackets: k.GetInFlightPackets(ctx),
+	}
+}
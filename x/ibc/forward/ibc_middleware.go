@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package forward
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/x/ibc/forward/keeper"
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware
+// given the forward keeper and the underlying transfer stack. Packets with a
+// `forward` memo are received on the forward receiver account and sent to the
+// next hop, and their acknowledgement is written once the forwarded packet is
+// acknowledged.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+
+	// retriesOnTimeout is the default number of times a forwarded packet is
+	// sent again when it times out.
+	retriesOnTimeout uint8
+	// forwardTimeout is the default relative timeout of the forwarded packets.
+	forwardTimeout time.Duration
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying
+// application and the default retries and timeout of the forwarded packets,
+// which can be overridden in the memo of each packet.
+func NewIBCMiddleware(
+	k keeper.Keeper,
+	app porttypes.IBCModule,
+	retriesOnTimeout uint8,
+	forwardTimeout time.Duration,
+) IBCMiddleware {
+	return IBCMiddleware{
+		Module:           ibc.NewModule(app),
+		keeper:           k,
+		retriesOnTimeout: retriesOnTimeout,
+		forwardTimeout:   forwardTimeout,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the packet memo contains forward metadata, the tokens are received on the
+// forward receiver account and sent to the next hop. The packet memo is dropped
+// for the underlying stack, so that the tokens passing through are not
+// converted to ERC20 and no EVM callback is executed on this chain. The
+// acknowledgement is written asynchronously once the forwarded packet is
+// acknowledged.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// the transfer application returns the error acknowledgement
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, found, err := types.ParseForwardMetadata(data.Memo)
+	if !found {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	overrideData := data
+	overrideData.Receiver = types.ReceiverAddress.String()
+	overrideData.Memo = ""
+
+	overridePacket := packet
+	overridePacket.Data = overrideData.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, overridePacket, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardPacket(ctx, packet, data, *metadata, im.forwardTimeout, im.retriesOnTimeout); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// The transfer stack refunds the forward receiver account on an error
+// acknowledgement, then the acknowledgement of the packet received from the
+// previous hop is written.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardAcknowledgement(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// The transfer stack refunds the forward receiver account, then the packet is
+// sent again if it has retries remaining, or refunded to the previous hop.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardTimeout(ctx, packet)
+}
@@ -0,0 +1,339 @@
+package forward_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/ibc/forward"
+	"github.com/evmos/evmos/v20/x/ibc/forward/keeper"
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+const forwardMemo = `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-2"}}`
+
+// mockTransferApp mocks the transfer application below the forward middleware.
+// Received packets credit the receiver, transfers move the tokens to the
+// transfer module account and failed transfers refund the sender.
+type mockTransferApp struct {
+	porttypes.IBCModule
+	types.TransferKeeper
+
+	nw       *network.UnitTestNetwork
+	received []transfertypes.FungibleTokenPacketData
+	sent     map[uint64]*transfertypes.MsgTransfer
+	sequence uint64
+}
+
+func (m *mockTransferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	m.received = append(m.received, data)
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, _ := math.NewIntFromString(data.Amount)
+	coin := sdk.NewCoin(receivedDenom(packet, data), amount)
+	if err := testutil.FundAccount(ctx, m.nw.App.BankKeeper, receiver, sdk.NewCoins(coin)); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *mockTransferApp) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+	if ack.Success() {
+		return nil
+	}
+	return m.refund(ctx, packet)
+}
+
+func (m *mockTransferApp) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	return m.refund(ctx, packet)
+}
+
+func (m *mockTransferApp) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.nw.App.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, transfertypes.ModuleName, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	m.sequence++
+	m.sent[m.sequence] = msg
+	return &transfertypes.MsgTransferResponse{Sequence: m.sequence}, nil
+}
+
+func (m *mockTransferApp) refund(ctx sdk.Context, packet channeltypes.Packet) error {
+	msg, found := m.sent[packet.Sequence]
+	if !found {
+		return errors.New("packet not sent")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return err
+	}
+
+	return m.nw.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, sender, sdk.NewCoins(msg.Token))
+}
+
+// mockICS4Wrapper records the acknowledgements written by the forward keeper.
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	acks map[uint64]exported.Acknowledgement
+}
+
+func (m *mockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	m.acks[packet.GetSequence()] = ack
+	return nil
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) LookupModuleByChannel(sdk.Context, string, string) (string, *capabilitytypes.Capability, error) {
+	return transfertypes.ModuleName, &capabilitytypes.Capability{}, nil
+}
+
+func receivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom) {
+		return data.Denom[len(transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)):]
+	}
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.DestinationPort, packet.DestinationChannel) + data.Denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+func setupMiddleware(t *testing.T) (*network.UnitTestNetwork, forward.IBCMiddleware, *mockTransferApp, *mockICS4Wrapper) {
+	t.Helper()
+
+	nw := network.NewUnitTestNetwork()
+	app := &mockTransferApp{
+		TransferKeeper: nw.App.TransferKeeper,
+		nw:             nw,
+		sent:           make(map[uint64]*transfertypes.MsgTransfer),
+	}
+	ics4 := &mockICS4Wrapper{acks: make(map[uint64]exported.Acknowledgement)}
+
+	k := keeper.NewKeeper(
+		nw.App.GetKey(types.StoreKey), nw.App.AppCodec(),
+		ics4, mockChannelKeeper{}, app, nw.App.BankKeeper,
+	)
+	return nw, forward.NewIBCMiddleware(k, app, 1, time.Hour), app, ics4
+}
+
+func newPacket(ctx sdk.Context, denom, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(
+		denom, "100",
+		"cosmos1sender", sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+		memo,
+	)
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		transfertypes.PortID, "channel-0",
+		transfertypes.PortID, "channel-1",
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), //nolint:gosec // G115
+	)
+}
+
+func forwardedPacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		[]byte("data"), sequence,
+		transfertypes.PortID, "channel-2",
+		transfertypes.PortID, "channel-3",
+		clienttypes.ZeroHeight(), 1,
+	)
+}
+
+func TestForwardAccounts(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	// users cannot send tokens to the forward module account
+	require.True(t, nw.App.BankKeeper.BlockedAddr(types.ModuleAddress))
+
+	// the transfer application can credit the receiver account, which is a
+	// module account so that the tokens are not converted to ERC20
+	require.False(t, nw.App.BankKeeper.BlockedAddr(types.ReceiverAddress))
+	_, ok := nw.App.AccountKeeper.GetAccount(ctx, types.ReceiverAddress).(sdk.ModuleAccountI)
+	require.True(t, ok)
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	t.Run("no forward memo", func(t *testing.T) {
+		nw, middleware, app, _ := setupMiddleware(t)
+		ctx := nw.GetContext()
+		packet := newPacket(ctx, "uatom", `{"other":{}}`)
+
+		ack := middleware.OnRecvPacket(ctx, packet, nil)
+		require.True(t, ack.Success())
+
+		var data transfertypes.FungibleTokenPacketData
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data))
+		require.Equal(t, []transfertypes.FungibleTokenPacketData{data}, app.received)
+		require.Empty(t, app.sent)
+	})
+
+	t.Run("invalid forward memo", func(t *testing.T) {
+		nw, middleware, app, _ := setupMiddleware(t)
+		ctx := nw.GetContext()
+		packet := newPacket(ctx, "uatom", `{"forward":{"receiver":"cosmos1receiver"}}`)
+
+		ack := middleware.OnRecvPacket(ctx, packet, nil)
+		require.False(t, ack.Success())
+		require.Empty(t, app.received)
+	})
+
+	t.Run("forward", func(t *testing.T) {
+		nw, middleware, app, _ := setupMiddleware(t)
+		ctx := nw.GetContext()
+		packet := newPacket(ctx, "uatom", forwardMemo)
+
+		// the acknowledgement is written once the forwarded packet is acknowledged
+		ack := middleware.OnRecvPacket(ctx, packet, nil)
+		require.Nil(t, ack)
+
+		// the tokens are received on the forward receiver account without memo
+		require.Len(t, app.received, 1)
+		require.Equal(t, types.ReceiverAddress.String(), app.received[0].Receiver)
+		require.Empty(t, app.received[0].Memo)
+
+		token := sdk.NewCoin(receivedDenom(packet, app.received[0]), math.NewInt(100))
+		require.Equal(t, &transfertypes.MsgTransfer{
+			SourcePort:       transfertypes.PortID,
+			SourceChannel:    "channel-2",
+			Token:            token,
+			Sender:           types.ReceiverAddress.String(),
+			Receiver:         "cosmos1receiver",
+			TimeoutHeight:    clienttypes.ZeroHeight(),
+			TimeoutTimestamp: uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), //nolint:gosec // G115
+		}, app.sent[1])
+
+		inFlightPacket, found := nw.App.ForwardKeeper.GetInFlightPacket(ctx, transfertypes.PortID, "channel-2", 1)
+		require.True(t, found)
+		require.Equal(t, packet, inFlightPacket.Packet())
+		require.Equal(t, token, inFlightPacket.Token)
+
+		// no tokens are left on this chain
+		require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ReceiverAddress).IsZero())
+		require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ModuleAddress).IsZero())
+	})
+}
+
+func TestOnAcknowledgementPacket(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denom   string
+		ack     channeltypes.Acknowledgement
+		checkFn func(t *testing.T, nw *network.UnitTestNetwork, ctx sdk.Context, token sdk.Coin)
+	}{
+		{
+			name:  "success",
+			denom: "uatom",
+			ack:   channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			checkFn: func(t *testing.T, nw *network.UnitTestNetwork, ctx sdk.Context, token sdk.Coin) {
+				// the tokens were sent to the next hop
+				require.Equal(t, token, nw.App.BankKeeper.GetSupply(ctx, token.Denom))
+			},
+		},
+		{
+			name:  "error - voucher is burned",
+			denom: "uatom",
+			ack:   channeltypes.NewErrorAcknowledgement(errors.New("failed")),
+			checkFn: func(t *testing.T, nw *network.UnitTestNetwork, ctx sdk.Context, token sdk.Coin) {
+				require.True(t, nw.App.BankKeeper.GetSupply(ctx, token.Denom).IsZero())
+			},
+		},
+		{
+			name:  "error - native token is escrowed again",
+			denom: "transfer/channel-0/aevmos",
+			ack:   channeltypes.NewErrorAcknowledgement(errors.New("failed")),
+			checkFn: func(t *testing.T, nw *network.UnitTestNetwork, ctx sdk.Context, token sdk.Coin) {
+				escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+				require.Equal(t, token, nw.App.BankKeeper.GetBalance(ctx, escrowAddress, token.Denom))
+				require.Equal(t, token, nw.App.TransferKeeper.GetTotalEscrowForDenom(ctx, token.Denom))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw, middleware, app, ics4 := setupMiddleware(t)
+			ctx := nw.GetContext()
+			packet := newPacket(ctx, tc.denom, forwardMemo)
+
+			require.Nil(t, middleware.OnRecvPacket(ctx, packet, nil))
+			token := app.sent[1].Token
+
+			err := middleware.OnAcknowledgementPacket(ctx, forwardedPacket(1), tc.ack.Acknowledgement(), nil)
+			require.NoError(t, err)
+
+			_, found := nw.App.ForwardKeeper.GetInFlightPacket(ctx, transfertypes.PortID, "channel-2", 1)
+			require.False(t, found)
+
+			// the acknowledgement of the received packet is written
+			require.Equal(t, tc.ack.Success(), ics4.acks[packet.Sequence].Success())
+
+			require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ReceiverAddress).IsZero())
+			require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ModuleAddress).IsZero())
+			tc.checkFn(t, nw, ctx, token)
+		})
+	}
+}
+
+func TestOnTimeoutPacket(t *testing.T) {
+	nw, middleware, app, ics4 := setupMiddleware(t)
+	ctx := nw.GetContext()
+	packet := newPacket(ctx, "uatom", forwardMemo)
+
+	require.Nil(t, middleware.OnRecvPacket(ctx, packet, nil))
+	token := app.sent[1].Token
+
+	// the packet is sent again while it has retries remaining
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, forwardedPacket(1), nil))
+	require.Equal(t, token, app.sent[2].Token)
+	require.Empty(t, ics4.acks)
+
+	_, found := nw.App.ForwardKeeper.GetInFlightPacket(ctx, transfertypes.PortID, "channel-2", 1)
+	require.False(t, found)
+	inFlightPacket, found := nw.App.ForwardKeeper.GetInFlightPacket(ctx, transfertypes.PortID, "channel-2", 2)
+	require.True(t, found)
+	require.Zero(t, inFlightPacket.RetriesRemaining)
+
+	// the voucher is burned once the packet runs out of retries
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, forwardedPacket(2), nil))
+	require.False(t, ics4.acks[packet.Sequence].Success())
+
+	_, found = nw.App.ForwardKeeper.GetInFlightPacket(ctx, transfertypes.PortID, "channel-2", 2)
+	require.False(t, found)
+	require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ReceiverAddress).IsZero())
+	require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, types.ModuleAddress).IsZero())
+	require.True(t, nw.App.BankKeeper.GetSupply(ctx, token.Denom).IsZero())
+}
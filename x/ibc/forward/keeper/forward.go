@@ -0,0 +1,241 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+// ForwardPacket sends the token of the received packet, already credited to the
+// forward receiver account, to the next hop defined in the forward metadata. The
+// packet is stored as in-flight until the forwarded packet is acknowledged or
+// timed out.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+	defaultTimeout time.Duration,
+	defaultRetries uint8,
+) error {
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	token := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	inFlightPacket := types.NewInFlightPacket(
+		packet, metadata, token, memo,
+		metadata.TimeoutOrDefault(defaultTimeout),
+		metadata.RetriesOrDefault(defaultRetries),
+	)
+
+	if err := k.collect(ctx, token); err != nil {
+		return err
+	}
+
+	if err := k.sendInFlightPacket(ctx, &inFlightPacket); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlightPacket.ForwardSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlightPacket.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// OnForwardAcknowledgement writes the acknowledgement of the received packet
+// once the forwarded packet is acknowledged. On an error acknowledgement, the
+// tokens refunded to the forward receiver account are reverted so that the
+// previous hop refunds the sender. It is a no-op for packets that were not
+// forwarded.
+func (k Keeper) OnForwardAcknowledgement(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if !ack.Success() {
+		if err := k.collect(ctx, inFlightPacket.Token); err != nil {
+			return err
+		}
+		return k.refund(ctx, inFlightPacket, errors.New(ack.GetError()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardSuccess,
+			sdk.NewAttribute(types.AttributeKeyDstChannel, inFlightPacket.DstChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlightPacket.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlightPacket.ForwardSequence, 10)),
+		),
+	)
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// OnForwardTimeout sends the forwarded packet again if it has retries
+// remaining. Otherwise, or if sending it again fails, the tokens refunded to the
+// forward receiver account are reverted so that the previous hop refunds the
+// sender. It is a no-op for packets that were not forwarded.
+func (k Keeper) OnForwardTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if err := k.collect(ctx, inFlightPacket.Token); err != nil {
+		return err
+	}
+
+	if inFlightPacket.RetriesRemaining == 0 {
+		return k.refund(ctx, inFlightPacket, errorsmod.Wrap(types.ErrForwardTransfer, "packet timed out"))
+	}
+
+	inFlightPacket.RetriesRemaining--
+	if err := k.retry(ctx, &inFlightPacket); err != nil {
+		return k.refund(ctx, inFlightPacket, err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRetry,
+			sdk.NewAttribute(types.AttributeKeyDstChannel, inFlightPacket.DstChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlightPacket.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlightPacket.ForwardSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetriesLeft, strconv.FormatUint(uint64(inFlightPacket.RetriesRemaining), 10)),
+		),
+	)
+
+	return nil
+}
+
+// retry sends the in-flight packet again in a cached context, so that a failed
+// attempt does not leave partial state changes before the refund.
+func (k Keeper) retry(ctx sdk.Context, inFlightPacket *types.InFlightPacket) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.sendInFlightPacket(cacheCtx, inFlightPacket); err != nil {
+		return err
+	}
+
+	writeFn()
+	return nil
+}
+
+// collect moves the token credited to the forward receiver account by the
+// transfer application to the forward module account.
+func (k Keeper) collect(ctx sdk.Context, token sdk.Coin) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.ReceiverAddress, types.ModuleName, sdk.NewCoins(token))
+}
+
+// sendInFlightPacket transfers the token of the in-flight packet held by the
+// forward module account to the next hop, through the forward receiver account,
+// and stores it under the new packet sequence.
+func (k Keeper) sendInFlightPacket(ctx sdk.Context, inFlightPacket *types.InFlightPacket) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.ReceiverAddress, sdk.NewCoins(inFlightPacket.Token)); err != nil {
+		return err
+	}
+
+	res, err := k.transferKeeper.Transfer(ctx, inFlightPacket.MsgTransfer(ctx.BlockTime()))
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	inFlightPacket.ForwardSequence = res.Sequence
+	k.SetInFlightPacket(ctx, *inFlightPacket)
+	return nil
+}
+
+// refund reverts the receipt of the token on this chain and writes an error
+// acknowledgement for the received packet, so that the previous hop refunds
+// the sender. The token refunded by the transfer application when the
+// forwarded packet failed is held by the forward module account:
+//   - if this chain is the source of the token, the token was unescrowed on
+//     receipt and is escrowed again on the channel it was received on
+//   - otherwise, the voucher minted on receipt is burned
+func (k Keeper) refund(ctx sdk.Context, inFlightPacket types.InFlightPacket, reason error) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.Data, &data); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(inFlightPacket.Token)
+
+	if transfertypes.ReceiverChainIsSource(inFlightPacket.SrcPortId, inFlightPacket.SrcChannelId, data.Denom) {
+		escrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.DstPortId, inFlightPacket.DstChannelId)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrowAddress, coins); err != nil {
+			return err
+		}
+
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, inFlightPacket.Token.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(inFlightPacket.Token))
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRefund,
+			sdk.NewAttribute(types.AttributeKeyDstChannel, inFlightPacket.DstChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlightPacket.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlightPacket.ForwardSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, reason.Error()),
+		),
+	)
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// writeAcknowledgement writes the asynchronous acknowledgement of the packet
+// received from the previous hop.
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack exported.Acknowledgement) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.DstPortId, inFlightPacket.DstChannelId)
+	if err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, inFlightPacket.Packet(), ack)
+}
@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+// GetInFlightPacket returns the in-flight packet forwarded on the given port
+// and channel with the given sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetInFlightPacket stores an in-flight packet.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.InFlightPacketKey(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence)
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded on the given port
+// and channel with the given sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
+
+// GetInFlightPackets returns all the in-flight packets stored.
+func (k Keeper) GetInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	packets := []types.InFlightPacket{}
+	k.IterateInFlightPackets(ctx, func(packet types.InFlightPacket) (stop bool) {
+		packets = append(packets, packet)
+		return false
+	})
+	return packets
+}
+
+// IterateInFlightPackets iterates over all the in-flight packets stored.
+func (k Keeper) IterateInFlightPackets(
+	ctx sdk.Context,
+	cb func(packet types.InFlightPacket) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if cb(packet) {
+			break
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+func TestInFlightPackets(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.ForwardKeeper
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1,
+		"transfer", "channel-0",
+		"transfer", "channel-1",
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), //nolint:gosec // G115
+	)
+	metadata := types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-2"}
+	inFlightPacket := types.NewInFlightPacket(packet, metadata, sdk.NewCoin("aevmos", math.NewInt(100)), "", time.Hour, 1)
+	inFlightPacket.ForwardSequence = 7
+
+	_, found := k.GetInFlightPacket(ctx, "transfer", "channel-2", 7)
+	require.False(t, found)
+	require.Empty(t, k.GetInFlightPackets(ctx))
+
+	k.SetInFlightPacket(ctx, inFlightPacket)
+
+	stored, found := k.GetInFlightPacket(ctx, "transfer", "channel-2", 7)
+	require.True(t, found)
+	require.Equal(t, inFlightPacket, stored)
+	require.Equal(t, packet, stored.Packet())
+	require.Len(t, k.GetInFlightPackets(ctx), 1)
+
+	// the packet is keyed by the forward channel and sequence
+	_, found = k.GetInFlightPacket(ctx, "transfer", "channel-1", 1)
+	require.False(t, found)
+
+	k.DeleteInFlightPacket(ctx, "transfer", "channel-2", 7)
+	_, found = k.GetInFlightPacket(ctx, "transfer", "channel-2", 7)
+	require.False(t, found)
+	require.Empty(t, k.GetInFlightPackets(ctx))
+}
+
+func TestOnForwardTimeoutNotFound(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1,
+		"transfer", "channel-0",
+		"transfer", "channel-1",
+		clienttypes.ZeroHeight(), 1,
+	)
+
+	// packets not sent by the forward module are ignored
+	require.NoError(t, nw.App.ForwardKeeper.OnForwardTimeout(ctx, packet))
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+// Keeper of the forward module maintains the ICS20 packets forwarded to the
+// next hop until they are acknowledged or timed out.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	transferKeeper types.TransferKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates new instances of the forward Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	transferKeeper types.TransferKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		transferKeeper: transferKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
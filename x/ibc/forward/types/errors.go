@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidForwardMetadata = errorsmod.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardTransfer        = errorsmod.Register(ModuleName, 3, "failed to forward transfer")
+	ErrInFlightPacketNotFound = errorsmod.Register(ModuleName, 4, "in-flight packet not found")
+)
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// forward events
+const (
+	EventTypeForward        = "forward_packet"
+	EventTypeForwardRetry   = "forward_packet_retry"
+	EventTypeForwardRefund  = "forward_packet_refund"
+	EventTypeForwardSuccess = "forward_packet_success"
+
+	AttributeKeySrcChannel      = "src_channel"
+	AttributeKeyDstChannel      = "dst_channel"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyRetriesLeft     = "retries_remaining"
+	AttributeKeyError           = "error"
+)
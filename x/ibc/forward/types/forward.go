@@ -0,0 +1,166 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// ForwardMemoKey is the key of the ICS20 packet memo field that holds the
+	// forward metadata.
+	ForwardMemoKey = "forward"
+
+	// DefaultForwardTimeout is the default relative timeout of the forwarded packets.
+	DefaultForwardTimeout = 28 * 24 * time.Hour
+	// DefaultForwardRetries is the default number of times a forwarded packet
+	// is sent again when it times out.
+	DefaultForwardRetries uint8 = 0
+)
+
+// ForwardMetadata defines the next hop of an ICS20 packet received by this
+// chain. It is set in the packet memo under the ForwardMemoKey, following the
+// packet-forward-middleware format, e.g.:
+//
+//	{"forward": {"receiver": "cosmos1...", "port": "transfer", "channel": "channel-1", "timeout": "10m", "retries": 2}}
+//
+// The optional next field holds the memo of the forwarded packet, which may
+// contain the forward metadata of a further hop.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  Duration        `json:"timeout,omitempty"`
+	Retries  *uint8          `json:"retries,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration that is unmarshalled from either a duration
+// string (e.g. "10m") or a number of nanoseconds.
+type Duration time.Duration
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var nanos int64
+	if err := json.Unmarshal(bz, &nanos); err == nil {
+		*d = Duration(nanos)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// ParseForwardMetadata returns the forward metadata set in the given ICS20 packet
+// memo. It returns false if the memo is not a JSON object or does not contain the
+// ForwardMemoKey, and an error if the forward metadata is malformed.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, bool, error) {
+	if memo == "" {
+		return nil, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// memos that are not JSON objects are not meant for forwarding
+		return nil, false, nil //nolint:nilerr
+	}
+
+	raw, found := fields[ForwardMemoKey]
+	if !found {
+		return nil, false, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, true, errorsmod.Wrapf(ErrInvalidForwardMetadata, "failed to unmarshal %q memo: %s", ForwardMemoKey, err)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return &metadata, true, nil
+}
+
+// Validate performs a stateless validation of the forward metadata.
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "timeout cannot be negative: %s", time.Duration(m.Timeout))
+	}
+
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NextMemo returns the memo of the forwarded packet. The next field can either
+// be a JSON object or a string holding a JSON object.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	if len(m.Next) == 0 || string(m.Next) == "null" {
+		return "", nil
+	}
+
+	var next string
+	if err := json.Unmarshal(m.Next, &next); err != nil {
+		next = string(m.Next)
+	}
+
+	if next != "" && !json.Valid([]byte(next)) {
+		return "", errorsmod.Wrap(ErrInvalidForwardMetadata, "next must be a JSON object")
+	}
+
+	return next, nil
+}
+
+// TimeoutOrDefault returns the relative timeout of the forwarded packet or the
+// given default if not set.
+func (m ForwardMetadata) TimeoutOrDefault(defaultTimeout time.Duration) time.Duration {
+	if m.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// RetriesOrDefault returns the number of retries of the forwarded packet or the
+// given default if not set.
+func (m ForwardMetadata) RetriesOrDefault(defaultRetries uint8) uint8 {
+	if m.Retries == nil {
+		return defaultRetries
+	}
+	return *m.Retries
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expErr      bool
+		expTimeout  time.Duration
+		expRetries  uint8
+		expNextMemo string
+	}{
+		{
+			"not found - empty memo",
+			"",
+			false, false, 0, 0, "",
+		},
+		{
+			"not found - memo is not JSON",
+			"hello",
+			false, false, 0, 0, "",
+		},
+		{
+			"not found - no forward key",
+			`{"evm": {"contract": "0x"}}`,
+			false, false, 0, 0, "",
+		},
+		{
+			"pass - defaults",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1"}}`,
+			true, false, types.DefaultForwardTimeout, types.DefaultForwardRetries, "",
+		},
+		{
+			"pass - timeout as duration string and retries",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "timeout": "10m", "retries": 2}}`,
+			true, false, 10 * time.Minute, 2, "",
+		},
+		{
+			"pass - timeout as nanoseconds",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "timeout": 60000000000}}`,
+			true, false, time.Minute, types.DefaultForwardRetries, "",
+		},
+		{
+			"pass - next as object",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "next": {"forward": {"receiver": "osmo1receiver", "port": "transfer", "channel": "channel-2"}}}}`,
+			true, false, types.DefaultForwardTimeout, types.DefaultForwardRetries,
+			`{"forward": {"receiver": "osmo1receiver", "port": "transfer", "channel": "channel-2"}}`,
+		},
+		{
+			"pass - next as string",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "next": "{\"foo\":\"bar\"}"}}`,
+			true, false, types.DefaultForwardTimeout, types.DefaultForwardRetries, `{"foo":"bar"}`,
+		},
+		{
+			"fail - next is not JSON",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "next": "foo"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - empty receiver",
+			`{"forward": {"receiver": "", "port": "transfer", "channel": "channel-1"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - invalid port",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "", "channel": "channel-1"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - invalid channel",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "c"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - invalid timeout",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "timeout": "ten minutes"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - negative timeout",
+			`{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "timeout": "-1m"}}`,
+			true, true, 0, 0, "",
+		},
+		{
+			"fail - forward is not an object",
+			`{"forward": "cosmos1receiver"}`,
+			true, true, 0, 0, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := types.ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.expFound, found)
+
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidForwardMetadata)
+				return
+			}
+
+			require.NoError(t, err)
+			if !tc.expFound {
+				require.Nil(t, metadata)
+				return
+			}
+
+			require.Equal(t, tc.expTimeout, metadata.TimeoutOrDefault(types.DefaultForwardTimeout))
+			require.Equal(t, tc.expRetries, metadata.RetriesOrDefault(types.DefaultForwardRetries))
+
+			next, err := metadata.NextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.expNextMemo, next)
+		})
+	}
+}
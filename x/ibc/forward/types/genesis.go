@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState sets default forward genesis state with no in-flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, packet := range gs.InFlightPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(InFlightPacketKey(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence))
+		if seen[key] {
+			return fmt.Errorf("duplicated in-flight packet %s/%s/%d", packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/forward/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	// in_flight_packets is a slice of the forwarded packets awaiting an
+	// acknowledgement or a timeout at genesis
+	InFlightPackets []InFlightPacket `protobuf:"bytes,1,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ea94e4238dc3896, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
+// InFlightPacket defines a packet forwarded to the next hop. The packet
+// received from the previous hop is acknowledged once the forwarded packet is
+// acknowledged, or refunded if it fails after all the retries.
+type InFlightPacket struct {
+	// src_port_id is the source port of the received packet
+	SrcPortId string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
+	// src_channel_id is the source channel of the received packet
+	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
+	// dst_port_id is the destination port of the received packet on this chain
+	DstPortId string `protobuf:"bytes,3,opt,name=dst_port_id,json=dstPortId,proto3" json:"dst_port_id,omitempty"`
+	// dst_channel_id is the destination channel of the received packet on this chain
+	DstChannelId string `protobuf:"bytes,4,opt,name=dst_channel_id,json=dstChannelId,proto3" json:"dst_channel_id,omitempty"`
+	// sequence is the sequence of the received packet
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the ICS20 packet data of the received packet
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// timeout_revision_number is the revision number of the received packet timeout height
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	// timeout_revision_height is the revision height of the received packet timeout height
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the received packet
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// forward_port_id is the port the packet is forwarded on
+	ForwardPortId string `protobuf:"bytes,10,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty"`
+	// forward_channel_id is the channel the packet is forwarded on
+	ForwardChannelId string `protobuf:"bytes,11,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty"`
+	// forward_sequence is the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,12,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// receiver is the receiver on the next hop
+	Receiver string `protobuf:"bytes,13,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// token is the token forwarded to the next hop
+	Token types.Coin `protobuf:"bytes,14,opt,name=token,proto3" json:"token"`
+	// memo is the memo of the forwarded packet
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout is the relative timeout in nanoseconds of the forwarded packet
+	Timeout uint64 `protobuf:"varint,16,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retries_remaining is the number of times the packet is sent again on timeout
+	RetriesRemaining uint32 `protobuf:"varint,17,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ea94e4238dc3896, []int{1}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetSrcPortId() string {
+	if m != nil {
+		return m.SrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSrcChannelId() string {
+	if m != nil {
+		return m.SrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDstPortId() string {
+	if m != nil {
+		return m.DstPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDstChannelId() string {
+	if m != nil {
+		return m.DstChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *InFlightPacket) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "evmos.forward.v1.GenesisState")
+	proto.RegisterType((*InFlightPacket)(nil), "evmos.forward.v1.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("evmos/forward/v1/genesis.proto", fileDescriptor_3ea94e4238dc3896) }
+
+var fileDescriptor_3ea94e4238dc3896 = []byte{
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0x6d, 0x98, 0x67, 0xdd, 0x4e, 0x1f, 0x16, 0x08, 0xd3, 0x45, 0x88, 0x46, 0x08, 0x85, 0x87,
+	0x12, 0x5a, 0x24, 0x16, 0x2c, 0x67, 0x24, 0x98, 0x6e, 0xd0, 0x28, 0x83, 0x84, 0xc4, 0x26, 0xca,
+	0xe3, 0x4e, 0x6a, 0xcd, 0xc4, 0x2e, 0xb6, 0x1b, 0xe0, 0x2f, 0xf8, 0x01, 0xf6, 0x2c, 0xf9, 0x8c,
+	0x59, 0xce, 0x92, 0x15, 0x42, 0xed, 0x82, 0xdf, 0x40, 0x71, 0x9c, 0xb4, 0x83, 0x66, 0xe3, 0x5c,
+	0xdf, 0x73, 0xee, 0xb1, 0x73, 0x7c, 0x2f, 0xb2, 0xa1, 0xc8, 0xb9, 0xf4, 0xcf, 0xb9, 0xf8, 0x1c,
+	0x89, 0xd4, 0x2f, 0xc6, 0x7e, 0x06, 0x0c, 0x24, 0x95, 0xde, 0x5c, 0x70, 0xc5, 0xf1, 0x40, 0xe3,
+	0x9e, 0xc1, 0xbd, 0x62, 0x3c, 0x1a, 0x46, 0x39, 0x65, 0xdc, 0xd7, 0x6b, 0x45, 0x1a, 0xd9, 0x09,
+	0x97, 0xa5, 0x4a, 0x1c, 0x49, 0xf0, 0x8b, 0x71, 0x0c, 0x2a, 0x1a, 0xfb, 0x09, 0xa7, 0xcc, 0xe0,
+	0x77, 0x33, 0x9e, 0x71, 0x1d, 0xfa, 0x65, 0x54, 0x65, 0x0f, 0x33, 0xd4, 0x7d, 0x5b, 0x9d, 0x75,
+	0xa6, 0x22, 0x05, 0xf8, 0x03, 0x1a, 0x52, 0x16, 0x9e, 0x5f, 0xd2, 0x6c, 0xa6, 0xc2, 0x79, 0x94,
+	0x5c, 0x80, 0x92, 0xc4, 0x72, 0xb6, 0xdc, 0xce, 0xc4, 0xf1, 0xfe, 0xbf, 0x86, 0x37, 0x65, 0x6f,
+	0x34, 0xf3, 0x54, 0x13, 0x8f, 0xda, 0x57, 0xbf, 0x1f, 0xb6, 0x7e, 0xfc, 0xfd, 0xf9, 0xd4, 0x0a,
+	0xfa, 0xf4, 0x06, 0x24, 0x0f, 0xbf, 0xef, 0xa0, 0xde, 0x4d, 0x3a, 0xb6, 0x51, 0x47, 0x8a, 0x24,
+	0x9c, 0x73, 0xa1, 0x42, 0x9a, 0x12, 0xcb, 0xb1, 0xdc, 0x76, 0xd0, 0x96, 0x22, 0x39, 0xe5, 0x42,
+	0x4d, 0x53, 0xfc, 0x08, 0xf5, 0x4a, 0x3c, 0x99, 0x45, 0x8c, 0xc1, 0x65, 0x49, 0xb9, 0xa3, 0x29,
+	0x5d, 0x29, 0x92, 0xe3, 0x2a, 0x39, 0x4d, 0x4b, 0x95, 0x54, 0xaa, 0x46, 0x65, 0xab, 0x52, 0x49,
+	0xa5, 0x5a, 0xab, 0x94, 0xf8, 0x86, 0xca, 0x76, 0xa5, 0x92, 0x4a, 0xb5, 0x56, 0x19, 0xa1, 0x7d,
+	0x09, 0x9f, 0x16, 0xc0, 0x12, 0x20, 0x3b, 0x8e, 0xe5, 0x6e, 0x07, 0xcd, 0x1e, 0x63, 0xb4, 0x9d,
+	0x46, 0x2a, 0x22, 0xbb, 0x8e, 0xe5, 0x76, 0x03, 0x1d, 0xe3, 0x57, 0xe8, 0xbe, 0xa2, 0x39, 0xf0,
+	0x85, 0x0a, 0x05, 0x14, 0x54, 0x52, 0xce, 0x42, 0xb6, 0xc8, 0x63, 0x10, 0x64, 0x4f, 0x97, 0xdf,
+	0x33, 0x70, 0x60, 0xd0, 0x77, 0x1a, 0xbc, 0xb5, 0x6e, 0x06, 0xa5, 0x29, 0x64, 0xff, 0xd6, 0xba,
+	0x13, 0x0d, 0xe2, 0x67, 0x68, 0x58, 0xd7, 0x95, 0x5f, 0xa9, 0xa2, 0x7c, 0x4e, 0xda, 0xba, 0x62,
+	0x60, 0x80, 0xf7, 0x75, 0x1e, 0x3f, 0x46, 0x7d, 0xf3, 0x48, 0x8d, 0x2d, 0x48, 0xff, 0xf3, 0x81,
+	0x49, 0x1b, 0x6b, 0x9e, 0x23, 0x5c, 0xf3, 0x36, 0xec, 0xe9, 0x68, 0xea, 0xc0, 0x20, 0x6b, 0x8b,
+	0x9e, 0xa0, 0x3a, 0x17, 0x36, 0x56, 0x75, 0xf5, 0x0d, 0xea, 0xd3, 0xce, 0x6a, 0xc7, 0x46, 0x68,
+	0x5f, 0x40, 0x02, 0xb4, 0x00, 0x41, 0x0e, 0xb4, 0x5c, 0xb3, 0xc7, 0xaf, 0xd1, 0x8e, 0xe2, 0x17,
+	0xc0, 0x48, 0xcf, 0xb1, 0xdc, 0xce, 0xe4, 0x81, 0x57, 0xf5, 0xad, 0x57, 0xf6, 0xad, 0x67, 0xfa,
+	0xd6, 0x3b, 0xe6, 0x94, 0x6d, 0xb6, 0x53, 0x55, 0x52, 0xbe, 0x44, 0x0e, 0x39, 0x27, 0x7d, 0xad,
+	0xa9, 0x63, 0x4c, 0xd0, 0x9e, 0x31, 0x80, 0x0c, 0xf4, 0x6d, 0xea, 0x6d, 0xe9, 0x99, 0x00, 0x25,
+	0x28, 0xc8, 0x50, 0x40, 0x1e, 0x51, 0x46, 0x59, 0x46, 0x86, 0x8e, 0xe5, 0x1e, 0x04, 0x03, 0x03,
+	0x04, 0x75, 0xfe, 0xe8, 0xe4, 0x6a, 0x69, 0x5b, 0xd7, 0x4b, 0xdb, 0xfa, 0xb3, 0xb4, 0xad, 0x6f,
+	0x2b, 0xbb, 0x75, 0xbd, 0xb2, 0x5b, 0xbf, 0x56, 0x76, 0xeb, 0xa3, 0x97, 0x51, 0x35, 0x5b, 0xc4,
+	0x5e, 0xc2, 0x73, 0xbf, 0x1a, 0xd4, 0x6a, 0x2d, 0x26, 0x2f, 0xfc, 0x2f, 0x3e, 0x8d, 0x93, 0x66,
+	0x70, 0xd5, 0xd7, 0x39, 0xc8, 0x78, 0x57, 0x4f, 0xd6, 0xcb, 0x7f, 0x03, 0x00, 0x7f, 0x71, 0x4c,
+	0x51, 0xd6, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ForwardSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DstChannelId) > 0 {
+		i -= len(m.DstChannelId)
+		copy(dAtA[i:], m.DstChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DstChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DstPortId) > 0 {
+		i -= len(m.DstPortId)
+		copy(dAtA[i:], m.DstPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DstPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcChannelId) > 0 {
+		i -= len(m.SrcChannelId)
+		copy(dAtA[i:], m.SrcChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SrcChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcPortId) > 0 {
+		i -= len(m.SrcPortId)
+		copy(dAtA[i:], m.SrcPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SrcPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.SrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DstPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DstChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardSequence))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 2 + sovGenesis(uint64(m.Timeout))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 2 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+			}
+			m.TimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+			}
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
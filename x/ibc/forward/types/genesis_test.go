@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/ibc/forward/types"
+)
+
+func newInFlightPacket(forwardSequence uint64) types.InFlightPacket {
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1,
+		"transfer", "channel-0",
+		"transfer", "channel-1",
+		clienttypes.ZeroHeight(), uint64(time.Now().UnixNano()), //nolint:gosec // G115
+	)
+	metadata := types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-2"}
+
+	inFlightPacket := types.NewInFlightPacket(packet, metadata, sdk.NewCoin("aevmos", math.NewInt(100)), "", time.Hour, 1)
+	inFlightPacket.ForwardSequence = forwardSequence
+	return inFlightPacket
+}
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"pass - default genesis",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"pass - empty genesis",
+			&types.GenesisState{},
+			true,
+		},
+		{
+			"pass - valid genesis",
+			types.NewGenesisState([]types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2)}),
+			true,
+		},
+		{
+			"fail - duplicated in-flight packet",
+			types.NewGenesisState([]types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(1)}),
+			false,
+		},
+		{
+			"fail - zero forward sequence",
+			types.NewGenesisState([]types.InFlightPacket{newInFlightPacket(0)}),
+			false,
+		},
+		{
+			"fail - invalid token",
+			types.NewGenesisState([]types.InFlightPacket{
+				func() types.InFlightPacket {
+					packet := newInFlightPacket(1)
+					packet.Token = sdk.Coin{Denom: "", Amount: math.NewInt(100)}
+					return packet
+				}(),
+			}),
+			false,
+		},
+		{
+			"fail - zero timeout",
+			types.NewGenesisState([]types.InFlightPacket{
+				func() types.InFlightPacket {
+					packet := newInFlightPacket(1)
+					packet.Timeout = 0
+					return packet
+				}(),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewInFlightPacket creates a new in-flight packet forwarding the token of the
+// received packet to the next hop defined in the forward metadata.
+func NewInFlightPacket(
+	packet channeltypes.Packet,
+	metadata ForwardMetadata,
+	token sdk.Coin,
+	memo string,
+	timeout time.Duration,
+	retries uint8,
+) InFlightPacket {
+	return InFlightPacket{
+		SrcPortId:             packet.SourcePort,
+		SrcChannelId:          packet.SourceChannel,
+		DstPortId:             packet.DestinationPort,
+		DstChannelId:          packet.DestinationChannel,
+		Sequence:              packet.Sequence,
+		Data:                  packet.Data,
+		TimeoutRevisionNumber: packet.TimeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: packet.TimeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      packet.TimeoutTimestamp,
+		ForwardPortId:         metadata.Port,
+		ForwardChannelId:      metadata.Channel,
+		Receiver:              metadata.Receiver,
+		Token:                 token,
+		Memo:                  memo,
+		Timeout:               uint64(timeout.Nanoseconds()), //nolint:gosec // G115 -- timeout is validated to be positive
+		RetriesRemaining:      uint32(retries),
+	}
+}
+
+// Packet returns the packet received from the previous hop.
+func (p InFlightPacket) Packet() channeltypes.Packet {
+	return channeltypes.NewPacket(
+		p.Data,
+		p.Sequence,
+		p.SrcPortId,
+		p.SrcChannelId,
+		p.DstPortId,
+		p.DstChannelId,
+		clienttypes.NewHeight(p.TimeoutRevisionNumber, p.TimeoutRevisionHeight),
+		p.TimeoutTimestamp,
+	)
+}
+
+// MsgTransfer returns the transfer message that forwards the token to the next
+// hop from the forward receiver account.
+func (p InFlightPacket) MsgTransfer(blockTime time.Time) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		p.ForwardPortId,
+		p.ForwardChannelId,
+		p.Token,
+		ReceiverAddress.String(),
+		p.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(blockTime.UnixNano())+p.Timeout, //nolint:gosec // G115
+		p.Memo,
+	)
+}
+
+// Validate performs a stateless validation of the in-flight packet.
+func (p InFlightPacket) Validate() error {
+	if err := p.Packet().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid received packet")
+	}
+
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return errorsmod.Wrap(err, "invalid forward port")
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid forward channel")
+	}
+
+	if p.ForwardSequence == 0 {
+		return fmt.Errorf("forward sequence cannot be 0")
+	}
+
+	if strings.TrimSpace(p.Receiver) == "" {
+		return fmt.Errorf("receiver cannot be empty")
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid token")
+	}
+
+	if p.Timeout == 0 {
+		return fmt.Errorf("timeout cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "forward"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// ReceiverName is the name of the module account that the transfer
+	// application credits with the tokens of the forwarded packets
+	ReceiverName = ModuleName + "_receiver"
+)
+
+var (
+	// ModuleAddress is the address of the forward module account, which holds
+	// the tokens of the in-flight packets. It is a blocked address, so that it
+	// cannot receive tokens from users.
+	ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+
+	// ReceiverAddress is the address of the module account that receives the
+	// tokens of the forwarded packets and their refunds from the transfer
+	// application, which rejects blocked receivers. It only passes the tokens
+	// to and from the forward module account within the same transaction.
+	ReceiverAddress = authtypes.NewModuleAddress(ReceiverName)
+)
+
+// prefix bytes for the forward persistent store
+const (
+	prefixInFlightPacket = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixInFlightPacket = []byte{prefixInFlightPacket}
+)
+
+// InFlightPacketKey returns the key of the in-flight packet forwarded on the
+// given port and channel with the given sequence.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyPrefixInFlightPacket...)
+	key = append(key, []byte(portID+"/"+channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
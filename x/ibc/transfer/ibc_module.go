@@ -4,7 +4,9 @@
 package transfer
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
 )
@@ -14,6 +16,7 @@ var _ porttypes.IBCModule = IBCModule{}
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
 type IBCModule struct {
 	*ibctransfer.IBCModule
+	keeper keeper.Keeper
 }
 
 // NewIBCModule creates a new IBCModule given the keeper
@@ -21,5 +24,37 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 	transferModule := ibctransfer.NewIBCModule(*k.Keeper)
 	return IBCModule{
 		IBCModule: &transferModule,
+		keeper:    k,
 	}
 }
+
+// OnAcknowledgementPacket implements the IBCModule interface. It removes the
+// acknowledged packet from the pending packets of its sender.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.DeletePendingPacket(ctx, packet)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It removes the timed out
+// packet from the pending packets of its sender.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.DeletePendingPacket(ctx, packet)
+	return nil
+}
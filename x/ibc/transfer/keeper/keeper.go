@@ -18,17 +18,22 @@ import (
 
 // Keeper defines the modified IBC transfer keeper that embeds the original one.
 // It also contains the bank keeper and the erc20 keeper to support ERC20 tokens
-// to be sent via IBC.
+// to be sent via IBC, and indexes the outbound packets pending by sender.
 type Keeper struct {
 	*keeper.Keeper
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
 	bankKeeper    types.BankKeeper
 	erc20Keeper   types.ERC20Keeper
 	accountKeeper types.AccountKeeper
 }
 
-// NewKeeper creates a new IBC transfer Keeper instance
+// NewKeeper creates a new IBC transfer Keeper instance. The transfer store key is
+// used by the embedded ibc-go keeper, while the store key holds the pending
+// packets index.
 func NewKeeper(
 	cdc codec.BinaryCodec,
+	transferStoreKey storetypes.StoreKey,
 	storeKey storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
 
@@ -43,7 +48,7 @@ func NewKeeper(
 ) Keeper {
 	// create the original IBC transfer keeper for embedding
 	transferKeeper := keeper.NewKeeper(
-		cdc, storeKey, paramSpace,
+		cdc, transferStoreKey, paramSpace,
 		ics4Wrapper, channelKeeper, portKeeper,
 		accountKeeper, bankKeeper, scopedKeeper,
 		authority,
@@ -51,6 +56,8 @@ func NewKeeper(
 
 	return Keeper{
 		Keeper:        &transferKeeper,
+		storeKey:      storeKey,
+		cdc:           cdc,
 		bankKeeper:    bankKeeper,
 		erc20Keeper:   erc20Keeper,
 		accountKeeper: accountKeeper,
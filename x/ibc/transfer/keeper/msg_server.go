@@ -26,9 +26,21 @@ var _ types.MsgServer = Keeper{}
 // registered through governance.
 // If user doesn't have enough balance of coin, it will attempt to convert
 // ERC20 tokens to the coin denomination, and continue with a regular transfer.
+// The packets sent are indexed by sender until they are acknowledged or time out.
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	res, err := k.transfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.indexPendingPacket(ctx, res.Sequence, *msg)
+	return res, nil
+}
+
+// transfer converts the ERC20 tokens if needed and performs the ICS20 transfer.
+func (k Keeper) transfer(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	// Temporarily save the KV and transient KV gas config. To avoid extra costs for relayers
 	// these two gas config are replaced with empty one and should be restored before exiting this function.
 	kvGasCfg := ctx.KVGasConfig()
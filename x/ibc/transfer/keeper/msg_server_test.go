@@ -15,6 +15,7 @@ import (
 	testutils "github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+	evmostransfertypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -288,7 +289,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 			ctx = suite.network.GetContext()
 
 			suite.network.App.TransferKeeper = keeper.NewKeeper(
-				suite.network.App.AppCodec(), suite.network.App.GetKey(types.StoreKey), suite.network.App.GetSubspace(types.ModuleName),
+				suite.network.App.AppCodec(), suite.network.App.GetKey(types.StoreKey), suite.network.App.GetKey(evmostransfertypes.StoreKey), suite.network.App.GetSubspace(types.ModuleName),
 				&MockICS4Wrapper{}, // ICS4 Wrapper
 				mockChannelKeeper, suite.network.App.IBCKeeper.PortKeeper,
 				suite.network.App.AccountKeeper, suite.network.App.BankKeeper, suite.network.App.ScopedTransferKeeper,
@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v20/x/ibc/transfer/types"
+)
+
+// GetPendingPacket returns the pending packet sent by the sender on the given
+// port and channel with the given sequence.
+func (k Keeper) GetPendingPacket(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	portID, channelID string,
+	sequence uint64,
+) (types.PendingPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingPacketKey(sender, portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingPacket{}, false
+	}
+
+	var packet types.PendingPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPendingPacket indexes the given packet by its sender.
+func (k Keeper) SetPendingPacket(ctx sdk.Context, packet types.PendingPacket) {
+	sender, err := sdk.AccAddressFromBech32(packet.Sender)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.PendingPacketKey(sender, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// indexPendingPacket indexes the packet sent by the transfer with the given
+// sequence. The packet is removed from the index by the acknowledgement and
+// timeout callbacks of the transfer module.
+func (k Keeper) indexPendingPacket(ctx sdk.Context, sequence uint64, msg transfertypes.MsgTransfer) {
+	k.SetPendingPacket(ctx, types.NewPendingPacket(sequence, msg))
+}
+
+// DeletePendingPacket removes the given packet from the pending packets index
+// of its sender. It is a no-op for packets that are not ICS20 packets.
+func (k Keeper) DeletePendingPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingPacketKey(sender, packet.SourcePort, packet.SourceChannel, packet.Sequence))
+}
+
+// GetPendingPackets returns the packets sent by the sender that have not been
+// acknowledged nor timed out yet, ordered by port, channel and sequence.
+func (k Keeper) GetPendingPackets(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.PendingPacket, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingPacketsKey(sender))
+
+	packets := []types.PendingPacket{}
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var packet types.PendingPacket
+		if err := k.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return packets, pageRes, nil
+}
+
+// GetAllPendingPackets returns the pending packets of all the senders.
+func (k Keeper) GetAllPendingPackets(ctx sdk.Context) []types.PendingPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	packets := []types.PendingPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
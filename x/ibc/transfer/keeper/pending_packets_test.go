@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmostransfertypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestPendingPackets() {
+	ctx := suite.network.GetContext()
+	k := suite.network.App.TransferKeeper
+	sender := suite.keyring.GetKey(0).AccAddr
+	other := suite.keyring.GetKey(1).AccAddr
+	token := sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(10))
+
+	msg0 := transfertypes.NewMsgTransfer("transfer", "channel-0", token, sender.String(), "cosmos1receiver", timeoutHeight, 0, "")
+	msg1 := transfertypes.NewMsgTransfer("transfer", "channel-1", token, sender.String(), "cosmos1receiver", timeoutHeight, 0, "memo")
+	msgOther := transfertypes.NewMsgTransfer("transfer", "channel-0", token, other.String(), "cosmos1receiver", timeoutHeight, 0, "")
+
+	k.SetPendingPacket(ctx, evmostransfertypes.NewPendingPacket(2, *msg1))
+	k.SetPendingPacket(ctx, evmostransfertypes.NewPendingPacket(1, *msg0))
+	k.SetPendingPacket(ctx, evmostransfertypes.NewPendingPacket(3, *msgOther))
+
+	packet, found := k.GetPendingPacket(ctx, sender, "transfer", "channel-1", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(evmostransfertypes.NewPendingPacket(2, *msg1), packet)
+	suite.Require().Equal(timeoutHeight, packet.TimeoutHeight())
+
+	// packets are returned by sender, ordered by port, channel and sequence
+	packets, pageRes, err := k.GetPendingPackets(ctx, sender, &query.PageRequest{CountTotal: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), pageRes.Total)
+	suite.Require().Len(packets, 2)
+	suite.Require().Equal(evmostransfertypes.NewPendingPacket(1, *msg0), packets[0])
+	suite.Require().Equal(uint64(2), packets[1].Sequence)
+
+	packets, pageRes, err = k.GetPendingPackets(ctx, sender, &query.PageRequest{Limit: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	suite.Require().NotNil(pageRes.NextKey)
+
+	// acknowledged or timed out packets are removed from the index
+	data := transfertypes.NewFungibleTokenPacketData(token.Denom, token.Amount.String(), sender.String(), "cosmos1receiver", "")
+	k.DeletePendingPacket(ctx, channeltypes.NewPacket(
+		data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-7", timeoutHeight, 0,
+	))
+
+	_, found = k.GetPendingPacket(ctx, sender, "transfer", "channel-0", 1)
+	suite.Require().False(found)
+
+	packets, _, err = k.GetPendingPackets(ctx, sender, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+
+	packets, _, err = k.GetPendingPackets(ctx, other, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	suite.Require().Equal(uint64(3), packets[0].Sequence)
+
+	// non ICS20 packets are ignored
+	k.DeletePendingPacket(ctx, channeltypes.NewPacket(
+		[]byte("data"), 2, "transfer", "channel-1", "transfer", "channel-7", timeoutHeight, 0,
+	))
+	_, found = k.GetPendingPacket(ctx, sender, "transfer", "channel-1", 2)
+	suite.Require().True(found)
+}
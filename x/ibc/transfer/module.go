@@ -4,14 +4,19 @@
 package transfer
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+	evmostypes "github.com/evmos/evmos/v20/x/ibc/transfer/types"
 )
 
 var (
@@ -19,11 +24,48 @@ var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
 
+// pendingPacketsGenesisField is the field of the transfer genesis state that
+// holds the pending packets index, next to the ibc-go transfer genesis fields.
+const pendingPacketsGenesisField = "pending_packets"
+
 // AppModuleBasic embeds the IBC Transfer AppModuleBasic
 type AppModuleBasic struct {
 	*ibctransfer.AppModuleBasic
 }
 
+// DefaultGenesis returns the default ibc-go transfer genesis state together with
+// the default pending packets index.
+func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	bz, err := mergeGenesis(
+		b.AppModuleBasic.DefaultGenesis(cdc),
+		cdc.MustMarshalJSON(evmostypes.DefaultGenesisState()),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateGenesis performs the validation of the ibc-go transfer genesis state
+// and of the pending packets index.
+func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	transferBz, pendingBz, err := splitGenesis(bz)
+	if err != nil {
+		return fmt.Errorf("failed to split %s genesis state: %w", types.ModuleName, err)
+	}
+
+	if err := b.AppModuleBasic.ValidateGenesis(cdc, config, transferBz); err != nil {
+		return err
+	}
+
+	var gs evmostypes.GenesisState
+	if err := cdc.UnmarshalJSON(pendingBz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s pending packets genesis state: %w", types.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
 // AppModule represents the AppModule for this module
 type AppModule struct {
 	*ibctransfer.AppModule
@@ -62,3 +104,76 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		panic(fmt.Errorf("failed to migrate transfer app from version 4 to 5 (set denom metadata migration): %v", err))
 	}
 }
+
+// InitGenesis performs the genesis initialization of the ibc-go transfer module
+// and of the pending packets index. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	transferBz, pendingBz, err := splitGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+
+	am.AppModule.InitGenesis(ctx, cdc, transferBz)
+
+	var gs evmostypes.GenesisState
+	cdc.MustUnmarshalJSON(pendingBz, &gs)
+	for _, packet := range gs.PendingPackets {
+		am.keeper.SetPendingPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns the exported ibc-go transfer genesis state together
+// with the pending packets index.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := evmostypes.NewGenesisState(am.keeper.GetAllPendingPackets(ctx))
+
+	bz, err := mergeGenesis(am.AppModule.ExportGenesis(ctx, cdc), cdc.MustMarshalJSON(gs))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// mergeGenesis adds the fields of the pending packets genesis state to the
+// ibc-go transfer genesis state, so that both are kept under the transfer
+// module entry of the application genesis.
+func mergeGenesis(transferBz, pendingBz json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(transferBz, &fields); err != nil {
+		return nil, err
+	}
+
+	var pendingFields map[string]json.RawMessage
+	if err := json.Unmarshal(pendingBz, &pendingFields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range pendingFields {
+		fields[key] = value
+	}
+
+	return json.Marshal(fields)
+}
+
+// splitGenesis separates the pending packets genesis state from the ibc-go
+// transfer genesis state. Genesis states without pending packets are valid.
+func splitGenesis(bz json.RawMessage) (transferBz, pendingBz json.RawMessage, err error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, nil, err
+	}
+
+	pendingFields := make(map[string]json.RawMessage)
+	if value, found := fields[pendingPacketsGenesisField]; found {
+		pendingFields[pendingPacketsGenesisField] = value
+		delete(fields, pendingPacketsGenesisField)
+	}
+
+	if transferBz, err = json.Marshal(fields); err != nil {
+		return nil, nil, err
+	}
+	if pendingBz, err = json.Marshal(pendingFields); err != nil {
+		return nil, nil, err
+	}
+	return transferBz, pendingBz, nil
+}
@@ -0,0 +1,68 @@
+package transfer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/ibc/transfer"
+	"github.com/evmos/evmos/v20/x/ibc/transfer/types"
+)
+
+func TestGenesis(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	cdc := nw.App.AppCodec()
+	basic := transfer.AppModuleBasic{AppModuleBasic: &ibctransfer.AppModuleBasic{}}
+
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	token := sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(10))
+	msg := transfertypes.NewMsgTransfer("transfer", "channel-0", token, sender.String(), "cosmos1receiver", clienttypes.NewHeight(1, 100), 0, "memo")
+	packet := types.NewPendingPacket(1, *msg)
+	nw.App.TransferKeeper.SetPendingPacket(ctx, packet)
+
+	// the pending packets are exported next to the ibc-go transfer genesis state
+	bz := transfer.NewAppModule(nw.App.TransferKeeper).ExportGenesis(ctx, cdc)
+	require.NoError(t, basic.ValidateGenesis(cdc, nil, bz))
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Contains(t, fields, "port_id")
+	require.Contains(t, fields, "pending_packets")
+
+	imported := network.NewUnitTestNetwork()
+	importedCtx := imported.GetContext()
+	transfer.NewAppModule(imported.App.TransferKeeper).InitGenesis(importedCtx, cdc, bz)
+
+	stored, found := imported.App.TransferKeeper.GetPendingPacket(importedCtx, sender, "transfer", "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, packet, stored)
+	require.Equal(t, transfertypes.PortID, imported.App.TransferKeeper.GetPort(importedCtx))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	cdc := network.NewUnitTestNetwork().App.AppCodec()
+	basic := transfer.AppModuleBasic{AppModuleBasic: &ibctransfer.AppModuleBasic{}}
+
+	// the default genesis state has no pending packets
+	require.NoError(t, basic.ValidateGenesis(cdc, nil, basic.DefaultGenesis(cdc)))
+
+	// ibc-go transfer genesis states without pending packets are valid
+	require.NoError(t, basic.ValidateGenesis(cdc, nil, cdc.MustMarshalJSON(transfertypes.DefaultGenesisState())))
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(basic.DefaultGenesis(cdc), &fields))
+	fields["pending_packets"] = json.RawMessage(`[{"source_port":"transfer","source_channel":"channel-0","sequence":"0"}]`)
+	bz, err := json.Marshal(fields)
+	require.NoError(t, err)
+	require.ErrorContains(t, basic.ValidateGenesis(cdc, nil, bz), "sequence cannot be 0")
+}
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(pendingPackets []PendingPacket) *GenesisState {
+	return &GenesisState{
+		PendingPackets: pendingPackets,
+	}
+}
+
+// DefaultGenesisState sets default genesis state with no pending packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PendingPackets: []PendingPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, packet := range gs.PendingPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s/%d", packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicated pending packet %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// StoreKey is the key of the store owned by the evmOS transfer module, which
+// holds the pending packets index next to the ibc-go transfer store.
+const StoreKey = "pendingpackets"
+
+// prefix bytes for the pending packets persistent store
+const (
+	prefixPendingPacket = iota + 1
+)
+
+// KeyPrefixPendingPacket is the prefix of the index of the outbound packets that
+// are pending an acknowledgement or timeout, by sender.
+var KeyPrefixPendingPacket = []byte{prefixPendingPacket}
+
+// PendingPacketsKey returns the key prefix of the pending packets sent by the sender.
+func PendingPacketsKey(sender sdk.AccAddress) []byte {
+	key := append([]byte{}, KeyPrefixPendingPacket...)
+	return append(key, address.MustLengthPrefix(sender)...)
+}
+
+// PendingPacketKey returns the key of the pending packet sent by the sender on
+// the given port and channel with the given sequence.
+func PendingPacketKey(sender sdk.AccAddress, portID, channelID string, sequence uint64) []byte {
+	key := PendingPacketsKey(sender)
+	key = append(key, []byte(portID+"/"+channelID+"/")...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
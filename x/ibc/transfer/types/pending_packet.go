@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewPendingPacket creates a new pending packet from the transfer message that
+// sent the packet with the given sequence.
+func NewPendingPacket(sequence uint64, msg transfertypes.MsgTransfer) PendingPacket {
+	return PendingPacket{
+		SourcePort:            msg.SourcePort,
+		SourceChannel:         msg.SourceChannel,
+		Sequence:              sequence,
+		Token:                 msg.Token,
+		Sender:                msg.Sender,
+		Receiver:              msg.Receiver,
+		TimeoutRevisionNumber: msg.TimeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: msg.TimeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      msg.TimeoutTimestamp,
+		Memo:                  msg.Memo,
+	}
+}
+
+// TimeoutHeight returns the timeout height of the pending packet.
+func (p PendingPacket) TimeoutHeight() clienttypes.Height {
+	return clienttypes.NewHeight(p.TimeoutRevisionNumber, p.TimeoutRevisionHeight)
+}
+
+// Validate performs a stateless validation of the pending packet.
+func (p PendingPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port")
+	}
+
+	if err := host.ChannelIdentifierValidator(p.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel")
+	}
+
+	if p.Sequence == 0 {
+		return fmt.Errorf("sequence cannot be 0")
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid token")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender")
+	}
+
+	return nil
+}
@@ -25,6 +25,10 @@ func InitGenesis(
 
 	// Set genesis state
 	params := data.Params
+	if err := k.ValidateRecipients(params.InflationDistribution); err != nil {
+		panic(errorsmod.Wrapf(err, "invalid inflation recipients"))
+	}
+
 	err := k.SetParams(ctx, params)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error setting params"))
@@ -41,6 +45,11 @@ func InitGenesis(
 
 	skippedEpochs := data.SkippedEpochs
 	k.SetSkippedEpochs(ctx, skippedEpochs)
+
+	annualInflation := data.AnnualInflation
+	if !annualInflation.IsNil() {
+		k.SetAnnualInflation(ctx, annualInflation)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,5 +60,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		EpochIdentifier: k.GetEpochIdentifier(ctx),
 		EpochsPerPeriod: k.GetEpochsPerPeriod(ctx),
 		SkippedEpochs:   k.GetSkippedEpochs(ctx),
+		AnnualInflation: k.GetAnnualInflation(ctx),
 	}
 }
@@ -4,7 +4,13 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	inflation "github.com/evmos/evmos/v20/x/inflation/v1"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 	"github.com/stretchr/testify/require"
 )
@@ -17,3 +23,47 @@ func TestInitGenesis(t *testing.T) {
 	expMintProvision := math.LegacyMustNewDecFromStr("282534246575342465753425").Quo(math.LegacyNewDec(types.ReductionFactor))
 	require.Equal(t, expMintProvision, epochMintProvision)
 }
+
+func TestInitGenesisRecipients(t *testing.T) {
+	testCases := []struct {
+		name      string
+		recipient string
+		expPanic  bool
+	}{
+		{
+			name:      "pass - address recipient",
+			recipient: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			expPanic:  false,
+		},
+		{
+			name:      "fail - unknown module account recipient",
+			recipient: "unknown_module",
+			expPanic:  true,
+		},
+		{
+			name:      "fail - blocked address recipient",
+			recipient: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+			expPanic:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			genState := inflation.ExportGenesis(ctx, nw.App.InflationKeeper)
+			genState.Params = paramsWithRecipients(erc20types.ModuleName, tc.recipient)
+
+			initGenesis := func() {
+				inflation.InitGenesis(ctx, nw.App.InflationKeeper, nw.App.AccountKeeper, nw.App.StakingKeeper, *genState)
+			}
+			if tc.expPanic {
+				require.Panics(t, initGenesis)
+			} else {
+				require.NotPanics(t, initGenesis)
+				require.Equal(t, genState.Params, nw.App.InflationKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
@@ -286,3 +287,124 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expParams, res.Params)
 }
+
+// TestInflationCurves simulates the epochs of two periods for each inflation
+// curve and checks the period and inflation rate queries after each epoch.
+func TestInflationCurves(t *testing.T) {
+	epochsPerPeriod := int64(3)
+
+	testCases := []struct {
+		name     string
+		malleate func(nw *network.UnitTestNetwork, params *types.Params)
+		// expPeriods is the period after each epoch
+		expPeriods []uint64
+		// postCheck is run after each epoch with the inflation rate before and
+		// after the epoch
+		postCheck func(t *testing.T, nw *network.UnitTestNetwork, prevRate, rate math.LegacyDec, periodChanged bool)
+	}{
+		{
+			"exponential - inflation decays on each period",
+			func(_ *network.UnitTestNetwork, params *types.Params) {
+				params.InflationCurve = types.INFLATION_CURVE_EXPONENTIAL
+			},
+			[]uint64{0, 0, 0, 1, 1, 1, 2},
+			func(t *testing.T, _ *network.UnitTestNetwork, prevRate, rate math.LegacyDec, periodChanged bool) {
+				require.True(t, rate.IsPositive())
+				if periodChanged {
+					require.True(t, rate.LT(prevRate), "expected %s < %s", rate, prevRate)
+				}
+			},
+		},
+		{
+			"bonded ratio - inflation moves towards the goal bonded ratio",
+			func(_ *network.UnitTestNetwork, params *types.Params) {
+				params.InflationCurve = types.INFLATION_CURVE_BONDED_RATIO
+			},
+			[]uint64{0, 0, 0, 1, 1, 1, 2},
+			func(t *testing.T, nw *network.UnitTestNetwork, prevRate, rate math.LegacyDec, _ bool) {
+				ctx := nw.GetContext()
+				calculation := nw.App.InflationKeeper.GetParams(ctx).BondedRatioCalculation
+
+				// the inflation rate query reports the annual inflation as a percentage
+				annualInflation := nw.App.InflationKeeper.GetAnnualInflation(ctx)
+				expRate := annualInflation.Mul(math.LegacyNewDec(100))
+				require.True(t, rate.Sub(expRate).Abs().LT(math.LegacyNewDecWithPrec(1, 12)), "expected %s, got %s", expRate, rate)
+				require.True(t, annualInflation.GTE(calculation.InflationMin))
+				require.True(t, annualInflation.LTE(calculation.InflationMax))
+
+				bondedRatio, err := nw.App.InflationKeeper.BondedRatio(ctx)
+				require.NoError(t, err)
+				if bondedRatio.LT(calculation.GoalBonded) {
+					require.True(t, rate.GTE(prevRate), "expected %s >= %s", rate, prevRate)
+				} else {
+					require.True(t, rate.LTE(prevRate), "expected %s <= %s", rate, prevRate)
+				}
+			},
+		},
+		{
+			"capped supply - inflation stops at the max supply",
+			func(nw *network.UnitTestNetwork, params *types.Params) {
+				ctx := nw.GetContext()
+				totalSupply := nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+				// 1% of the supply is minted on each epoch, so the max supply is
+				// reached on the fourth epoch
+				params.InflationCurve = types.INFLATION_CURVE_CAPPED_SUPPLY
+				params.CappedSupplyCalculation = types.CappedSupplyCalculation{
+					MaxSupply:       totalSupply.Add(totalSupply.MulRaw(35).QuoRaw(1000)),
+					AnnualInflation: math.LegacyNewDecWithPrec(3, 2),
+				}
+			},
+			// the period is not updated on the epochs without inflation
+			[]uint64{0, 0, 0, 1, 1, 1, 1},
+			func(t *testing.T, nw *network.UnitTestNetwork, _, rate math.LegacyDec, _ bool) {
+				ctx := nw.GetContext()
+				params := nw.App.InflationKeeper.GetParams(ctx)
+				totalSupply := nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+				require.True(t, totalSupply.LTE(params.CappedSupplyCalculation.MaxSupply))
+				if totalSupply.Equal(params.CappedSupplyCalculation.MaxSupply) {
+					require.True(t, rate.IsZero(), "expected zero inflation rate, got %s", rate)
+				} else {
+					require.True(t, rate.IsPositive())
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			params := nw.App.InflationKeeper.GetParams(ctx)
+			params.EnableInflation = true
+			tc.malleate(nw, &params)
+			require.NoError(t, nw.App.InflationKeeper.SetParams(ctx, params))
+			nw.App.InflationKeeper.SetEpochsPerPeriod(ctx, epochsPerPeriod)
+
+			res, err := nw.GetInflationClient().InflationRate(ctx, &types.QueryInflationRateRequest{})
+			require.NoError(t, err)
+			prevRate := res.InflationRate
+			prevPeriod := uint64(0)
+
+			for i, expPeriod := range tc.expPeriods {
+				epochNumber := int64(i + 1)
+				nw.App.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, epochNumber)
+
+				// create the query client after the epoch to query the new state
+				qc := nw.GetInflationClient()
+
+				periodRes, err := qc.Period(ctx, &types.QueryPeriodRequest{})
+				require.NoError(t, err)
+				require.Equal(t, expPeriod, periodRes.Period, "epoch %d", epochNumber)
+
+				rateRes, err := qc.InflationRate(ctx, &types.QueryInflationRateRequest{})
+				require.NoError(t, err)
+
+				tc.postCheck(t, nw, prevRate, rateRes.InflationRate, periodRes.Period != prevPeriod)
+				prevRate = rateRes.InflationRate
+				prevPeriod = periodRes.Period
+			}
+		})
+	}
+}
@@ -48,19 +48,25 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	}
 
 	// mint coins, update supply
-	period := k.GetPeriod(ctx)
-	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
-	bondedRatio, err := k.BondedRatio(ctx)
+	state, err := k.GetInflationState(ctx, params.MintDenom)
 	if err != nil {
 		panic(err)
 	}
+	period := state.Period
+	epochsPerPeriod := state.EpochsPerPeriod
+
+	// the bonded ratio curve moves the annual inflation towards the goal bonded
+	// ratio before minting. It is only stored once the inflation is allocated.
+	bondedRatioCurve := params.InflationCurve == types.INFLATION_CURVE_BONDED_RATIO
+	if bondedRatioCurve {
+		state.AnnualInflation = params.BondedRatioCalculation.NextAnnualInflation(
+			state.AnnualInflation,
+			state.BondedRatio,
+			epochsPerPeriod,
+		)
+	}
 
-	epochMintProvision := types.CalculateEpochMintProvision(
-		params,
-		period,
-		epochsPerPeriod,
-		bondedRatio,
-	)
+	epochMintProvision := params.Calculation().EpochMintProvision(state)
 
 	if !epochMintProvision.IsPositive() {
 		k.Logger(ctx).Error(
@@ -75,9 +81,27 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		Amount: epochMintProvision.TruncateInt(),
 	}
 
-	staking, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin, params)
+	// mint and allocate in a cached context so that a failing allocation
+	// neither halts the chain nor leaves a partial allocation behind. The
+	// epoch is then counted as skipped, as no tokens were minted.
+	cacheCtx, writeFn := ctx.CacheContext()
+	staking, communityPool, err := k.MintAndAllocateInflation(cacheCtx, mintedCoin, params)
 	if err != nil {
-		panic(err)
+		skippedEpochs++
+		k.SetSkippedEpochs(ctx, skippedEpochs)
+		k.Logger(ctx).Error(
+			"SKIPPING INFLATION: failed to mint and allocate inflation",
+			"epoch-id", epochIdentifier,
+			"epoch-number", epochNumber,
+			"skipped-epochs", skippedEpochs,
+			"error", err.Error(),
+		)
+		return
+	}
+	writeFn()
+
+	if bondedRatioCurve {
+		k.SetAnnualInflation(ctx, state.AnnualInflation)
 	}
 
 	// If period is passed, update the period. A period is
@@ -140,13 +164,28 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.NamedEpochHooks     = Hooks{}
+	_ epochstypes.EpochDependentHooks = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// Name returns the name of the inflation epoch hooks
+func (h Hooks) Name() string {
+	return types.ModuleName
+}
+
+// EpochIdentifiers returns the identifiers of the epochs that the inflation
+// depends on: the epoch of the inflation mint and the day epoch that counts the
+// skipped epochs
+func (h Hooks) EpochIdentifiers(ctx sdk.Context) []string {
+	return []string{h.k.GetEpochIdentifier(ctx), epochstypes.DayEpochID}
+}
+
 // epochs hooks
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
@@ -8,8 +8,11 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 	"github.com/stretchr/testify/require"
 )
@@ -252,3 +255,40 @@ func TestPeriodChangesSkippedEpochsAfterEpochEnd(t *testing.T) {
 		})
 	}
 }
+
+func TestAfterEpochEndFailedAllocation(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	denom := nw.GetBaseDenom()
+
+	// a blocked address recipient passes the stateless validation but cannot
+	// receive the allocation
+	params := paramsWithRecipients(
+		erc20types.ModuleName,
+		authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+	)
+	params.EnableInflation = true
+	params.InflationCurve = types.INFLATION_CURVE_BONDED_RATIO
+	require.NoError(t, params.Validate())
+	require.NoError(t, nw.App.InflationKeeper.SetParams(ctx, params))
+
+	supplyBefore := nw.App.BankKeeper.GetSupply(ctx, denom)
+	periodBefore := nw.App.InflationKeeper.GetPeriod(ctx)
+	skippedEpochsBefore := nw.App.InflationKeeper.GetSkippedEpochs(ctx)
+	annualInflationBefore := nw.App.InflationKeeper.GetAnnualInflation(ctx)
+
+	futureCtx := ctx.WithBlockTime(time.Now().Add(time.Hour))
+	require.NotPanics(t, func() {
+		nw.App.InflationKeeper.AfterEpochEnd(futureCtx, epochstypes.DayEpochID, nw.App.LastBlockHeight()+1)
+	})
+
+	// no inflation is minted nor partially allocated
+	require.Equal(t, supplyBefore, nw.App.BankKeeper.GetSupply(ctx, denom))
+	erc20Addr := nw.App.AccountKeeper.GetModuleAddress(erc20types.ModuleName)
+	require.True(t, nw.App.BankKeeper.GetBalance(ctx, erc20Addr, denom).IsZero())
+	require.Equal(t, periodBefore, nw.App.InflationKeeper.GetPeriod(ctx))
+
+	// the epoch is counted as skipped and the annual inflation is not moved
+	require.Equal(t, skippedEpochsBefore+1, nw.App.InflationKeeper.GetSkippedEpochs(ctx))
+	require.Equal(t, annualInflationBefore, nw.App.InflationKeeper.GetAnnualInflation(ctx))
+}
@@ -4,8 +4,10 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	utils "github.com/evmos/evmos/v20/utils"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
@@ -45,7 +47,7 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 // AllocateExponentialInflation allocates coins from the inflation to external
 // modules according to allocation proportions:
 //   - staking rewards -> sdk `auth` module fee collector
-//   - usage incentives -> `x/incentives` module
+//   - recipients -> module accounts or addresses of the recipients
 //   - community pool -> `sdk `distr` module community pool
 func (k Keeper) AllocateExponentialInflation(
 	ctx sdk.Context,
@@ -68,6 +70,20 @@ func (k Keeper) AllocateExponentialInflation(
 	); err != nil {
 		return nil, nil, err
 	}
+	emitAllocateInflationEvent(ctx, k.feeCollectorName, staking)
+
+	// Allocate the recipients shares to their module accounts or addresses
+	for _, recipient := range distribution.Recipients {
+		coins := sdk.Coins{k.GetProportions(ctx, mintedCoin, recipient.Share)}
+		if !coins.IsAllPositive() {
+			continue
+		}
+
+		if err := k.allocateToRecipient(ctx, recipient, coins); err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "failed to allocate inflation to %s", recipient.Address)
+		}
+		emitAllocateInflationEvent(ctx, recipient.Address, coins)
+	}
 
 	// Allocate community pool amount (remaining module balance) to community
 	// pool address
@@ -82,10 +98,62 @@ func (k Keeper) AllocateExponentialInflation(
 	if err != nil {
 		return nil, nil, err
 	}
+	emitAllocateInflationEvent(ctx, types.AttributeValueCommunityPool, communityPool)
 
 	return staking, communityPool, nil
 }
 
+// allocateToRecipient sends the coins from the inflation module to the module
+// account or the address of the recipient.
+func (k Keeper) allocateToRecipient(
+	ctx sdk.Context,
+	recipient types.InflationRecipient,
+	coins sdk.Coins,
+) error {
+	if recipient.IsModuleAccount() {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Address, coins)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		sdk.MustAccAddressFromBech32(recipient.Address),
+		coins,
+	)
+}
+
+// ValidateRecipients checks that the module account recipients of the
+// distribution exist and that the address recipients are allowed to receive
+// funds, as the allocation of each epoch would fail otherwise.
+func (k Keeper) ValidateRecipients(distribution types.InflationDistribution) error {
+	for _, recipient := range distribution.Recipients {
+		if recipient.IsModuleAccount() {
+			if k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+				return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", recipient.Address)
+			}
+			continue
+		}
+
+		if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(recipient.Address)) {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", recipient.Address)
+		}
+	}
+
+	return nil
+}
+
+// emitAllocateInflationEvent emits the event of the inflation allocated to a
+// recipient.
+func emitAllocateInflationEvent(ctx sdk.Context, recipient string, coins sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAllocateInflation,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
 // GetProportions calculates the proportion of coins that is to be
 // allocated during inflation for a given distribution.
 func (k Keeper) GetProportions(
@@ -185,18 +253,42 @@ func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) math.LegacyD
 	return epochMintProvision.Mul(epochsPerPeriod).Quo(circulatingSupply).Mul(math.LegacyNewDec(100))
 }
 
+// GetInflationState returns the chain state used by the inflation curves to
+// compute the epoch mint provision.
+func (k Keeper) GetInflationState(ctx sdk.Context, mintDenom string) (types.InflationState, error) {
+	bondedRatio, err := k.BondedRatio(ctx)
+	if err != nil {
+		return types.InflationState{}, err
+	}
+
+	return types.InflationState{
+		Period:            k.GetPeriod(ctx),
+		EpochsPerPeriod:   k.GetEpochsPerPeriod(ctx),
+		BondedRatio:       bondedRatio,
+		CirculatingSupply: k.GetCirculatingSupply(ctx, mintDenom),
+		TotalSupply:       k.bankKeeper.GetSupply(ctx, mintDenom).Amount,
+		AnnualInflation:   k.GetAnnualInflation(ctx),
+	}, nil
+}
+
 // GetEpochMintProvision retrieves necessary params KV storage
-// and calculate EpochMintProvision
+// and calculate EpochMintProvision with the inflation curve selected by the
+// params
 func (k Keeper) GetEpochMintProvision(ctx sdk.Context) math.LegacyDec {
-	bondedRadio, err := k.BondedRatio(ctx)
+	params := k.GetParams(ctx)
+	state, err := k.GetInflationState(ctx, params.MintDenom)
 	// ?? Should we bubble up this error instead of returning 0 ??
 	if err != nil {
 		return math.LegacyZeroDec()
 	}
-	return types.CalculateEpochMintProvision(
-		k.GetParams(ctx),
-		k.GetPeriod(ctx),
-		k.GetEpochsPerPeriod(ctx),
-		bondedRadio,
-	).Quo(math.LegacyNewDec(types.ReductionFactor))
+
+	epochMintProvision := params.Calculation().EpochMintProvision(state)
+
+	// the exponential curve provision has always been reported reduced once more
+	// by the reduction factor, which is kept to not change the query results
+	if params.IsExponential() {
+		epochMintProvision = epochMintProvision.Quo(math.LegacyNewDec(types.ReductionFactor))
+	}
+
+	return epochMintProvision
 }
@@ -11,8 +11,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 	"github.com/stretchr/testify/require"
@@ -97,6 +99,57 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocateInflationRecipients(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	denom := nw.GetBaseDenom()
+	recipientAddr := utiltx.GenerateAddress().Bytes()
+
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+		UsageIncentives: math.LegacyZeroDec(),
+		CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+		Recipients: []types.InflationRecipient{
+			{Address: erc20types.ModuleName, Share: math.LegacyNewDecWithPrec(1, 1)},
+			{Address: sdk.AccAddress(recipientAddr).String(), Share: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	erc20Addr := nw.App.AccountKeeper.GetModuleAddress(erc20types.ModuleName)
+	erc20BalanceBefore := nw.App.BankKeeper.GetBalance(ctx, erc20Addr, denom)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mintCoin := sdk.NewCoin(denom, math.NewInt(1_000_000))
+	staking, communityPool, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, mintCoin, params)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(500_000))), staking)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(300_000))), communityPool)
+
+	erc20Balance := nw.App.BankKeeper.GetBalance(ctx, erc20Addr, denom)
+	require.Equal(t, math.NewInt(100_000), erc20Balance.Amount.Sub(erc20BalanceBefore.Amount))
+	require.Equal(t, math.NewInt(100_000), nw.App.BankKeeper.GetBalance(ctx, recipientAddr, denom).Amount)
+
+	// an allocation event is emitted for each recipient
+	var recipients []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeAllocateInflation {
+			continue
+		}
+		attr, ok := event.GetAttribute(types.AttributeKeyRecipient)
+		require.True(t, ok)
+		recipients = append(recipients, attr.Value)
+	}
+	require.Equal(t, []string{
+		authtypes.FeeCollectorName,
+		erc20types.ModuleName,
+		sdk.AccAddress(recipientAddr).String(),
+		types.AttributeValueCommunityPool,
+	}, recipients)
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -6,6 +6,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v3 "github.com/evmos/evmos/v20/x/inflation/v1/migrations/v3"
+	v4 "github.com/evmos/evmos/v20/x/inflation/v1/migrations/v4"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
 
@@ -27,3 +28,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx.KVStore(m.keeper.storeKey))
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -21,6 +21,10 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateRecipients(req.Params.InflationDistribution); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid inflation recipients")
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, errorsmod.Wrapf(err, "error setting params")
 	}
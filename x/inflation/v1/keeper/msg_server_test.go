@@ -3,10 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 	"github.com/stretchr/testify/require"
 )
@@ -34,6 +38,36 @@ func TestUpdateParams(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "pass - module account and address recipients",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: paramsWithRecipients(
+					erc20types.ModuleName,
+					sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				),
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail - unknown module account recipient",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    paramsWithRecipients("unknown_module", "erc20"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - blocked address recipient",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: paramsWithRecipients(
+					erc20types.ModuleName,
+					authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+				),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -49,3 +83,19 @@ func TestUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+// paramsWithRecipients returns the default params allocating 10% of the
+// inflation to each of the two given recipients.
+func paramsWithRecipients(first, second string) types.Params {
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+		UsageIncentives: math.LegacyZeroDec(),
+		CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+		Recipients: []types.InflationRecipient{
+			{Address: first, Share: math.LegacyNewDecWithPrec(1, 1)},
+			{Address: second, Share: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+	return params
+}
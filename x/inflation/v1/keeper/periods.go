@@ -4,6 +4,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
@@ -19,3 +20,32 @@ func (k Keeper) SetPeriod(ctx sdk.Context, period uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyPrefixPeriod, sdk.Uint64ToBigEndian(period))
 }
+
+// GetAnnualInflation gets the current annual inflation of the bonded ratio
+// inflation curve
+func (k Keeper) GetAnnualInflation(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixAnnualInflation)
+	if len(bz) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	var annualInflation math.LegacyDec
+	if err := annualInflation.Unmarshal(bz); err != nil {
+		return math.LegacyZeroDec()
+	}
+
+	return annualInflation
+}
+
+// SetAnnualInflation stores the current annual inflation of the bonded ratio
+// inflation curve
+func (k Keeper) SetAnnualInflation(ctx sdk.Context, annualInflation math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := annualInflation.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.KeyPrefixAnnualInflation, bz)
+}
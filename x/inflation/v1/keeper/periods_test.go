@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/stretchr/testify/require"
@@ -51,3 +53,46 @@ func TestSetGetPeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestSetGetAnnualInflation(t *testing.T) {
+	var (
+		ctx sdk.Context
+		nw  *network.UnitTestNetwork
+	)
+	expAnnualInflation := math.LegacyNewDecWithPrec(13, 2)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		ok       bool
+	}{
+		{
+			"default annual inflation",
+			func() {},
+			false,
+		},
+		{
+			"annual inflation set",
+			func() {
+				nw.App.InflationKeeper.SetAnnualInflation(ctx, expAnnualInflation)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			// reset
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+
+			tc.malleate()
+
+			annualInflation := nw.App.InflationKeeper.GetAnnualInflation(ctx)
+			if tc.ok {
+				require.Equal(t, expAnnualInflation, annualInflation, tc.name)
+			} else {
+				require.True(t, annualInflation.IsZero(), tc.name)
+			}
+		})
+	}
+}
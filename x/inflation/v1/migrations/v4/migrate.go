@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+// MigrateStore migrates the x/inflation module state from the consensus version 3 to
+// version 4. Specifically, it selects the exponential inflation curve, which was
+// the only calculation before, and sets the default factors of the bonded ratio
+// and capped supply curves, so that they can later be selected by governance.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+
+	bz := store.Get(types.ParamsKey)
+	if len(bz) != 0 {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.InflationCurve = types.INFLATION_CURVE_EXPONENTIAL
+	params.BondedRatioCalculation = types.DefaultBondedRatioCalculation
+	params.CappedSupplyCalculation = types.DefaultCappedSupplyCalculation
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
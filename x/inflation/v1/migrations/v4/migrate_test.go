@@ -0,0 +1,40 @@
+package v4_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/evmos/evmos/v20/encoding"
+	v4 "github.com/evmos/evmos/v20/x/inflation/v1/migrations/v4"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := encoding.MakeConfig().Codec
+
+	// params stored before the inflation curves were introduced
+	legacyParams := types.Params{
+		MintDenom:              types.DefaultInflationDenom,
+		ExponentialCalculation: types.DefaultExponentialCalculation,
+		InflationDistribution:  types.DefaultInflationDistribution,
+		EnableInflation:        true,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&legacyParams))
+
+	require.NoError(t, v4.MigrateStore(store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.INFLATION_CURVE_EXPONENTIAL, params.InflationCurve)
+	require.Equal(t, types.DefaultBondedRatioCalculation, params.BondedRatioCalculation)
+	require.Equal(t, types.DefaultCappedSupplyCalculation, params.CappedSupplyCalculation)
+	require.Equal(t, legacyParams.ExponentialCalculation, params.ExponentialCalculation)
+	require.Equal(t, legacyParams.InflationDistribution, params.InflationDistribution)
+	require.True(t, params.EnableInflation)
+}
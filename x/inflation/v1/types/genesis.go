@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 
+	"cosmossdk.io/math"
+
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 )
 
@@ -16,6 +18,7 @@ func NewGenesisState(
 	epochIdentifier string,
 	epochsPerPeriod int64,
 	skippedEpochs uint64,
+	annualInflation math.LegacyDec,
 ) GenesisState {
 	return GenesisState{
 		Params:          params,
@@ -23,6 +26,7 @@ func NewGenesisState(
 		EpochIdentifier: epochIdentifier,
 		EpochsPerPeriod: epochsPerPeriod,
 		SkippedEpochs:   skippedEpochs,
+		AnnualInflation: annualInflation,
 	}
 }
 
@@ -34,6 +38,7 @@ func DefaultGenesisState() *GenesisState {
 		EpochIdentifier: epochstypes.DayEpochID,
 		EpochsPerPeriod: 365,
 		SkippedEpochs:   0,
+		AnnualInflation: math.LegacyZeroDec(),
 	}
 }
 
@@ -52,6 +57,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := validateAnnualInflation(gs.AnnualInflation); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -75,3 +84,21 @@ func validateSkippedEpochs(i interface{}) error {
 	}
 	return nil
 }
+
+func validateAnnualInflation(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid genesis state type: %T", i)
+	}
+
+	// an unset annual inflation starts at the minimum inflation
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("annual inflation must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
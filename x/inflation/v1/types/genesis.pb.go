@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -36,6 +37,8 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// annual_inflation is the current annual inflation of the bonded ratio curve
+	AnnualInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=annual_inflation,json=annualInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_inflation"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +119,14 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// inflation_curve selects the calculation of the epoch mint provision
+	InflationCurve InflationCurve `protobuf:"varint,5,opt,name=inflation_curve,json=inflationCurve,proto3,enum=evmos.inflation.v1.InflationCurve" json:"inflation_curve,omitempty"`
+	// bonded_ratio_calculation takes in the variables to calculate the inflation
+	// targeting a bonded ratio
+	BondedRatioCalculation BondedRatioCalculation `protobuf:"bytes,6,opt,name=bonded_ratio_calculation,json=bondedRatioCalculation,proto3" json:"bonded_ratio_calculation"`
+	// capped_supply_calculation takes in the variables to calculate the inflation
+	// capped by a max supply
+	CappedSupplyCalculation CappedSupplyCalculation `protobuf:"bytes,7,opt,name=capped_supply_calculation,json=cappedSupplyCalculation,proto3" json:"capped_supply_calculation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,6 +190,27 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetInflationCurve() InflationCurve {
+	if m != nil {
+		return m.InflationCurve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+func (m *Params) GetBondedRatioCalculation() BondedRatioCalculation {
+	if m != nil {
+		return m.BondedRatioCalculation
+	}
+	return BondedRatioCalculation{}
+}
+
+func (m *Params) GetCappedSupplyCalculation() CappedSupplyCalculation {
+	if m != nil {
+		return m.CappedSupplyCalculation
+	}
+	return CappedSupplyCalculation{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -187,34 +219,42 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x8d, 0x9b, 0x12, 0x11, 0x17, 0x28, 0xb5, 0x20, 0x44, 0x91, 0x58, 0x56, 0x91, 0x90, 0xd2,
-	0x1c, 0xd6, 0x34, 0x9c, 0xb9, 0x94, 0x56, 0x28, 0xb7, 0x68, 0xb9, 0x71, 0xb1, 0x9c, 0xec, 0x34,
-	0xb5, 0x9a, 0xb5, 0xad, 0xb5, 0x13, 0x95, 0xbf, 0xe0, 0x33, 0xb8, 0xc1, 0x67, 0xf4, 0xd8, 0x23,
-	0x27, 0x84, 0x92, 0x03, 0xbf, 0x81, 0x32, 0x5e, 0x36, 0x41, 0xec, 0xc5, 0xb2, 0xdf, 0xbc, 0x79,
-	0x6f, 0x66, 0x3c, 0x34, 0x86, 0x55, 0x6e, 0x1c, 0x57, 0xfa, 0x6a, 0x21, 0xbd, 0x32, 0x9a, 0xaf,
-	0xce, 0xf8, 0x1c, 0x34, 0x38, 0xe5, 0x12, 0x5b, 0x18, 0x6f, 0x18, 0x43, 0x46, 0x52, 0x31, 0x92,
-	0xd5, 0x59, 0xef, 0x44, 0xe6, 0x4a, 0x1b, 0x8e, 0x67, 0xa0, 0xf5, 0x9e, 0xcd, 0xcd, 0xdc, 0xe0,
-	0x95, 0x6f, 0x6f, 0x25, 0xda, 0xaf, 0x91, 0xdf, 0x29, 0x21, 0xa7, 0xbf, 0x21, 0xf4, 0xd1, 0x87,
-	0x60, 0xf9, 0xd1, 0x4b, 0x0f, 0xec, 0x1d, 0x6d, 0x59, 0x59, 0xc8, 0xdc, 0x75, 0x49, 0x4c, 0x06,
-	0x47, 0xa3, 0x5e, 0xf2, 0x7f, 0x09, 0xc9, 0x04, 0x19, 0xe7, 0xed, 0xbb, 0x9f, 0xaf, 0x1a, 0x5f,
-	0x7f, 0x7f, 0x1f, 0x92, 0xb4, 0x4c, 0x62, 0x1d, 0xda, 0xb2, 0x50, 0x28, 0x93, 0x75, 0x0f, 0x62,
-	0x32, 0x38, 0x4c, 0xcb, 0x17, 0x3b, 0xa5, 0x4f, 0xc1, 0x9a, 0xd9, 0xb5, 0x50, 0x19, 0x68, 0xaf,
-	0xae, 0x14, 0x14, 0xdd, 0x66, 0x4c, 0x06, 0xed, 0xf4, 0x18, 0xf1, 0x71, 0x05, 0xb3, 0x21, 0x3d,
-	0x41, 0xc8, 0x09, 0x0b, 0x85, 0x28, 0xd5, 0x0e, 0x63, 0x32, 0x68, 0x96, 0x5c, 0x37, 0x81, 0x62,
-	0x12, 0x64, 0x5f, 0xd3, 0x27, 0xee, 0x46, 0x59, 0x0b, 0x99, 0x08, 0xa1, 0xee, 0x03, 0xb4, 0x7d,
-	0x5c, 0xa2, 0x97, 0x08, 0xf6, 0xbf, 0x1d, 0xd0, 0x56, 0xa8, 0x99, 0xbd, 0xa4, 0x34, 0x57, 0xda,
-	0x8b, 0x0c, 0xb4, 0xc9, 0xb1, 0xc7, 0x76, 0xda, 0xde, 0x22, 0x17, 0x5b, 0x80, 0x69, 0xfa, 0x02,
-	0x6e, 0xad, 0xd1, 0xdb, 0x6a, 0xe4, 0x42, 0xcc, 0xe4, 0x62, 0xb6, 0x0c, 0x7d, 0x63, 0x43, 0x47,
-	0xa3, 0x61, 0xdd, 0x3c, 0x2e, 0x77, 0x29, 0xef, 0x77, 0x19, 0xfb, 0xf3, 0xe9, 0x40, 0x2d, 0x85,
-	0xdd, 0xd0, 0x4e, 0xa5, 0x24, 0x32, 0xe5, 0x7c, 0xa1, 0xa6, 0x4b, 0xb4, 0x6b, 0xa2, 0xdd, 0x69,
-	0x9d, 0xdd, 0xf8, 0xef, 0xe3, 0x62, 0x2f, 0x61, 0xdf, 0xed, 0xb9, 0xaa, 0x63, 0xe0, 0x27, 0x68,
-	0x39, 0x5d, 0x80, 0xa8, 0xe2, 0x38, 0xd8, 0x87, 0xe9, 0x71, 0xc0, 0x2b, 0xe1, 0xf3, 0xf1, 0xdd,
-	0x3a, 0x22, 0xf7, 0xeb, 0x88, 0xfc, 0x5a, 0x47, 0xe4, 0xcb, 0x26, 0x6a, 0xdc, 0x6f, 0xa2, 0xc6,
-	0x8f, 0x4d, 0xd4, 0xf8, 0xc4, 0xe7, 0xca, 0x5f, 0x2f, 0xa7, 0xc9, 0xcc, 0xe4, 0x3c, 0x2c, 0x58,
-	0x38, 0x57, 0xa3, 0x37, 0xfc, 0xf6, 0xdf, 0x65, 0xf3, 0x9f, 0x2d, 0xb8, 0x69, 0x0b, 0x37, 0xed,
-	0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x47, 0x3c, 0x80, 0xee, 0x02, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x8f, 0xd2, 0x4e,
+	0x1c, 0xa5, 0xc0, 0xbf, 0x7f, 0x19, 0x15, 0x76, 0x27, 0xca, 0x56, 0x8c, 0x5d, 0x42, 0x62, 0xc2,
+	0x62, 0xd2, 0xba, 0x78, 0xf6, 0x02, 0x6c, 0x0c, 0xd1, 0x03, 0x29, 0x37, 0x2f, 0xcd, 0xb4, 0x9d,
+	0x85, 0x09, 0xed, 0xcc, 0xa4, 0xd3, 0x36, 0xcb, 0xb7, 0xf0, 0x63, 0x78, 0xf4, 0xe8, 0x47, 0xd8,
+	0xe3, 0x1e, 0x8d, 0x87, 0x8d, 0xc2, 0xc1, 0xaf, 0x61, 0x3a, 0xad, 0x05, 0x62, 0xf1, 0x32, 0x99,
+	0x79, 0x7d, 0xbf, 0xf7, 0x7e, 0xbf, 0x37, 0x1d, 0xd0, 0xc5, 0x49, 0xc0, 0x84, 0x49, 0xe8, 0xb5,
+	0x8f, 0x22, 0xc2, 0xa8, 0x99, 0x5c, 0x9a, 0x0b, 0x4c, 0xb1, 0x20, 0xc2, 0xe0, 0x21, 0x8b, 0x18,
+	0x84, 0x92, 0x61, 0x14, 0x0c, 0x23, 0xb9, 0xec, 0x9c, 0xa2, 0x80, 0x50, 0x66, 0xca, 0x35, 0xa3,
+	0x75, 0x9e, 0x2c, 0xd8, 0x82, 0xc9, 0xad, 0x99, 0xee, 0x72, 0xb4, 0x57, 0x22, 0xbf, 0x53, 0x92,
+	0x9c, 0xde, 0xd7, 0x2a, 0x78, 0xf4, 0x2e, 0xb3, 0x9c, 0x47, 0x28, 0xc2, 0xf0, 0x2d, 0x50, 0x39,
+	0x0a, 0x51, 0x20, 0x34, 0xa5, 0xab, 0xf4, 0x1f, 0x0e, 0x3b, 0xc6, 0xdf, 0x2d, 0x18, 0x33, 0xc9,
+	0x18, 0x35, 0x6e, 0xef, 0xcf, 0x2b, 0x9f, 0x7f, 0x7d, 0x19, 0x28, 0x56, 0x5e, 0x04, 0xdb, 0x40,
+	0xe5, 0x38, 0x24, 0xcc, 0xd3, 0xaa, 0x5d, 0xa5, 0x5f, 0xb7, 0xf2, 0x13, 0xbc, 0x00, 0x27, 0x98,
+	0x33, 0x77, 0x69, 0x13, 0x0f, 0xd3, 0x88, 0x5c, 0x13, 0x1c, 0x6a, 0xb5, 0xae, 0xd2, 0x6f, 0x58,
+	0x2d, 0x89, 0x4f, 0x0b, 0x18, 0x0e, 0xc0, 0xa9, 0x84, 0x84, 0xcd, 0x71, 0x68, 0xe7, 0x6a, 0xf5,
+	0xae, 0xd2, 0xaf, 0xe5, 0x5c, 0x31, 0xc3, 0xe1, 0x2c, 0x93, 0x7d, 0x09, 0x9a, 0x62, 0x45, 0x38,
+	0xc7, 0x9e, 0x9d, 0x7d, 0xd2, 0xfe, 0x93, 0xb6, 0x8f, 0x73, 0xf4, 0x4a, 0x82, 0x70, 0x0e, 0x4e,
+	0x10, 0xa5, 0x31, 0xf2, 0xed, 0x62, 0x0c, 0x4d, 0x4d, 0xdd, 0x47, 0xfd, 0x74, 0x84, 0xef, 0xf7,
+	0xe7, 0xcf, 0x5d, 0x26, 0x02, 0x26, 0x84, 0xb7, 0x32, 0x08, 0x33, 0x03, 0x14, 0x2d, 0x8d, 0x0f,
+	0x78, 0x81, 0xdc, 0xf5, 0x04, 0xbb, 0xd9, 0x84, 0xad, 0x4c, 0x61, 0xfa, 0x47, 0xa0, 0xf7, 0xb3,
+	0x0e, 0xd4, 0x2c, 0x08, 0xf8, 0x02, 0x80, 0x80, 0xd0, 0xc8, 0xf6, 0x30, 0x65, 0x81, 0x0c, 0xae,
+	0x61, 0x35, 0x52, 0x64, 0x92, 0x02, 0x90, 0x82, 0x33, 0x7c, 0xc3, 0x19, 0x4d, 0x47, 0x44, 0xbe,
+	0xed, 0x22, 0xdf, 0x8d, 0xf3, 0x2e, 0xaa, 0x32, 0xe4, 0x41, 0x59, 0xc8, 0x57, 0xbb, 0x92, 0xf1,
+	0xae, 0x62, 0x3f, 0xf4, 0x36, 0x2e, 0xa5, 0xc0, 0x15, 0x68, 0x17, 0x4a, 0xb6, 0x47, 0x44, 0x14,
+	0x12, 0x27, 0x96, 0x76, 0x35, 0x69, 0x77, 0x51, 0x66, 0x57, 0x0c, 0x36, 0xd9, 0x2b, 0xd8, 0x77,
+	0x7b, 0x4a, 0xca, 0x18, 0xf2, 0x66, 0x29, 0x72, 0x7c, 0xbc, 0x97, 0x6d, 0x7a, 0x5b, 0x0f, 0xac,
+	0x56, 0x86, 0x17, 0xc2, 0xf0, 0x3d, 0x68, 0xed, 0xfa, 0x72, 0xe3, 0x30, 0xc1, 0xf2, 0xba, 0x9a,
+	0xc3, 0xde, 0x3f, 0x1b, 0x1a, 0xa7, 0x4c, 0xab, 0x49, 0x0e, 0xce, 0x90, 0x01, 0xcd, 0x61, 0xd4,
+	0xc3, 0x9e, 0x1d, 0xa6, 0xe8, 0x41, 0xaa, 0xea, 0xf1, 0x54, 0x47, 0xb2, 0xc6, 0x4a, 0xcf, 0xc7,
+	0x52, 0x75, 0x4a, 0x29, 0x30, 0x04, 0xcf, 0x5c, 0x24, 0x7f, 0x35, 0x11, 0x73, 0xee, 0xaf, 0x0f,
+	0x1c, 0xff, 0x97, 0x8e, 0xaf, 0xca, 0x1c, 0xc7, 0xb2, 0x68, 0x2e, 0x6b, 0x8e, 0x58, 0x9e, 0xb9,
+	0x47, 0x38, 0xd3, 0xdb, 0x8d, 0xae, 0xdc, 0x6d, 0x74, 0xe5, 0xc7, 0x46, 0x57, 0x3e, 0x6d, 0xf5,
+	0xca, 0xdd, 0x56, 0xaf, 0x7c, 0xdb, 0xea, 0x95, 0x8f, 0xe6, 0x82, 0x44, 0xcb, 0xd8, 0x31, 0x5c,
+	0x16, 0x98, 0xd9, 0x3b, 0xcf, 0xd6, 0x64, 0xf8, 0xda, 0xbc, 0x39, 0x7c, 0xf3, 0xd1, 0x9a, 0x63,
+	0xe1, 0xa8, 0xf2, 0xc1, 0xbf, 0xf9, 0x3d, 0x00, 0x12, 0xc8, 0xee, 0x06, 0x75, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -237,6 +277,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AnnualInflation.Size()
+		i -= size
+		if _, err := m.AnnualInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.SkippedEpochs != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkippedEpochs))
 		i--
@@ -292,6 +342,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CappedSupplyCalculation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.BondedRatioCalculation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.InflationCurve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InflationCurve))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -364,6 +439,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkippedEpochs != 0 {
 		n += 1 + sovGenesis(uint64(m.SkippedEpochs))
 	}
+	l = m.AnnualInflation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -384,6 +461,13 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.InflationCurve != 0 {
+		n += 1 + sovGenesis(uint64(m.InflationCurve))
+	}
+	l = m.BondedRatioCalculation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CappedSupplyCalculation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -544,6 +628,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -712,6 +830,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCurve", wireType)
+			}
+			m.InflationCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationCurve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatioCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatioCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedSupplyCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CappedSupplyCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -22,7 +24,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 	// Team Address needs to be set manually at Genesis
 	validParams := DefaultParams()
 
-	newGen := NewGenesisState(validParams, uint64(0), epochstypes.DayEpochID, 365, 0)
+	newGen := NewGenesisState(validParams, uint64(0), epochstypes.DayEpochID, 365, 0, math.LegacyZeroDec())
 
 	testCases := []struct {
 		name     string
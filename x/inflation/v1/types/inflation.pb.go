@@ -25,8 +25,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationCurve defines the calculation used to compute the mint provision of
+// each epoch.
+type InflationCurve int32
+
+const (
+	// INFLATION_CURVE_UNSPECIFIED defines an undefined curve, which falls back to
+	// the exponential calculation of the chains created before the curves were
+	// introduced.
+	INFLATION_CURVE_UNSPECIFIED InflationCurve = 0
+	// INFLATION_CURVE_EXPONENTIAL - the provision decays exponentially on each
+	// period, following the ExponentialCalculation.
+	INFLATION_CURVE_EXPONENTIAL InflationCurve = 1
+	// INFLATION_CURVE_BONDED_RATIO - the annual inflation moves towards a goal
+	// bonded ratio, following the BondedRatioCalculation.
+	INFLATION_CURVE_BONDED_RATIO InflationCurve = 2
+	// INFLATION_CURVE_CAPPED_SUPPLY - a fixed annual inflation is minted until the
+	// supply reaches a maximum, following the CappedSupplyCalculation.
+	INFLATION_CURVE_CAPPED_SUPPLY InflationCurve = 3
+)
+
+var InflationCurve_name = map[int32]string{
+	0: "INFLATION_CURVE_UNSPECIFIED",
+	1: "INFLATION_CURVE_EXPONENTIAL",
+	2: "INFLATION_CURVE_BONDED_RATIO",
+	3: "INFLATION_CURVE_CAPPED_SUPPLY",
+}
+
+var InflationCurve_value = map[string]int32{
+	"INFLATION_CURVE_UNSPECIFIED":   0,
+	"INFLATION_CURVE_EXPONENTIAL":   1,
+	"INFLATION_CURVE_BONDED_RATIO":  2,
+	"INFLATION_CURVE_CAPPED_SUPPLY": 3,
+}
+
+func (x InflationCurve) String() string {
+	return proto.EnumName(InflationCurve_name, int32(x))
+}
+
+func (InflationCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
+// allocated through minting on each epoch (staking, community, recipients). It
 // excludes the team vesting distribution, as this is minted once at genesis.
 // The initial InflationDistribution can be calculated from the Evmos Token
 // Model like this:
@@ -42,6 +84,10 @@ type InflationDistribution struct {
 	// community_pool defines the proportion of the minted minted_denom that is to
 	// be allocated to the community pool
 	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// recipients defines the module accounts and addresses that are allocated a
+	// proportion of the minted minted_denom, besides the staking rewards and the
+	// community pool
+	Recipients []InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -77,6 +123,64 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetRecipients() []InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient defines a module account or an address that is allocated
+// a proportion of the minted coins on each epoch.
+type InflationRecipient struct {
+	// address is the name of the recipient module account or the bech32 address
+	// of the recipient account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// share defines the proportion of the minted minted_denom that is to be
+	// allocated to the recipient
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -99,7 +203,7 @@ func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{}
 func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
 func (*ExponentialCalculation) ProtoMessage()    {}
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
 }
 func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,9 +232,109 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// BondedRatioCalculation holds the factors to calculate the annual inflation
+// targeting a bonded ratio, as the dynamic inflation of the Cosmos SDK x/mint
+// module. On each epoch the annual inflation changes by:
+// inflationChange = (1 - bondedRatio / goal_bonded) * inflation_rate_change /
+// epochsPerPeriod
+// and is kept within inflation_min and inflation_max.
+type BondedRatioCalculation struct {
+	// inflation_rate_change defines the maximum annual change in the inflation
+	InflationRateChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate_change"`
+	// inflation_max defines the maximum annual inflation
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
+	// inflation_min defines the minimum annual inflation
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
+	// goal_bonded defines the targeted bonded ratio
+	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
+}
+
+func (m *BondedRatioCalculation) Reset()         { *m = BondedRatioCalculation{} }
+func (m *BondedRatioCalculation) String() string { return proto.CompactTextString(m) }
+func (*BondedRatioCalculation) ProtoMessage()    {}
+func (*BondedRatioCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{3}
+}
+func (m *BondedRatioCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondedRatioCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondedRatioCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondedRatioCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondedRatioCalculation.Merge(m, src)
+}
+func (m *BondedRatioCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondedRatioCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondedRatioCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondedRatioCalculation proto.InternalMessageInfo
+
+// CappedSupplyCalculation holds the factors to calculate a fixed annual
+// inflation of the total supply, which stops once the supply reaches the
+// max_supply. Calculation reference:
+// epochProvision = min(totalSupply * annual_inflation / epochsPerPeriod,
+// max_supply - totalSupply)
+type CappedSupplyCalculation struct {
+	// max_supply defines the maximum total supply of the mint denom
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// annual_inflation defines the proportion of the total supply minted each
+	// period
+	AnnualInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=annual_inflation,json=annualInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_inflation"`
+}
+
+func (m *CappedSupplyCalculation) Reset()         { *m = CappedSupplyCalculation{} }
+func (m *CappedSupplyCalculation) String() string { return proto.CompactTextString(m) }
+func (*CappedSupplyCalculation) ProtoMessage()    {}
+func (*CappedSupplyCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{4}
+}
+func (m *CappedSupplyCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CappedSupplyCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CappedSupplyCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CappedSupplyCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CappedSupplyCalculation.Merge(m, src)
+}
+func (m *CappedSupplyCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CappedSupplyCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CappedSupplyCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CappedSupplyCalculation proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.InflationCurve", InflationCurve_name, InflationCurve_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
+	proto.RegisterType((*InflationRecipient)(nil), "evmos.inflation.v1.InflationRecipient")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*BondedRatioCalculation)(nil), "evmos.inflation.v1.BondedRatioCalculation")
+	proto.RegisterType((*CappedSupplyCalculation)(nil), "evmos.inflation.v1.CappedSupplyCalculation")
 }
 
 func init() {
@@ -138,32 +342,51 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xc7, 0xe3, 0xf2, 0x21, 0x61, 0x60, 0x17, 0x22, 0x40, 0xd1, 0x22, 0x65, 0x51, 0x4f, 0xab,
-	0x3d, 0xc4, 0x14, 0x24, 0x1e, 0xa0, 0x94, 0x43, 0x05, 0x12, 0x50, 0x01, 0x07, 0x2e, 0xd1, 0xc4,
-	0x35, 0xa9, 0xd5, 0xd8, 0x13, 0xd9, 0x4e, 0x48, 0xdf, 0x02, 0xf1, 0x14, 0x1c, 0x79, 0x8c, 0x1e,
-	0x7b, 0x44, 0x1c, 0x2a, 0xd4, 0x1e, 0x78, 0x0d, 0x94, 0x0f, 0x5a, 0x71, 0x23, 0x97, 0xd1, 0x64,
-	0x34, 0xbf, 0x7f, 0x3c, 0x7f, 0x8f, 0xe9, 0x50, 0x94, 0x0a, 0x2d, 0x93, 0xfa, 0x53, 0x06, 0x4e,
-	0xa2, 0x66, 0xe5, 0xe8, 0xf8, 0x11, 0xe5, 0x06, 0x1d, 0xfa, 0x7e, 0xd3, 0x13, 0x1d, 0xcb, 0xe5,
-	0xe8, 0xec, 0x2e, 0x28, 0xa9, 0x91, 0x35, 0xb1, 0x6d, 0x3b, 0xbb, 0x97, 0x62, 0x8a, 0x4d, 0xca,
-	0xea, 0xac, 0xad, 0x0e, 0xbf, 0x0e, 0xe8, 0xfd, 0xe9, 0x5f, 0x72, 0x22, 0xad, 0x33, 0x32, 0x29,
-	0xea, 0xdc, 0x7f, 0x4b, 0x4f, 0xad, 0x83, 0xa5, 0xd4, 0x69, 0x6c, 0xc4, 0x67, 0x30, 0x73, 0x1b,
-	0x90, 0x47, 0xe4, 0xe2, 0xc6, 0xf8, 0x62, 0xbd, 0x3d, 0xf7, 0x7e, 0x6e, 0xcf, 0x1f, 0x72, 0xb4,
-	0x0a, 0xad, 0x9d, 0x2f, 0x23, 0x89, 0x4c, 0x81, 0x5b, 0x44, 0xaf, 0x44, 0x0a, 0x7c, 0x35, 0x11,
-	0xfc, 0xdb, 0xef, 0xef, 0x97, 0x64, 0x76, 0xd2, 0x09, 0xcc, 0x5a, 0xde, 0x7f, 0x4f, 0xef, 0x14,
-	0x16, 0x52, 0x11, 0x4b, 0xcd, 0x85, 0x76, 0xb2, 0x14, 0x36, 0x18, 0x34, 0x9a, 0x97, 0xff, 0xab,
-	0x19, 0x90, 0xd9, 0x69, 0xa3, 0x31, 0x3d, 0x48, 0xf8, 0xaf, 0xe9, 0x09, 0x47, 0xa5, 0x0a, 0x2d,
-	0xdd, 0x2a, 0xce, 0x11, 0xb3, 0xe0, 0x4a, 0xcf, 0x83, 0xde, 0x3e, 0xf0, 0x6f, 0x10, 0xb3, 0xe1,
-	0x76, 0x40, 0x1f, 0xbc, 0xa8, 0x72, 0xd4, 0xf5, 0x1f, 0x20, 0x7b, 0x0e, 0x19, 0x2f, 0x5a, 0x87,
-	0xfc, 0x67, 0x94, 0x40, 0x6f, 0x1f, 0x08, 0xd4, 0x9c, 0xe9, 0x66, 0xed, 0xc1, 0x99, 0x9a, 0xe3,
-	0xbd, 0xc7, 0x21, 0xbc, 0xf6, 0x24, 0x41, 0x3d, 0xaf, 0x6f, 0xcf, 0x81, 0x49, 0x85, 0x0b, 0xae,
-	0xf6, 0xf5, 0xa4, 0xe3, 0xdf, 0x35, 0xb8, 0xff, 0x92, 0xde, 0x52, 0x50, 0xc5, 0x25, 0x18, 0x09,
-	0x9a, 0x8b, 0xe0, 0x5a, 0x4f, 0xb9, 0x9b, 0x0a, 0xaa, 0x0f, 0x1d, 0x3c, 0x9e, 0xae, 0x77, 0x21,
-	0xd9, 0xec, 0x42, 0xf2, 0x6b, 0x17, 0x92, 0x2f, 0xfb, 0xd0, 0xdb, 0xec, 0x43, 0xef, 0xc7, 0x3e,
-	0xf4, 0x3e, 0xb2, 0x54, 0xba, 0x45, 0x91, 0x44, 0x1c, 0x15, 0x6b, 0x77, 0xbf, 0x8d, 0xe5, 0x93,
-	0xc7, 0xac, 0xfa, 0xf7, 0x1d, 0xb8, 0x55, 0x2e, 0x6c, 0x72, 0xbd, 0xd9, 0xe3, 0xa7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xec, 0x57, 0xae, 0x03, 0x2a, 0x03, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xa4, 0xbd, 0x57, 0x9d, 0xfe, 0xcb, 0x9d, 0x7b, 0xdb, 0x6b, 0xb5, 0x90, 0x96,
+	0x2c, 0x50, 0xd5, 0x45, 0x4c, 0x8b, 0xc4, 0x12, 0x29, 0x7f, 0x5c, 0xc9, 0x22, 0x4d, 0x52, 0xa7,
+	0xa9, 0x00, 0x21, 0x59, 0x27, 0xf6, 0xe0, 0x8c, 0x6a, 0xcf, 0x58, 0xf6, 0x38, 0x38, 0x6f, 0xc0,
+	0x92, 0x17, 0x60, 0xc5, 0x86, 0x25, 0xbc, 0x45, 0x97, 0x5d, 0x22, 0x16, 0x05, 0xb5, 0x42, 0xbc,
+	0x06, 0xb2, 0x9d, 0xba, 0xa5, 0x65, 0x81, 0xd9, 0x58, 0x33, 0x93, 0xf3, 0xfd, 0xce, 0xcc, 0xf7,
+	0x4d, 0x34, 0xa8, 0x4a, 0xc6, 0x2e, 0x0f, 0x14, 0xca, 0x5e, 0x3a, 0x20, 0x28, 0x67, 0xca, 0x78,
+	0xe7, 0x6a, 0x52, 0xf3, 0x7c, 0x2e, 0x38, 0xc6, 0x49, 0x4d, 0xed, 0x6a, 0x79, 0xbc, 0xb3, 0xf6,
+	0x0f, 0xb8, 0x94, 0x71, 0x25, 0xf9, 0xa6, 0x65, 0x6b, 0xff, 0xd9, 0xdc, 0xe6, 0xc9, 0x50, 0x89,
+	0x47, 0xe9, 0x6a, 0xf5, 0x5b, 0x11, 0xad, 0x68, 0x97, 0xca, 0x16, 0x0d, 0x84, 0x4f, 0x87, 0x61,
+	0x3c, 0xc6, 0x07, 0x68, 0x39, 0x10, 0x70, 0x4c, 0x99, 0x6d, 0xf8, 0xe4, 0x15, 0xf8, 0x56, 0x20,
+	0x4b, 0x9b, 0xd2, 0xd6, 0x5c, 0x63, 0xeb, 0xe4, 0x6c, 0xa3, 0xf0, 0xf9, 0x6c, 0x63, 0xdd, 0xe4,
+	0x81, 0xcb, 0x83, 0xc0, 0x3a, 0xae, 0x51, 0xae, 0xb8, 0x20, 0x46, 0xb5, 0x36, 0xb1, 0xc1, 0x9c,
+	0xb4, 0x88, 0xf9, 0xfe, 0xfb, 0x87, 0x6d, 0x49, 0x5f, 0x9a, 0x02, 0xf4, 0x54, 0x8f, 0x07, 0xa8,
+	0x1c, 0x06, 0x60, 0x13, 0x83, 0x32, 0x93, 0x30, 0x41, 0xc7, 0x24, 0x90, 0x8b, 0x09, 0x73, 0xfb,
+	0x77, 0x99, 0xb2, 0xa4, 0x2f, 0x27, 0x0c, 0x2d, 0x43, 0xe0, 0x2e, 0x5a, 0x32, 0xb9, 0xeb, 0x86,
+	0x8c, 0x8a, 0x89, 0xe1, 0x71, 0xee, 0xc8, 0xa5, 0x9c, 0x1b, 0x5d, 0xcc, 0xf4, 0x3d, 0xce, 0x1d,
+	0x7c, 0x80, 0x90, 0x4f, 0x4c, 0xea, 0x51, 0xc2, 0x44, 0x20, 0xcf, 0x6c, 0x96, 0xb6, 0xe6, 0x77,
+	0xef, 0xd7, 0x6e, 0xdb, 0x5c, 0xcb, 0x9c, 0xd3, 0x2f, 0xcb, 0x1b, 0x73, 0x71, 0xd3, 0x94, 0x7a,
+	0x0d, 0x52, 0x65, 0x08, 0xdf, 0x2e, 0xc6, 0x32, 0xfa, 0x1b, 0x2c, 0xcb, 0x27, 0xc1, 0xd4, 0x5b,
+	0xfd, 0x72, 0x8a, 0x1f, 0xa3, 0xd9, 0x60, 0x04, 0x3e, 0x91, 0x8b, 0x39, 0x8f, 0x92, 0xca, 0xaa,
+	0x67, 0x45, 0xb4, 0xaa, 0x46, 0x1e, 0x67, 0xb1, 0x49, 0xe0, 0x34, 0xc1, 0x31, 0xc3, 0xb4, 0x3b,
+	0x7e, 0x84, 0x24, 0xc8, 0x1d, 0xa5, 0x04, 0xb1, 0xce, 0xcf, 0xbd, 0x1d, 0xc9, 0x8f, 0x75, 0x66,
+	0xee, 0x44, 0x24, 0x33, 0x8e, 0x75, 0xc8, 0x99, 0x15, 0x5f, 0x40, 0x01, 0xbe, 0x4d, 0x84, 0x3c,
+	0x93, 0x37, 0xd6, 0xa9, 0xfe, 0x30, 0x91, 0xe3, 0x27, 0x68, 0xc1, 0x85, 0xc8, 0x18, 0x83, 0x4f,
+	0x81, 0x99, 0x44, 0x9e, 0xcd, 0x89, 0x9b, 0x77, 0x21, 0x3a, 0x9a, 0x8a, 0xab, 0x5f, 0x8a, 0x68,
+	0xb5, 0xc1, 0x99, 0x45, 0x2c, 0x3d, 0xb6, 0xf5, 0xba, 0xc1, 0x2f, 0xd0, 0x4a, 0x76, 0x4b, 0x0c,
+	0x1f, 0x04, 0x31, 0xcc, 0x11, 0x30, 0x9b, 0xe4, 0x36, 0xfd, 0xdf, 0x0c, 0xa3, 0x83, 0x20, 0xcd,
+	0x04, 0x82, 0xf7, 0xd1, 0xe2, 0x15, 0xdd, 0x85, 0x28, 0x77, 0x24, 0x0b, 0x99, 0x7c, 0x1f, 0xa2,
+	0x1b, 0x38, 0xca, 0xe4, 0xd2, 0x9f, 0xe3, 0x28, 0xc3, 0x1a, 0x9a, 0xb7, 0x39, 0x38, 0xc6, 0x30,
+	0xb1, 0x26, 0x77, 0x62, 0x28, 0x16, 0xa7, 0xb6, 0x56, 0x3f, 0x4a, 0xe8, 0xff, 0x26, 0x78, 0x1e,
+	0xb1, 0xfa, 0xa1, 0xe7, 0x39, 0x93, 0xeb, 0x16, 0xd7, 0x11, 0x8a, 0xa3, 0x0c, 0x92, 0x1f, 0xa6,
+	0xbe, 0x56, 0xa7, 0x5d, 0x56, 0x6e, 0x77, 0xd1, 0x98, 0x48, 0xf9, 0x73, 0x2e, 0x44, 0x29, 0x0d,
+	0xf7, 0x51, 0x19, 0x18, 0x0b, 0xc1, 0x31, 0xb2, 0x03, 0xe4, 0xb6, 0x72, 0x39, 0x25, 0x64, 0xff,
+	0xec, 0xed, 0xb7, 0x12, 0x5a, 0xca, 0x66, 0xcd, 0xd0, 0x1f, 0x13, 0xbc, 0x81, 0xd6, 0xb5, 0xce,
+	0x5e, 0xbb, 0x7e, 0xa8, 0x75, 0x3b, 0x46, 0x73, 0xa0, 0x1f, 0xa9, 0xc6, 0xa0, 0xd3, 0xef, 0xa9,
+	0x4d, 0x6d, 0x4f, 0x53, 0x5b, 0xe5, 0xc2, 0xaf, 0x0a, 0xd4, 0xa7, 0xbd, 0x6e, 0x47, 0xed, 0x1c,
+	0x6a, 0xf5, 0x76, 0x59, 0xc2, 0x9b, 0xe8, 0xce, 0xcd, 0x82, 0x46, 0xb7, 0xd3, 0x52, 0x5b, 0x86,
+	0x1e, 0xaf, 0x95, 0x8b, 0xf8, 0x1e, 0xba, 0x7b, 0xb3, 0xa2, 0x59, 0xef, 0xf5, 0xd4, 0x96, 0xd1,
+	0x1f, 0xf4, 0x7a, 0xed, 0x67, 0xe5, 0xd2, 0xda, 0xcc, 0xeb, 0x77, 0x95, 0x42, 0x43, 0x3b, 0x39,
+	0xaf, 0x48, 0xa7, 0xe7, 0x15, 0xe9, 0xeb, 0x79, 0x45, 0x7a, 0x73, 0x51, 0x29, 0x9c, 0x5e, 0x54,
+	0x0a, 0x9f, 0x2e, 0x2a, 0x85, 0xe7, 0x8a, 0x4d, 0xc5, 0x28, 0x1c, 0xd6, 0x4c, 0xee, 0x2a, 0xe9,
+	0xa3, 0x93, 0x7e, 0xc7, 0xbb, 0x0f, 0x94, 0xe8, 0xe7, 0x07, 0x48, 0x4c, 0x3c, 0x12, 0x0c, 0xff,
+	0x4a, 0x1e, 0x90, 0x87, 0x3f, 0x06, 0x00, 0x74, 0x5a, 0xb7, 0x5d, 0xa3, 0x06, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -186,6 +409,20 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.CommunityPool.Size()
 		i -= size
@@ -219,6 +456,46 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -292,6 +569,112 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *BondedRatioCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondedRatioCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondedRatioCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CappedSupplyCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CappedSupplyCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CappedSupplyCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualInflation.Size()
+		i -= size
+		if _, err := m.AnnualInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -315,6 +698,27 @@ func (m *InflationDistribution) Size() (n int) {
 	n += 1 + l + sovInflation(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovInflation(uint64(l))
 	return n
 }
 
@@ -337,6 +741,36 @@ func (m *ExponentialCalculation) Size() (n int) {
 	return n
 }
 
+func (m *BondedRatioCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *CappedSupplyCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.AnnualInflation.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -474,22 +908,172 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInflation(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInflation
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -715,6 +1299,310 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BondedRatioCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondedRatioCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondedRatioCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CappedSupplyCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CappedSupplyCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CappedSupplyCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInflation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
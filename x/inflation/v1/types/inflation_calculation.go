@@ -71,3 +71,131 @@ func CalculateEpochMintProvision(
 	epochProvision = epochProvision.Mul(math.LegacyNewDecFromInt(evmostypes.PowerReduction))
 	return epochProvision
 }
+
+// InflationState holds the chain state used by the inflation curves to compute
+// the mint provision of an epoch.
+type InflationState struct {
+	// Period is the number of past periods
+	Period uint64
+	// EpochsPerPeriod is the number of epochs after which inflation is recalculated
+	EpochsPerPeriod int64
+	// BondedRatio is the fraction of the staking tokens which are bonded
+	BondedRatio math.LegacyDec
+	// CirculatingSupply is the supply of the mint denom excluding the team allocation
+	CirculatingSupply math.LegacyDec
+	// TotalSupply is the bank supply of the mint denom
+	TotalSupply math.Int
+	// AnnualInflation is the annual inflation of the bonded ratio curve
+	AnnualInflation math.LegacyDec
+}
+
+// InflationCalculation defines the calculation of an inflation curve.
+type InflationCalculation interface {
+	// EpochMintProvision returns the amount of the mint denom to be minted on
+	// the epoch for the given state.
+	EpochMintProvision(state InflationState) math.LegacyDec
+}
+
+var (
+	_ InflationCalculation = ExponentialCalculation{}
+	_ InflationCalculation = BondedRatioCalculation{}
+	_ InflationCalculation = CappedSupplyCalculation{}
+)
+
+// Calculation returns the calculation of the inflation curve selected by the
+// params. The unspecified curve falls back to the exponential calculation.
+func (p Params) Calculation() InflationCalculation {
+	switch p.InflationCurve {
+	case INFLATION_CURVE_BONDED_RATIO:
+		return p.BondedRatioCalculation
+	case INFLATION_CURVE_CAPPED_SUPPLY:
+		return p.CappedSupplyCalculation
+	default:
+		return p.ExponentialCalculation
+	}
+}
+
+// IsExponential returns true if the params select the exponential inflation
+// curve.
+func (p Params) IsExponential() bool {
+	return p.InflationCurve == INFLATION_CURVE_UNSPECIFIED || p.InflationCurve == INFLATION_CURVE_EXPONENTIAL
+}
+
+// EpochMintProvision implements InflationCalculation. It returns the exponential
+// decay provision computed by CalculateEpochMintProvision.
+func (ec ExponentialCalculation) EpochMintProvision(state InflationState) math.LegacyDec {
+	return CalculateEpochMintProvision(
+		Params{ExponentialCalculation: ec},
+		state.Period,
+		state.EpochsPerPeriod,
+		state.BondedRatio,
+	)
+}
+
+// NextAnnualInflation returns the annual inflation after an epoch, which moves
+// towards the maximum inflation while the bonded ratio is below the goal and
+// towards the minimum inflation while it is above:
+//
+// inflation = inflation + (1 - bondedRatio / goalBonded) * inflationRateChange / epochsPerPeriod
+//
+// The result is kept within the minimum and maximum inflation.
+func (bc BondedRatioCalculation) NextAnnualInflation(
+	inflation, bondedRatio math.LegacyDec,
+	epochsPerPeriod int64,
+) math.LegacyDec {
+	if epochsPerPeriod > 0 && bc.GoalBonded.IsPositive() {
+		change := math.LegacyOneDec().
+			Sub(bondedRatio.Quo(bc.GoalBonded)).
+			Mul(bc.InflationRateChange).
+			QuoInt64(epochsPerPeriod)
+		inflation = bc.boundInflation(inflation).Add(change)
+	}
+
+	return bc.boundInflation(inflation)
+}
+
+// EpochMintProvision implements InflationCalculation. It returns the share of
+// the annual inflation of the circulating supply minted on each epoch:
+//
+// epochProvision = annualInflation * circulatingSupply / epochsPerPeriod
+func (bc BondedRatioCalculation) EpochMintProvision(state InflationState) math.LegacyDec {
+	if state.EpochsPerPeriod <= 0 || state.CirculatingSupply.IsNil() || !state.CirculatingSupply.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return bc.boundInflation(state.AnnualInflation).
+		Mul(state.CirculatingSupply).
+		QuoInt64(state.EpochsPerPeriod)
+}
+
+// boundInflation keeps the annual inflation within the minimum and maximum
+// inflation. An unset inflation starts at the minimum inflation.
+func (bc BondedRatioCalculation) boundInflation(inflation math.LegacyDec) math.LegacyDec {
+	switch {
+	case inflation.IsNil() || inflation.LT(bc.InflationMin):
+		return bc.InflationMin
+	case inflation.GT(bc.InflationMax):
+		return bc.InflationMax
+	default:
+		return inflation
+	}
+}
+
+// EpochMintProvision implements InflationCalculation. It returns the share of
+// the annual inflation of the total supply minted on each epoch, without
+// exceeding the max supply:
+//
+// epochProvision = min(annualInflation * totalSupply / epochsPerPeriod, maxSupply - totalSupply)
+func (cc CappedSupplyCalculation) EpochMintProvision(state InflationState) math.LegacyDec {
+	if state.EpochsPerPeriod <= 0 || state.TotalSupply.IsNil() || cc.MaxSupply.IsNil() ||
+		state.TotalSupply.GTE(cc.MaxSupply) {
+		return math.LegacyZeroDec()
+	}
+
+	epochProvision := cc.AnnualInflation.
+		MulInt(state.TotalSupply).
+		QuoInt64(state.EpochsPerPeriod)
+
+	remaining := math.LegacyNewDecFromInt(cc.MaxSupply.Sub(state.TotalSupply))
+	return math.LegacyMinDec(epochProvision, remaining)
+}
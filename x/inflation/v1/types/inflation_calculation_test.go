@@ -157,3 +157,171 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculation() {
+	params := DefaultParams()
+
+	params.InflationCurve = INFLATION_CURVE_UNSPECIFIED
+	suite.Require().Equal(params.ExponentialCalculation, params.Calculation())
+	suite.Require().True(params.IsExponential())
+
+	params.InflationCurve = INFLATION_CURVE_EXPONENTIAL
+	suite.Require().Equal(params.ExponentialCalculation, params.Calculation())
+	suite.Require().True(params.IsExponential())
+
+	params.InflationCurve = INFLATION_CURVE_BONDED_RATIO
+	suite.Require().Equal(params.BondedRatioCalculation, params.Calculation())
+	suite.Require().False(params.IsExponential())
+
+	params.InflationCurve = INFLATION_CURVE_CAPPED_SUPPLY
+	suite.Require().Equal(params.CappedSupplyCalculation, params.Calculation())
+	suite.Require().False(params.IsExponential())
+
+	// the exponential strategy matches the legacy calculation
+	state := InflationState{Period: 1, EpochsPerPeriod: 365, BondedRatio: math.LegacyOneDec()}
+	suite.Require().Equal(
+		CalculateEpochMintProvision(params, state.Period, state.EpochsPerPeriod, state.BondedRatio),
+		params.ExponentialCalculation.EpochMintProvision(state),
+	)
+}
+
+func (suite *InflationTestSuite) TestNextAnnualInflation() {
+	calculation := DefaultBondedRatioCalculation
+	epochsPerPeriod := int64(365)
+
+	testCases := []struct {
+		name         string
+		inflation    math.LegacyDec
+		bondedRatio  math.LegacyDec
+		expInflation math.LegacyDec
+	}{
+		{
+			"unset inflation starts at the min inflation",
+			math.LegacyDec{},
+			calculation.GoalBonded,
+			calculation.InflationMin,
+		},
+		{
+			"bonded ratio at goal keeps the inflation",
+			math.LegacyNewDecWithPrec(10, 2),
+			calculation.GoalBonded,
+			math.LegacyNewDecWithPrec(10, 2),
+		},
+		{
+			"no bonded tokens increase the inflation",
+			math.LegacyNewDecWithPrec(10, 2),
+			math.LegacyZeroDec(),
+			// 0.1 + (1 - 0 / 0.67) * 0.13 / 365
+			math.LegacyNewDecWithPrec(10, 2).Add(math.LegacyNewDecWithPrec(13, 2).QuoInt64(365)),
+		},
+		{
+			"all tokens bonded decrease the inflation",
+			math.LegacyNewDecWithPrec(10, 2),
+			math.LegacyOneDec(),
+			// 0.1 + (1 - 1 / 0.67) * 0.13 / 365
+			math.LegacyNewDecWithPrec(10, 2).Add(
+				math.LegacyOneDec().Sub(math.LegacyOneDec().Quo(calculation.GoalBonded)).
+					Mul(math.LegacyNewDecWithPrec(13, 2)).
+					QuoInt64(365),
+			),
+		},
+		{
+			"inflation is capped at the max inflation",
+			calculation.InflationMax,
+			math.LegacyZeroDec(),
+			calculation.InflationMax,
+		},
+		{
+			"inflation is floored at the min inflation",
+			calculation.InflationMin,
+			math.LegacyOneDec(),
+			calculation.InflationMin,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			inflation := calculation.NextAnnualInflation(tc.inflation, tc.bondedRatio, epochsPerPeriod)
+			suite.Require().Equal(tc.expInflation, inflation)
+		})
+	}
+}
+
+func (suite *InflationTestSuite) TestBondedRatioEpochMintProvision() {
+	calculation := DefaultBondedRatioCalculation
+	supply := math.LegacyNewDec(365_000)
+
+	testCases := []struct {
+		name              string
+		state             InflationState
+		expEpochProvision math.LegacyDec
+	}{
+		{
+			"annual inflation of the circulating supply",
+			InflationState{EpochsPerPeriod: 365, CirculatingSupply: supply, AnnualInflation: math.LegacyNewDecWithPrec(10, 2)},
+			// 365_000 * 0.1 / 365
+			math.LegacyNewDec(100),
+		},
+		{
+			"annual inflation is bounded",
+			InflationState{EpochsPerPeriod: 365, CirculatingSupply: supply, AnnualInflation: math.LegacyOneDec()},
+			// 365_000 * 0.2 / 365
+			math.LegacyNewDec(200),
+		},
+		{
+			"zero epochs per period",
+			InflationState{CirculatingSupply: supply, AnnualInflation: math.LegacyNewDecWithPrec(10, 2)},
+			math.LegacyZeroDec(),
+		},
+		{
+			"zero circulating supply",
+			InflationState{EpochsPerPeriod: 365, CirculatingSupply: math.LegacyZeroDec(), AnnualInflation: math.LegacyNewDecWithPrec(10, 2)},
+			math.LegacyZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().Equal(tc.expEpochProvision, calculation.EpochMintProvision(tc.state))
+		})
+	}
+}
+
+func (suite *InflationTestSuite) TestCappedSupplyEpochMintProvision() {
+	calculation := CappedSupplyCalculation{
+		MaxSupply:       math.NewInt(1_000_000),
+		AnnualInflation: math.LegacyNewDecWithPrec(73, 3), // 7.3%
+	}
+
+	testCases := []struct {
+		name              string
+		totalSupply       math.Int
+		expEpochProvision math.LegacyDec
+	}{
+		{
+			"annual inflation of the total supply",
+			math.NewInt(500_000),
+			// 500_000 * 0.073 / 365
+			math.LegacyNewDec(100),
+		},
+		{
+			"provision stops at the max supply",
+			math.NewInt(999_950),
+			math.LegacyNewDec(50),
+		},
+		{
+			"supply at the max supply",
+			math.NewInt(1_000_000),
+			math.LegacyZeroDec(),
+		},
+		{
+			"supply above the max supply",
+			math.NewInt(1_000_001),
+			math.LegacyZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			state := InflationState{EpochsPerPeriod: 365, TotalSupply: tc.totalSupply}
+			suite.Require().Equal(tc.expEpochProvision, calculation.EpochMintProvision(state))
+		})
+	}
+}
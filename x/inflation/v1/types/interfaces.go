@@ -30,6 +30,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -10,6 +10,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	evmostypes "github.com/evmos/evmos/v20/types"
 )
 
@@ -23,6 +25,15 @@ var FoundationWallets = []string{
 	"0xCdf843a5102cc1B3098940552faA9e6F39b3Bb61",
 }
 
+// ReservedRecipients contains the names of the module accounts that cannot be
+// inflation recipients, as their balances are accounted for by their modules.
+var ReservedRecipients = []string{
+	authtypes.FeeCollectorName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	ModuleName,
+}
+
 var ParamsKey = []byte("Params")
 
 var (
@@ -40,6 +51,17 @@ var (
 		CommunityPool:   math.LegacyNewDecWithPrec(466666666, 9), // 0.47
 		UsageIncentives: math.LegacyZeroDec(),                    // Deprecated
 	}
+	DefaultInflationCurve         = INFLATION_CURVE_EXPONENTIAL
+	DefaultBondedRatioCalculation = BondedRatioCalculation{
+		InflationRateChange: math.LegacyNewDecWithPrec(13, 2), // 13%
+		InflationMax:        math.LegacyNewDecWithPrec(20, 2), // 20%
+		InflationMin:        math.LegacyNewDecWithPrec(7, 2),  // 7%
+		GoalBonded:          math.LegacyNewDecWithPrec(67, 2), // 67%
+	}
+	DefaultCappedSupplyCalculation = CappedSupplyCalculation{
+		MaxSupply:       math.NewInt(1_000_000_000).Mul(evmostypes.PowerReduction), // 1B evmos
+		AnnualInflation: math.LegacyNewDecWithPrec(5, 2),                           // 5%
+	}
 )
 
 func NewParams(
@@ -49,20 +71,26 @@ func NewParams(
 	enableInflation bool,
 ) Params {
 	return Params{
-		MintDenom:              mintDenom,
-		ExponentialCalculation: exponentialCalculation,
-		InflationDistribution:  inflationDistribution,
-		EnableInflation:        enableInflation,
+		MintDenom:               mintDenom,
+		ExponentialCalculation:  exponentialCalculation,
+		InflationDistribution:   inflationDistribution,
+		EnableInflation:         enableInflation,
+		InflationCurve:          DefaultInflationCurve,
+		BondedRatioCalculation:  DefaultBondedRatioCalculation,
+		CappedSupplyCalculation: DefaultCappedSupplyCalculation,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:              DefaultInflationDenom,
-		ExponentialCalculation: DefaultExponentialCalculation,
-		InflationDistribution:  DefaultInflationDistribution,
-		EnableInflation:        DefaultInflation,
+		MintDenom:               DefaultInflationDenom,
+		ExponentialCalculation:  DefaultExponentialCalculation,
+		InflationDistribution:   DefaultInflationDistribution,
+		EnableInflation:         DefaultInflation,
+		InflationCurve:          DefaultInflationCurve,
+		BondedRatioCalculation:  DefaultBondedRatioCalculation,
+		CappedSupplyCalculation: DefaultCappedSupplyCalculation,
 	}
 }
 
@@ -121,6 +149,80 @@ func validateExponentialCalculation(i interface{}) error {
 	return nil
 }
 
+func validateInflationCurve(i interface{}) error {
+	v, ok := i.(InflationCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationCurve_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation curve: %d", v)
+	}
+
+	return nil
+}
+
+func validateBondedRatioCalculation(i interface{}) error {
+	v, ok := i.(BondedRatioCalculation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// validate inflation rate change
+	if v.InflationRateChange.IsNil() || v.InflationRateChange.IsNegative() {
+		return fmt.Errorf("inflation rate change cannot be negative")
+	}
+
+	if v.InflationRateChange.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation rate change cannot be greater than 1")
+	}
+
+	// validate inflation bounds
+	if v.InflationMin.IsNil() || v.InflationMin.IsNegative() {
+		return fmt.Errorf("min inflation cannot be negative")
+	}
+
+	if v.InflationMax.IsNil() || v.InflationMax.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max inflation cannot be greater than 1")
+	}
+
+	if v.InflationMax.LT(v.InflationMin) {
+		return fmt.Errorf("max inflation (%s) cannot be less than min inflation (%s)", v.InflationMax, v.InflationMin)
+	}
+
+	// validate goal bonded
+	if v.GoalBonded.IsNil() || !v.GoalBonded.IsPositive() {
+		return fmt.Errorf("goal bonded cannot be zero or negative")
+	}
+
+	if v.GoalBonded.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("goal bonded cannot be greater than 1")
+	}
+
+	return nil
+}
+
+func validateCappedSupplyCalculation(i interface{}) error {
+	v, ok := i.(CappedSupplyCalculation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.MaxSupply.IsNil() || !v.MaxSupply.IsPositive() {
+		return fmt.Errorf("max supply cannot be zero or negative")
+	}
+
+	if v.AnnualInflation.IsNil() || v.AnnualInflation.IsNegative() {
+		return fmt.Errorf("annual inflation cannot be negative")
+	}
+
+	if v.AnnualInflation.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("annual inflation cannot be greater than 1")
+	}
+
+	return nil
+}
+
 func validateInflationDistribution(i interface{}) error {
 	v, ok := i.(InflationDistribution)
 	if !ok {
@@ -140,6 +242,21 @@ func validateInflationDistribution(i interface{}) error {
 	}
 
 	totalProportions := v.StakingRewards.Add(v.UsageIncentives).Add(v.CommunityPool)
+
+	seenRecipients := make(map[string]bool, len(v.Recipients))
+	for _, recipient := range v.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+
+		if seenRecipients[recipient.Address] {
+			return fmt.Errorf("duplicate inflation recipient: %s", recipient.Address)
+		}
+		seenRecipients[recipient.Address] = true
+
+		totalProportions = totalProportions.Add(recipient.Share)
+	}
+
 	if !totalProportions.Equal(math.LegacyNewDec(1)) {
 		return errors.New("total distributions ratio should be 1")
 	}
@@ -147,6 +264,32 @@ func validateInflationDistribution(i interface{}) error {
 	return nil
 }
 
+// Validate performs a stateless validation of the inflation recipient.
+func (r InflationRecipient) Validate() error {
+	if strings.TrimSpace(r.Address) == "" {
+		return errors.New("inflation recipient address cannot be blank")
+	}
+
+	if r.Share.IsNil() || !r.Share.IsPositive() {
+		return fmt.Errorf("inflation recipient %s share must be positive", r.Address)
+	}
+
+	for _, reserved := range ReservedRecipients {
+		if r.Address == reserved || r.Address == authtypes.NewModuleAddress(reserved).String() {
+			return fmt.Errorf("inflation recipient %s is a reserved module account", r.Address)
+		}
+	}
+
+	return nil
+}
+
+// IsModuleAccount returns true if the recipient is a module account name
+// rather than a bech32 account address.
+func (r InflationRecipient) IsModuleAccount() bool {
+	_, err := sdk.AccAddressFromBech32(r.Address)
+	return err != nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -166,6 +309,22 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := validateInflationCurve(p.InflationCurve); err != nil {
+		return err
+	}
+
+	// the factors of a curve are only used, and therefore validated, when the
+	// curve is selected
+	switch p.InflationCurve {
+	case INFLATION_CURVE_BONDED_RATIO:
+		if err := validateBondedRatioCalculation(p.BondedRatioCalculation); err != nil {
+			return err
+		}
+	case INFLATION_CURVE_CAPPED_SUPPLY:
+		if err := validateCappedSupplyCalculation(p.CappedSupplyCalculation); err != nil {
+			return err
+		}
+	}
 
 	return validateBool(p.EnableInflation)
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -248,6 +250,254 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			true,
 		},
+		{
+			"valid - inflation distribution - recipients",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+					Recipients: []InflationRecipient{
+						{Address: "erc20", Share: math.LegacyNewDecWithPrec(1, 1)},
+						{Address: "evmos1hajh6rhhkjqkwet6wqld3lgx8ur4y3khljfx82", Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			false,
+		},
+		{
+			"invalid - inflation distribution - recipients total distribution ratio unequal 1",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+					Recipients: []InflationRecipient{
+						{Address: "erc20", Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - blank recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+					Recipients: []InflationRecipient{
+						{Address: " ", Share: math.LegacyNewDecWithPrec(2, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - zero recipient share",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(5, 1),
+					Recipients: []InflationRecipient{
+						{Address: "erc20", Share: math.LegacyZeroDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - fee collector recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(4, 1),
+					Recipients: []InflationRecipient{
+						{Address: authtypes.FeeCollectorName, Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - bonded pool recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(4, 1),
+					Recipients: []InflationRecipient{
+						{Address: stakingtypes.BondedPoolName, Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - not bonded pool address recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(4, 1),
+					Recipients: []InflationRecipient{
+						{Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(), Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(5, 1),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(3, 1),
+					Recipients: []InflationRecipient{
+						{Address: "erc20", Share: math.LegacyNewDecWithPrec(1, 1)},
+						{Address: "erc20", Share: math.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"valid - bonded ratio curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_BONDED_RATIO,
+				BondedRatioCalculation: DefaultBondedRatioCalculation,
+			},
+			false,
+		},
+		{
+			"valid - capped supply curve",
+			Params{
+				MintDenom:               DefaultInflationDenom,
+				ExponentialCalculation:  validExponentialCalculation,
+				InflationDistribution:   validInflationDistribution,
+				EnableInflation:         true,
+				InflationCurve:          INFLATION_CURVE_CAPPED_SUPPLY,
+				CappedSupplyCalculation: DefaultCappedSupplyCalculation,
+			},
+			false,
+		},
+		{
+			"invalid - inflation curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         InflationCurve(4),
+			},
+			true,
+		},
+		{
+			"invalid - bonded ratio curve - empty calculation",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_BONDED_RATIO,
+			},
+			true,
+		},
+		{
+			"invalid - bonded ratio curve - max inflation below min inflation",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_BONDED_RATIO,
+				BondedRatioCalculation: BondedRatioCalculation{
+					InflationRateChange: math.LegacyNewDecWithPrec(13, 2),
+					InflationMax:        math.LegacyNewDecWithPrec(5, 2),
+					InflationMin:        math.LegacyNewDecWithPrec(7, 2),
+					GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
+				},
+			},
+			true,
+		},
+		{
+			"invalid - bonded ratio curve - zero goal bonded",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_BONDED_RATIO,
+				BondedRatioCalculation: BondedRatioCalculation{
+					InflationRateChange: math.LegacyNewDecWithPrec(13, 2),
+					InflationMax:        math.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        math.LegacyNewDecWithPrec(7, 2),
+					GoalBonded:          math.LegacyZeroDec(),
+				},
+			},
+			true,
+		},
+		{
+			"invalid - capped supply curve - zero max supply",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_CAPPED_SUPPLY,
+				CappedSupplyCalculation: CappedSupplyCalculation{
+					MaxSupply:       math.ZeroInt(),
+					AnnualInflation: math.LegacyNewDecWithPrec(5, 2),
+				},
+			},
+			true,
+		},
+		{
+			"invalid - capped supply curve - negative annual inflation",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_CAPPED_SUPPLY,
+				CappedSupplyCalculation: CappedSupplyCalculation{
+					MaxSupply:       math.NewInt(1_000_000),
+					AnnualInflation: math.LegacyNewDecWithPrec(-5, 2),
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
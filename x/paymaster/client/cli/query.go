@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+// GetQueryCmd returns the cli query commands for the paymaster module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the paymaster module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetSponsorshipsCmd(),
+		GetSponsorshipCmd(),
+		GetParamsCmd(),
+	)
+
+	return cmd
+}
+
+// GetSponsorshipsCmd queries the sponsorships granted to a sender
+func GetSponsorshipsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorships SENDER",
+		Short: "Gets the sponsorships granted to a sender hex address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySponsorshipsRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Sponsorships(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorships")
+	return cmd
+}
+
+// GetSponsorshipCmd queries the sponsorship granted by a paymaster to a sender
+func GetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship SENDER PAYMASTER",
+		Short: "Gets the sponsorship granted by a paymaster to a sender hex address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySponsorshipRequest{
+				Sender:    args[0],
+				Paymaster: args[1],
+			}
+
+			res, err := queryClient.Sponsorship(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Sponsorship)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets paymaster params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
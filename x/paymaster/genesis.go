@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package paymaster
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/paymaster/keeper"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		k.SetSponsorship(ctx, sponsorship)
+	}
+
+	for _, selection := range data.Selections {
+		k.SetSelectedPaymaster(ctx, selection.GetSenderAddress(), selection.GetPaymasterAddress())
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:       k.GetParams(ctx),
+		Sponsorships: k.GetSponsorships(ctx),
+		Selections:   k.GetPaymasterSelections(ctx),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Sponsorships returns the sponsorships granted to a sender
+func (k Keeper) Sponsorships(c context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Sender); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sponsorships []types.Sponsorship
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsorshipsKey(common.HexToAddress(req.Sender)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var sponsorship types.Sponsorship
+		if err := k.cdc.Unmarshal(value, &sponsorship); err != nil {
+			return err
+		}
+		sponsorships = append(sponsorships, sponsorship)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Sponsorship returns the sponsorship granted by a paymaster to a sender
+func (k Keeper) Sponsorship(c context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Sender); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.Sender)
+	}
+
+	if err := evmostypes.ValidateAddress(req.Paymaster); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid paymaster address %s", req.Paymaster)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsorship, found := k.GetSponsorship(ctx, common.HexToAddress(req.Sender), common.HexToAddress(req.Paymaster))
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"sponsorship for sender %s by paymaster %s not found", req.Sender, req.Paymaster,
+		)
+	}
+
+	return &types.QuerySponsorshipResponse{Sponsorship: sponsorship}, nil
+}
+
+// Params returns the params of the paymaster module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
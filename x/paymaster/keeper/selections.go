@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+// GetSelectedPaymaster returns the paymaster selected by the sender to pay the
+// fees of its transactions.
+func (k Keeper) GetSelectedPaymaster(ctx sdk.Context, sender common.Address) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SelectedPaymasterKey(sender))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetSelectedPaymaster stores the paymaster selected by the sender.
+func (k Keeper) SetSelectedPaymaster(ctx sdk.Context, sender, paymaster common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SelectedPaymasterKey(sender), paymaster.Bytes())
+}
+
+// DeleteSelectedPaymaster removes the paymaster selected by the sender.
+func (k Keeper) DeleteSelectedPaymaster(ctx sdk.Context, sender common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SelectedPaymasterKey(sender))
+}
+
+// GetPaymasterSelections returns all the paymaster selections stored.
+func (k Keeper) GetPaymasterSelections(ctx sdk.Context) []types.PaymasterSelection {
+	selections := []types.PaymasterSelection{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSelectedPaymaster)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sender := common.BytesToAddress(iterator.Key())
+		paymaster := common.BytesToAddress(iterator.Value())
+		selections = append(selections, types.NewPaymasterSelection(sender, paymaster))
+	}
+
+	return selections
+}
+
+// SelectPaymaster opts the sender in to have the fees of its transactions paid
+// by the sponsorship of the given paymaster. Selecting the zero address clears
+// the selection.
+func (k Keeper) SelectPaymaster(ctx sdk.Context, sender, paymaster common.Address) error {
+	if paymaster == (common.Address{}) {
+		k.DeleteSelectedPaymaster(ctx, sender)
+		return nil
+	}
+
+	selection := types.NewPaymasterSelection(sender, paymaster)
+	if err := selection.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPaymasterSelection, err.Error())
+	}
+
+	k.SetSelectedPaymaster(ctx, sender, paymaster)
+	return nil
+}
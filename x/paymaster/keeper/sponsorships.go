@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+// GetSponsorship returns the sponsorship granted by the paymaster to the sender.
+func (k Keeper) GetSponsorship(ctx sdk.Context, sender, paymaster common.Address) (types.Sponsorship, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SponsorshipKey(sender, paymaster))
+	if len(bz) == 0 {
+		return types.Sponsorship{}, false
+	}
+
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetSponsorship stores a sponsorship.
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SponsorshipKey(sponsorship.GetSenderAddress(), sponsorship.GetPaymasterAddress())
+	store.Set(key, k.cdc.MustMarshal(&sponsorship))
+}
+
+// DeleteSponsorship removes the sponsorship granted by the paymaster to the sender.
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, sender, paymaster common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SponsorshipKey(sender, paymaster))
+}
+
+// GetSponsorships returns all the sponsorships stored.
+func (k Keeper) GetSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+	k.IterateSponsorships(ctx, types.KeyPrefixSponsorship, func(sponsorship types.Sponsorship) (stop bool) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+	return sponsorships
+}
+
+// IterateSenderSponsorships iterates over the sponsorships granted to the
+// sender, ordered by paymaster address.
+func (k Keeper) IterateSenderSponsorships(
+	ctx sdk.Context,
+	sender common.Address,
+	cb func(sponsorship types.Sponsorship) (stop bool),
+) {
+	k.IterateSponsorships(ctx, types.SponsorshipsKey(sender), cb)
+}
+
+// IterateSponsorships iterates over the sponsorships stored under the given
+// key prefix.
+func (k Keeper) IterateSponsorships(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	cb func(sponsorship types.Sponsorship) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		if cb(sponsorship) {
+			break
+		}
+	}
+}
+
+// Sponsor grants or updates the spend limit of the sponsorship of the paymaster
+// to the sender. The fees already spent on an existing sponsorship are kept.
+func (k Keeper) Sponsor(
+	ctx sdk.Context,
+	paymaster, sender common.Address,
+	spendLimit math.Int,
+) (types.Sponsorship, error) {
+	sponsorship, found := k.GetSponsorship(ctx, sender, paymaster)
+	if found {
+		sponsorship.SpendLimit = spendLimit
+	} else {
+		sponsorship = types.NewSponsorship(paymaster, sender, spendLimit)
+	}
+
+	if err := sponsorship.Validate(); err != nil {
+		return types.Sponsorship{}, errorsmod.Wrap(types.ErrInvalidSponsorship, err.Error())
+	}
+
+	k.SetSponsorship(ctx, sponsorship)
+	return sponsorship, nil
+}
+
+// Revoke removes the sponsorship of the paymaster to the sender.
+func (k Keeper) Revoke(ctx sdk.Context, paymaster, sender common.Address) error {
+	if _, found := k.GetSponsorship(ctx, sender, paymaster); !found {
+		return errorsmod.Wrapf(
+			types.ErrSponsorshipNotFound,
+			"paymaster %s, sender %s", paymaster, sender,
+		)
+	}
+
+	k.DeleteSponsorship(ctx, sender, paymaster)
+	return nil
+}
+
+// GetFeeSponsorship returns the sponsorship that pays the given fee for the
+// sender. The sponsorship of the transaction recipient is preferred, so that
+// contracts can pay for the calls they receive. Otherwise, the sponsorship of
+// the paymaster selected by the sender is used, if it covers the fee and canPay
+// returns true. Sponsorships are never iterated, so the lookup cost does not
+// depend on the number of sponsorships granted to the sender.
+func (k Keeper) GetFeeSponsorship(
+	ctx sdk.Context,
+	sender common.Address,
+	recipient *common.Address,
+	fee math.Int,
+	canPay func(paymaster common.Address) bool,
+) (types.Sponsorship, bool) {
+	if !k.IsPaymasterEnabled(ctx) {
+		return types.Sponsorship{}, false
+	}
+
+	if recipient != nil {
+		sponsorship, found := k.GetSponsorship(ctx, sender, *recipient)
+		if found && sponsorship.Covers(fee) && canPay(*recipient) {
+			return sponsorship, true
+		}
+	}
+
+	paymaster, found := k.GetSelectedPaymaster(ctx, sender)
+	if !found {
+		return types.Sponsorship{}, false
+	}
+
+	sponsorship, found := k.GetSponsorship(ctx, sender, paymaster)
+	if !found || !sponsorship.Covers(fee) || !canPay(paymaster) {
+		return types.Sponsorship{}, false
+	}
+
+	return sponsorship, true
+}
+
+// RefundSponsorship subtracts the leftover gas refunded to the paymaster from
+// the spent amount of the sponsorship. It is a no-op if the sponsorship was
+// revoked during the transaction.
+func (k Keeper) RefundSponsorship(ctx sdk.Context, sender, paymaster common.Address, amount math.Int) {
+	sponsorship, found := k.GetSponsorship(ctx, sender, paymaster)
+	if !found {
+		return
+	}
+
+	sponsorship.Spent = math.MaxInt(sponsorship.Spent.Sub(amount), math.ZeroInt())
+	k.SetSponsorship(ctx, sponsorship)
+}
+
+// ConsumeSponsorship adds the fee paid by the paymaster to the spent amount of
+// the sponsorship.
+func (k Keeper) ConsumeSponsorship(ctx sdk.Context, sponsorship types.Sponsorship, fee math.Int) error {
+	if !sponsorship.Covers(fee) {
+		return errorsmod.Wrapf(
+			types.ErrSpendLimitExceeded,
+			"fee %s exceeds the remaining spend limit %s", fee, sponsorship.Remaining(),
+		)
+	}
+
+	sponsorship.Spent = sponsorship.Spent.Add(fee)
+	k.SetSponsorship(ctx, sponsorship)
+	return nil
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+func TestSponsorAndRevoke(t *testing.T) {
+	keys := keyring.New(2)
+	paymaster, sender := keys.GetAddr(0), keys.GetAddr(1)
+
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.PaymasterKeeper
+
+	// fail - invalid spend limit
+	_, err := k.Sponsor(ctx, paymaster, sender, math.ZeroInt())
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// pass - new sponsorship
+	sponsorship, err := k.Sponsor(ctx, paymaster, sender, math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, types.NewSponsorship(paymaster, sender, math.NewInt(100)), sponsorship)
+
+	// pass - updating the spend limit keeps the spent amount
+	require.NoError(t, k.ConsumeSponsorship(ctx, sponsorship, math.NewInt(30)))
+	sponsorship, err = k.Sponsor(ctx, paymaster, sender, math.NewInt(50))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(30), sponsorship.Spent)
+	require.Equal(t, math.NewInt(20), sponsorship.Remaining())
+
+	stored, found := k.GetSponsorship(ctx, sender, paymaster)
+	require.True(t, found)
+	require.Equal(t, sponsorship, stored)
+	require.Len(t, k.GetSponsorships(ctx), 1)
+
+	// pass - revoke existing sponsorship
+	require.NoError(t, k.Revoke(ctx, paymaster, sender))
+	_, found = k.GetSponsorship(ctx, sender, paymaster)
+	require.False(t, found)
+
+	// fail - revoke non existent sponsorship
+	err = k.Revoke(ctx, paymaster, sender)
+	require.ErrorIs(t, err, types.ErrSponsorshipNotFound)
+}
+
+func TestConsumeSponsorship(t *testing.T) {
+	keys := keyring.New(2)
+	paymaster, sender := keys.GetAddr(0), keys.GetAddr(1)
+
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.PaymasterKeeper
+
+	sponsorship, err := k.Sponsor(ctx, paymaster, sender, math.NewInt(100))
+	require.NoError(t, err)
+
+	err = k.ConsumeSponsorship(ctx, sponsorship, math.NewInt(101))
+	require.ErrorIs(t, err, types.ErrSpendLimitExceeded)
+
+	err = k.ConsumeSponsorship(ctx, sponsorship, math.NewInt(100))
+	require.NoError(t, err)
+
+	stored, found := k.GetSponsorship(ctx, sender, paymaster)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), stored.Spent)
+	require.False(t, stored.Covers(math.OneInt()))
+}
+
+func TestRefundSponsorship(t *testing.T) {
+	keys := keyring.New(2)
+	paymaster, sender := keys.GetAddr(0), keys.GetAddr(1)
+
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.PaymasterKeeper
+
+	// no-op without sponsorship
+	k.RefundSponsorship(ctx, sender, paymaster, math.NewInt(10))
+	_, found := k.GetSponsorship(ctx, sender, paymaster)
+	require.False(t, found)
+
+	sponsorship, err := k.Sponsor(ctx, paymaster, sender, math.NewInt(100))
+	require.NoError(t, err)
+	require.NoError(t, k.ConsumeSponsorship(ctx, sponsorship, math.NewInt(100)))
+
+	k.RefundSponsorship(ctx, sender, paymaster, math.NewInt(40))
+	stored, found := k.GetSponsorship(ctx, sender, paymaster)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(60), stored.Spent)
+
+	// the spent amount never goes below zero
+	k.RefundSponsorship(ctx, sender, paymaster, math.NewInt(100))
+	stored, found = k.GetSponsorship(ctx, sender, paymaster)
+	require.True(t, found)
+	require.True(t, stored.Spent.IsZero())
+}
+
+func TestGetFeeSponsorship(t *testing.T) {
+	keys := keyring.New(4)
+	sender := keys.GetAddr(0)
+	paymasterA, paymasterB, recipient := keys.GetAddr(1), keys.GetAddr(2), keys.GetAddr(3)
+	fee := math.NewInt(50)
+
+	canPayAll := func(common.Address) bool { return true }
+
+	testCases := []struct {
+		name         string
+		malleate     func(ctx sdk.Context, nw *network.UnitTestNetwork)
+		recipient    *common.Address
+		canPay       func(common.Address) bool
+		expFound     bool
+		expPaymaster common.Address
+	}{
+		{
+			"not found - no sponsorships",
+			func(sdk.Context, *network.UnitTestNetwork) {},
+			nil,
+			canPayAll,
+			false,
+			common.Address{},
+		},
+		{
+			"not found - paymaster disabled",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterA))
+				err = nw.App.PaymasterKeeper.SetParams(ctx, types.NewParams(false))
+				require.NoError(t, err)
+			},
+			nil,
+			canPayAll,
+			false,
+			common.Address{},
+		},
+		{
+			"not found - spend limit does not cover the fee",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(49))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterA))
+			},
+			nil,
+			canPayAll,
+			false,
+			common.Address{},
+		},
+		{
+			"not found - sponsorship not selected by the sender",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+			},
+			nil,
+			canPayAll,
+			false,
+			common.Address{},
+		},
+		{
+			"not found - selected paymaster did not sponsor the sender",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterB))
+			},
+			nil,
+			canPayAll,
+			false,
+			common.Address{},
+		},
+		{
+			"not found - selected paymaster cannot pay",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterA))
+			},
+			nil,
+			func(common.Address) bool { return false },
+			false,
+			common.Address{},
+		},
+		{
+			"found - selected paymaster",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				_, err = nw.App.PaymasterKeeper.Sponsor(ctx, paymasterB, sender, math.NewInt(100))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterB))
+			},
+			nil,
+			canPayAll,
+			true,
+			paymasterB,
+		},
+		{
+			"found - recipient sponsorship is preferred",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				_, err = nw.App.PaymasterKeeper.Sponsor(ctx, recipient, sender, math.NewInt(100))
+				require.NoError(t, err)
+			},
+			&recipient,
+			canPayAll,
+			true,
+			recipient,
+		},
+		{
+			"found - fallback when the recipient cannot pay",
+			func(ctx sdk.Context, nw *network.UnitTestNetwork) {
+				_, err := nw.App.PaymasterKeeper.Sponsor(ctx, paymasterA, sender, math.NewInt(100))
+				require.NoError(t, err)
+				_, err = nw.App.PaymasterKeeper.Sponsor(ctx, recipient, sender, math.NewInt(10))
+				require.NoError(t, err)
+				require.NoError(t, nw.App.PaymasterKeeper.SelectPaymaster(ctx, sender, paymasterA))
+			},
+			&recipient,
+			canPayAll,
+			true,
+			paymasterA,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			tc.malleate(ctx, nw)
+
+			sponsorship, found := nw.App.PaymasterKeeper.GetFeeSponsorship(ctx, sender, tc.recipient, fee, tc.canPay)
+			require.Equal(t, tc.expFound, found)
+			if tc.expFound {
+				require.Equal(t, tc.expPaymaster, sponsorship.GetPaymasterAddress())
+			}
+		})
+	}
+}
+
+func TestSelectPaymaster(t *testing.T) {
+	keys := keyring.New(2)
+	sender, paymaster := keys.GetAddr(0), keys.GetAddr(1)
+
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.PaymasterKeeper
+
+	_, found := k.GetSelectedPaymaster(ctx, sender)
+	require.False(t, found)
+
+	// the sender cannot select itself
+	err := k.SelectPaymaster(ctx, sender, sender)
+	require.ErrorIs(t, err, types.ErrInvalidPaymasterSelection)
+
+	require.NoError(t, k.SelectPaymaster(ctx, sender, paymaster))
+	selected, found := k.GetSelectedPaymaster(ctx, sender)
+	require.True(t, found)
+	require.Equal(t, paymaster, selected)
+	require.Equal(t, []types.PaymasterSelection{types.NewPaymasterSelection(sender, paymaster)}, k.GetPaymasterSelections(ctx))
+
+	// selecting the zero address clears the selection
+	require.NoError(t, k.SelectPaymaster(ctx, sender, common.Address{}))
+	_, found = k.GetSelectedPaymaster(ctx, sender)
+	require.False(t, found)
+}
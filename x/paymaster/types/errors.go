@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrPaymasterDisabled         = errorsmod.Register(ModuleName, 2, "paymaster module is disabled")
+	ErrSponsorshipNotFound       = errorsmod.Register(ModuleName, 3, "sponsorship not found")
+	ErrSpendLimitExceeded        = errorsmod.Register(ModuleName, 4, "sponsorship spend limit exceeded")
+	ErrInvalidSponsorship        = errorsmod.Register(ModuleName, 5, "invalid sponsorship")
+	ErrInvalidPaymasterSelection = errorsmod.Register(ModuleName, 6, "invalid paymaster selection")
+)
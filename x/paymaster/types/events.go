@@ -0,0 +1,13 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// paymaster events
+const (
+	EventTypeSponsoredTx = "sponsored_tx"
+
+	AttributeKeyPaymaster = "paymaster"
+	AttributeKeySender    = "sender"
+	AttributeKeyFee       = "fee"
+)
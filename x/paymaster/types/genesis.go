@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, sponsorships []Sponsorship, selections []PaymasterSelection) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Sponsorships: sponsorships,
+		Selections:   selections,
+	}
+}
+
+// DefaultGenesisState sets default paymaster genesis state with default params
+// and no sponsorships.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		Sponsorships: []Sponsorship{},
+		Selections:   []PaymasterSelection{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+
+	for _, s := range gs.Sponsorships {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := string(SponsorshipKey(s.GetSenderAddress(), s.GetPaymasterAddress()))
+		if seen[key] {
+			return fmt.Errorf("sponsorship duplicated on genesis: paymaster %s, sender %s", s.Paymaster, s.Sender)
+		}
+		seen[key] = true
+	}
+
+	selected := make(map[string]bool)
+
+	for _, s := range gs.Selections {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		if selected[s.Sender] {
+			return fmt.Errorf("paymaster selection duplicated on genesis: sender %s", s.Sender)
+		}
+		selected[s.Sender] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+
+	return nil
+}
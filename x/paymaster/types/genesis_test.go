@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	paymaster, _ := tx.NewAddrKey()
+	sender, _ := tx.NewAddrKey()
+	sender2, _ := tx.NewAddrKey()
+	sponsorship := types.NewSponsorship(paymaster, sender, math.NewInt(100))
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			"pass - default genesis",
+			*types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"pass - empty genesis",
+			types.GenesisState{},
+			true,
+		},
+		{
+			"pass - valid genesis",
+			types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship}, nil),
+			true,
+		},
+		{
+			"pass - same paymaster for different senders",
+			types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{
+				sponsorship,
+				types.NewSponsorship(paymaster, sender2, math.NewInt(100)),
+			}, nil),
+			true,
+		},
+		{
+			"fail - duplicated sponsorship",
+			types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{
+				sponsorship,
+				types.NewSponsorship(paymaster, sender, math.NewInt(200)),
+			}, nil),
+			false,
+		},
+		{
+			"fail - invalid sponsorship",
+			types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{
+				types.NewSponsorship(paymaster, sender, math.ZeroInt()),
+			}, nil),
+			false,
+		},
+		{
+			"pass - valid paymaster selections",
+			types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship}, []types.PaymasterSelection{
+				types.NewPaymasterSelection(sender, paymaster),
+				types.NewPaymasterSelection(sender2, paymaster),
+			}),
+			true,
+		},
+		{
+			"fail - duplicated paymaster selection",
+			types.NewGenesisState(types.DefaultParams(), nil, []types.PaymasterSelection{
+				types.NewPaymasterSelection(sender, paymaster),
+				types.NewPaymasterSelection(sender, sender2),
+			}),
+			false,
+		},
+		{
+			"fail - paymaster selected by itself",
+			types.NewGenesisState(types.DefaultParams(), nil, []types.PaymasterSelection{
+				types.NewPaymasterSelection(sender, sender),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// constants
+const (
+	// module name
+	ModuleName = "paymaster"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the paymaster persistent store
+const (
+	prefixSponsorship = iota + 1
+	prefixParams
+	prefixSelectedPaymaster
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixSponsorship       = []byte{prefixSponsorship}
+	KeyPrefixParams            = []byte{prefixParams}
+	KeyPrefixSelectedPaymaster = []byte{prefixSelectedPaymaster}
+)
+
+// SponsorshipsKey returns the key prefix of all the sponsorships granted to
+// the given sender.
+func SponsorshipsKey(sender common.Address) []byte {
+	return append(KeyPrefixSponsorship, sender.Bytes()...)
+}
+
+// SponsorshipKey returns the key of the sponsorship granted by the paymaster to
+// the given sender.
+func SponsorshipKey(sender, paymaster common.Address) []byte {
+	return append(SponsorshipsKey(sender), paymaster.Bytes()...)
+}
+
+// SelectedPaymasterKey returns the key of the paymaster selected by the given
+// sender.
+func SelectedPaymasterKey(sender common.Address) []byte {
+	return append(KeyPrefixSelectedPaymaster, sender.Bytes()...)
+}
@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// NewParams creates a new Params object
+func NewParams(enablePaymaster bool) Params {
+	return Params{
+		EnablePaymaster: enablePaymaster,
+	}
+}
+
+// DefaultParams returns the default paymaster module parameters.
+func DefaultParams() Params {
+	return Params{
+		EnablePaymaster: true,
+	}
+}
+
+// Validate performs a stateless validation of the paymaster module parameters.
+func (p Params) Validate() error {
+	return nil
+}
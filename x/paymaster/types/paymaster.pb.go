@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/paymaster/v1/paymaster.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the paymaster module params
+type Params struct {
+	// enable_paymaster is the parameter to enable the payment of Ethereum transaction
+	// fees by registered paymasters.
+	EnablePaymaster bool `protobuf:"varint,1,opt,name=enable_paymaster,json=enablePaymaster,proto3" json:"enable_paymaster,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6b7e290e15dfda, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnablePaymaster() bool {
+	if m != nil {
+		return m.EnablePaymaster
+	}
+	return false
+}
+
+// Sponsorship defines the spending cap that a paymaster grants to a sender
+// to pay the fees of its Ethereum transactions.
+type Sponsorship struct {
+	// paymaster is the hex address of the account or contract that pays the fees
+	Paymaster string `protobuf:"bytes,1,opt,name=paymaster,proto3" json:"paymaster,omitempty"`
+	// sender is the hex address of the sponsored transaction sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// spend_limit is the maximum amount of fees, in the 18 decimals representation
+	// of the EVM denom, that the paymaster pays for the sender
+	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend_limit,json=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit"`
+	// spent is the amount of fees already paid by the paymaster for the sender
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6b7e290e15dfda, []int{1}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetPaymaster() string {
+	if m != nil {
+		return m.Paymaster
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// PaymasterSelection defines the paymaster that a sender opted in to pay the
+// fees of its Ethereum transactions.
+type PaymasterSelection struct {
+	// sender is the hex address of the transaction sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// paymaster is the hex address of the paymaster selected by the sender
+	Paymaster string `protobuf:"bytes,2,opt,name=paymaster,proto3" json:"paymaster,omitempty"`
+}
+
+func (m *PaymasterSelection) Reset()         { *m = PaymasterSelection{} }
+func (m *PaymasterSelection) String() string { return proto.CompactTextString(m) }
+func (*PaymasterSelection) ProtoMessage()    {}
+func (*PaymasterSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e6b7e290e15dfda, []int{2}
+}
+func (m *PaymasterSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymasterSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymasterSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymasterSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymasterSelection.Merge(m, src)
+}
+func (m *PaymasterSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymasterSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymasterSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymasterSelection proto.InternalMessageInfo
+
+func (m *PaymasterSelection) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PaymasterSelection) GetPaymaster() string {
+	if m != nil {
+		return m.Paymaster
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "evmos.paymaster.v1.Params")
+	proto.RegisterType((*Sponsorship)(nil), "evmos.paymaster.v1.Sponsorship")
+	proto.RegisterType((*PaymasterSelection)(nil), "evmos.paymaster.v1.PaymasterSelection")
+}
+
+func init() {
+	proto.RegisterFile("evmos/paymaster/v1/paymaster.proto", fileDescriptor_9e6b7e290e15dfda)
+}
+
+var fileDescriptor_9e6b7e290e15dfda = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x31, 0x4f, 0x32, 0x41,
+	0x10, 0xbd, 0xe5, 0xfb, 0x24, 0xb2, 0x14, 0xea, 0x46, 0xcd, 0x85, 0x98, 0xc3, 0x5c, 0xa5, 0xc6,
+	0xdc, 0x8a, 0x34, 0xd6, 0x98, 0x98, 0x68, 0x2c, 0x08, 0x74, 0x36, 0x64, 0x81, 0x0d, 0x6c, 0x64,
+	0x77, 0x2e, 0xb7, 0xeb, 0x45, 0xfe, 0x85, 0x3f, 0xc3, 0xd2, 0xdf, 0x60, 0x45, 0x49, 0x69, 0x2c,
+	0x88, 0x81, 0xc2, 0xbf, 0x61, 0x6e, 0x57, 0x81, 0xb3, 0xb2, 0x99, 0xcc, 0x7b, 0x33, 0x6f, 0x32,
+	0x2f, 0x0f, 0x87, 0x3c, 0x95, 0xa0, 0x69, 0xcc, 0xc6, 0x92, 0x69, 0xc3, 0x13, 0x9a, 0xd6, 0x56,
+	0x20, 0x8a, 0x13, 0x30, 0x40, 0x88, 0xdd, 0x89, 0x56, 0x74, 0x5a, 0xab, 0xec, 0x30, 0x29, 0x14,
+	0x50, 0x5b, 0xdd, 0x5a, 0x65, 0x77, 0x00, 0x03, 0xb0, 0x2d, 0xcd, 0x3a, 0xc7, 0x86, 0x75, 0x5c,
+	0x6c, 0xb2, 0x84, 0x49, 0x4d, 0x8e, 0xf1, 0x36, 0x57, 0xac, 0x3b, 0xe2, 0x9d, 0xe5, 0x25, 0x1f,
+	0x1d, 0xa2, 0xa3, 0xcd, 0xd6, 0x96, 0xe3, 0x9b, 0x3f, 0x74, 0xf8, 0x8a, 0x70, 0xb9, 0x1d, 0x83,
+	0xd2, 0x90, 0xe8, 0xa1, 0x88, 0xc9, 0x01, 0x2e, 0xe5, 0x35, 0xa5, 0xd6, 0x8a, 0x20, 0xfb, 0xb8,
+	0xa8, 0xb9, 0xea, 0xf3, 0xc4, 0x2f, 0xd8, 0xd1, 0x37, 0x22, 0x97, 0xb8, 0xac, 0x63, 0xae, 0xfa,
+	0x9d, 0x91, 0x90, 0xc2, 0xf8, 0xff, 0xb2, 0x61, 0x23, 0x9c, 0xcc, 0xaa, 0xde, 0xfb, 0xac, 0xba,
+	0xd7, 0x03, 0x2d, 0x41, 0xeb, 0xfe, 0x7d, 0x24, 0x80, 0x4a, 0x66, 0x86, 0xd1, 0xb5, 0x32, 0xcf,
+	0x9f, 0x2f, 0x27, 0xa8, 0x85, 0xad, 0xec, 0x36, 0x53, 0x91, 0x0b, 0xbc, 0x91, 0x21, 0xe3, 0xff,
+	0xff, 0xb3, 0xdc, 0x09, 0xc2, 0x1b, 0x4c, 0x96, 0x8e, 0xda, 0x7c, 0xc4, 0x7b, 0x46, 0x80, 0x5a,
+	0x7b, 0x16, 0xe5, 0x9e, 0xcd, 0x59, 0x2c, 0xfc, 0xb2, 0xd8, 0xb8, 0x9a, 0xcc, 0x03, 0x34, 0x9d,
+	0x07, 0xe8, 0x63, 0x1e, 0xa0, 0xa7, 0x45, 0xe0, 0x4d, 0x17, 0x81, 0xf7, 0xb6, 0x08, 0xbc, 0xbb,
+	0xd3, 0x81, 0x30, 0xc3, 0x87, 0x6e, 0xd4, 0x03, 0x49, 0x5d, 0x96, 0xae, 0xa6, 0xe7, 0x67, 0xf4,
+	0x71, 0x2d, 0x57, 0x33, 0x8e, 0xb9, 0xee, 0x16, 0x6d, 0x28, 0xf5, 0xaf, 0x01, 0x00, 0x18, 0x8a,
+	0x0f, 0x00, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnablePaymaster {
+		i--
+		if m.EnablePaymaster {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPaymaster(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Paymaster) > 0 {
+		i -= len(m.Paymaster)
+		copy(dAtA[i:], m.Paymaster)
+		i = encodeVarintPaymaster(dAtA, i, uint64(len(m.Paymaster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymasterSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymasterSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymasterSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paymaster) > 0 {
+		i -= len(m.Paymaster)
+		copy(dAtA[i:], m.Paymaster)
+		i = encodeVarintPaymaster(dAtA, i, uint64(len(m.Paymaster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPaymaster(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaymaster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaymaster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnablePaymaster {
+		n += 2
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Paymaster)
+	if l > 0 {
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	return n
+}
+
+func (m *PaymasterSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	l = len(m.Paymaster)
+	if l > 0 {
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	return n
+}
+
+func sovPaymaster(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaymaster(x uint64) (n int) {
+	return sovPaymaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePaymaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePaymaster = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymaster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paymaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymasterSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymasterSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymasterSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymaster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paymaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaymaster(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaymaster
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaymaster
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaymaster
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaymaster        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaymaster          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaymaster = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/types"
+)
+
+// NewPaymasterSelection returns an instance of PaymasterSelection.
+func NewPaymasterSelection(sender, paymaster common.Address) PaymasterSelection {
+	return PaymasterSelection{
+		Sender:    sender.Hex(),
+		Paymaster: paymaster.Hex(),
+	}
+}
+
+// GetSenderAddress returns the sender hex address.
+func (s PaymasterSelection) GetSenderAddress() common.Address {
+	return common.HexToAddress(s.Sender)
+}
+
+// GetPaymasterAddress returns the selected paymaster hex address.
+func (s PaymasterSelection) GetPaymasterAddress() common.Address {
+	return common.HexToAddress(s.Paymaster)
+}
+
+// Validate performs a stateless validation of the paymaster selection fields.
+func (s PaymasterSelection) Validate() error {
+	if err := types.ValidateNonZeroAddress(s.Sender); err != nil {
+		return fmt.Errorf("invalid sender address %s: %w", s.Sender, err)
+	}
+
+	if err := types.ValidateNonZeroAddress(s.Paymaster); err != nil {
+		return fmt.Errorf("invalid paymaster address %s: %w", s.Paymaster, err)
+	}
+
+	if s.GetPaymasterAddress() == s.GetSenderAddress() {
+		return fmt.Errorf("sender cannot select itself as paymaster: %s", s.Sender)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/types"
+)
+
+// NewSponsorship returns an instance of Sponsorship with no fees spent.
+func NewSponsorship(paymaster, sender common.Address, spendLimit math.Int) Sponsorship {
+	return Sponsorship{
+		Paymaster:  paymaster.Hex(),
+		Sender:     sender.Hex(),
+		SpendLimit: spendLimit,
+		Spent:      math.ZeroInt(),
+	}
+}
+
+// GetPaymasterAddress returns the paymaster hex address.
+func (s Sponsorship) GetPaymasterAddress() common.Address {
+	return common.HexToAddress(s.Paymaster)
+}
+
+// GetSenderAddress returns the sponsored sender hex address.
+func (s Sponsorship) GetSenderAddress() common.Address {
+	return common.HexToAddress(s.Sender)
+}
+
+// Remaining returns the amount of fees that the paymaster still pays for the
+// sender.
+func (s Sponsorship) Remaining() math.Int {
+	if s.Spent.GTE(s.SpendLimit) {
+		return math.ZeroInt()
+	}
+	return s.SpendLimit.Sub(s.Spent)
+}
+
+// Covers returns true if the remaining spend limit covers the given fee.
+func (s Sponsorship) Covers(fee math.Int) bool {
+	return s.Remaining().GTE(fee)
+}
+
+// Validate performs a stateless validation of the sponsorship fields.
+func (s Sponsorship) Validate() error {
+	if err := types.ValidateNonZeroAddress(s.Paymaster); err != nil {
+		return fmt.Errorf("invalid paymaster address %s: %w", s.Paymaster, err)
+	}
+
+	if err := types.ValidateNonZeroAddress(s.Sender); err != nil {
+		return fmt.Errorf("invalid sender address %s: %w", s.Sender, err)
+	}
+
+	if s.GetPaymasterAddress() == s.GetSenderAddress() {
+		return fmt.Errorf("paymaster cannot sponsor itself: %s", s.Paymaster)
+	}
+
+	if s.SpendLimit.IsNil() || !s.SpendLimit.IsPositive() {
+		return fmt.Errorf("spend limit must be positive: %s", s.SpendLimit)
+	}
+
+	if s.Spent.IsNil() || s.Spent.IsNegative() {
+		return fmt.Errorf("spent amount cannot be negative: %s", s.Spent)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/paymaster/types"
+)
+
+func TestSponsorshipValidate(t *testing.T) {
+	paymaster, _ := tx.NewAddrKey()
+	sender, _ := tx.NewAddrKey()
+
+	testCases := []struct {
+		name        string
+		sponsorship types.Sponsorship
+		expPass     bool
+	}{
+		{
+			"pass - valid sponsorship",
+			types.NewSponsorship(paymaster, sender, math.NewInt(100)),
+			true,
+		},
+		{
+			"pass - spent above spend limit",
+			types.Sponsorship{
+				Paymaster:  paymaster.Hex(),
+				Sender:     sender.Hex(),
+				SpendLimit: math.NewInt(100),
+				Spent:      math.NewInt(200),
+			},
+			true,
+		},
+		{
+			"fail - zero paymaster address",
+			types.NewSponsorship(common.Address{}, sender, math.NewInt(100)),
+			false,
+		},
+		{
+			"fail - zero sender address",
+			types.NewSponsorship(paymaster, common.Address{}, math.NewInt(100)),
+			false,
+		},
+		{
+			"fail - paymaster sponsors itself",
+			types.NewSponsorship(paymaster, paymaster, math.NewInt(100)),
+			false,
+		},
+		{
+			"fail - zero spend limit",
+			types.NewSponsorship(paymaster, sender, math.ZeroInt()),
+			false,
+		},
+		{
+			"fail - negative spent amount",
+			types.Sponsorship{
+				Paymaster:  paymaster.Hex(),
+				Sender:     sender.Hex(),
+				SpendLimit: math.NewInt(100),
+				Spent:      math.NewInt(-1),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sponsorship.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSponsorshipRemaining(t *testing.T) {
+	paymaster, _ := tx.NewAddrKey()
+	sender, _ := tx.NewAddrKey()
+
+	testCases := []struct {
+		name         string
+		spent        math.Int
+		expRemaining math.Int
+	}{
+		{"nothing spent", math.ZeroInt(), math.NewInt(100)},
+		{"partially spent", math.NewInt(40), math.NewInt(60)},
+		{"fully spent", math.NewInt(100), math.ZeroInt()},
+		{"spent above limit", math.NewInt(150), math.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sponsorship := types.NewSponsorship(paymaster, sender, math.NewInt(100))
+			sponsorship.Spent = tc.spent
+
+			require.Equal(t, tc.expRemaining, sponsorship.Remaining())
+			require.True(t, sponsorship.Covers(tc.expRemaining))
+			require.False(t, sponsorship.Covers(tc.expRemaining.AddRaw(1)))
+		})
+	}
+}
@@ -6,6 +6,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetVestingAccountsByFunderCmd(),
+		GetScheduleCmd(),
 	)
 	return cmd
 }
@@ -35,7 +38,7 @@ func GetBalancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "balances ADDRESS",
 		Short: "Gets locked, unvested and vested tokens for a vesting account",
-		Long:  "Gets locked, unvested and vested tokens for a vesting account",
+		Long:  "Gets locked, unvested and vested tokens for a vesting account at the current block time or at the unix time given with --time",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -49,6 +52,11 @@ func GetBalancesCmd() *cobra.Command {
 				Address: args[0],
 			}
 
+			if readTime, _ := cmd.Flags().GetInt64(FlagTime); readTime != 0 {
+				t := time.Unix(readTime, 0).UTC()
+				req.Time = &t
+			}
+
 			res, err := queryClient.Balances(context.Background(), req)
 			if err != nil {
 				return err
@@ -59,6 +67,76 @@ func GetBalancesCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(FlagTime, 0, "unix time at which the balances are computed (defaults to the block time)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetVestingAccountsByFunderCmd queries the vesting accounts funded by a given funder.
+func GetVestingAccountsByFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-funder FUNDER_ADDRESS",
+		Short: "Gets the clawback vesting accounts funded by a funder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVestingAccountsByFunderRequest{
+				FunderAddress: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.VestingAccountsByFunder(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting accounts")
+	return cmd
+}
+
+// GetScheduleCmd queries the unlocking and vesting timeline of a given vesting account.
+func GetScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule ADDRESS",
+		Short: "Gets the unlocking and vesting timeline of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryScheduleRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Schedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
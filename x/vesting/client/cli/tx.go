@@ -21,11 +21,14 @@ import (
 
 // Transaction command flags
 const (
-	FlagDest     = "dest"
-	FlagLockup   = "lockup"
-	FlagVesting  = "vesting"
-	FlagClawback = "clawback"
-	FlagFunder   = "funder"
+	FlagDest            = "dest"
+	FlagLockup          = "lockup"
+	FlagVesting         = "vesting"
+	FlagLockupTemplate  = "lockup-template"
+	FlagVestingTemplate = "vesting-template"
+	FlagClawback        = "clawback"
+	FlagFunder          = "funder"
+	FlagTime            = "time"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -45,6 +48,7 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgUpdateVestingScheduleCmd(),
 	)
 
 	return txCmd
@@ -99,7 +103,12 @@ Unvested coins may be "clawed back" by the funder with the clawback command.
 Coins may not be transferred out of the account if they are locked or unvested. Only vested coins may be staked.
 
 A periods file is a JSON object describing a sequence of unlocking or vesting events,
-with a start time and an array of coins strings and durations relative to the start or previous event.`,
+with a start time and an array of coins strings and durations relative to the start or previous event.
+
+Instead of a periods file, a schedule template file (--lockup-template, --vesting-template) can be given,
+describing coins released linearly over a duration after an optional cliff, either in monthly or quarterly
+tranches (months of 2629800 seconds) or continuously every second. Continuous templates are only supported
+for vesting. All the given files must have the same start time if a template is used.`,
 		Example: `Sample period file contents:
 {
   "start_time": 1625204910,
@@ -113,12 +122,22 @@ with a start time and an array of coins strings and durations relative to the st
       "length_seconds": 2592000 //30 days
     }
   ]
+}
+
+Sample template file contents:
+{
+  "start_time": 1625204910,
+  "interval": "monthly",
+  "cliff_seconds": 31557600, //12 months
+  "duration_seconds": 126230400, //48 months
+  "coins": "4800test"
 }`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var (
-				lockupStart, vestingStart     int64
-				lockupPeriods, vestingPeriods sdkvesting.Periods
+				lockupStart, vestingStart       int64
+				lockupPeriods, vestingPeriods   sdkvesting.Periods
+				lockupTemplate, vestingTemplate *types.ScheduleTemplate
 			)
 
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -133,8 +152,31 @@ with a start time and an array of coins strings and durations relative to the st
 
 			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
 			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
-			if lockupFile == "" && vestingFile == "" {
-				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			lockupTemplateFile, _ := cmd.Flags().GetString(FlagLockupTemplate)
+			vestingTemplateFile, _ := cmd.Flags().GetString(FlagVestingTemplate)
+			if lockupFile == "" && vestingFile == "" && lockupTemplateFile == "" && vestingTemplateFile == "" {
+				return fmt.Errorf(
+					"must specify at least one of %s, %s, %s or %s",
+					FlagLockup, FlagVesting, FlagLockupTemplate, FlagVestingTemplate,
+				)
+			}
+			if lockupFile != "" && lockupTemplateFile != "" {
+				return fmt.Errorf("cannot specify both %s and %s", FlagLockup, FlagLockupTemplate)
+			}
+			if vestingFile != "" && vestingTemplateFile != "" {
+				return fmt.Errorf("cannot specify both %s and %s", FlagVesting, FlagVestingTemplate)
+			}
+			if lockupTemplateFile != "" {
+				lockupStart, lockupTemplate, err = ReadTemplateFile(lockupTemplateFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingTemplateFile != "" {
+				vestingStart, vestingTemplate, err = ReadTemplateFile(vestingTemplateFile)
+				if err != nil {
+					return err
+				}
 			}
 			if lockupFile != "" {
 				lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
@@ -151,7 +193,23 @@ with a start time and an array of coins strings and durations relative to the st
 
 			commonStart, _ := types.AlignSchedules(lockupStart, vestingStart, lockupPeriods, vestingPeriods)
 
+			// templates are relative to the start time of the message, so they
+			// cannot be aligned to the start time of the other schedule
+			hasLockup := lockupFile != "" || lockupTemplateFile != ""
+			hasVesting := vestingFile != "" || vestingTemplateFile != ""
+			switch {
+			case lockupTemplate == nil && vestingTemplate == nil:
+			case hasLockup && hasVesting && lockupStart != vestingStart:
+				return fmt.Errorf("lockup and vesting schedules must have the same start time when using a template")
+			case hasLockup:
+				commonStart = lockupStart
+			default:
+				commonStart = vestingStart
+			}
+
 			msg := types.NewMsgFundVestingAccount(clientCtx.GetFromAddress(), toAddr, time.Unix(commonStart, 0), lockupPeriods, vestingPeriods)
+			msg.LockupTemplate = lockupTemplate
+			msg.VestingTemplate = vestingTemplate
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -159,6 +217,8 @@ with a start time and an array of coins strings and durations relative to the st
 
 	cmd.Flags().String(FlagLockup, "", "path to file containing unlocking periods")
 	cmd.Flags().String(FlagVesting, "", "path to file containing vesting periods")
+	cmd.Flags().String(FlagLockupTemplate, "", "path to file containing a lockup schedule template")
+	cmd.Flags().String(FlagVestingTemplate, "", "path to file containing a vesting schedule template")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -238,6 +298,62 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 	return cmd
 }
 
+// NewMsgUpdateVestingScheduleCmd returns a CLI command handler for updating
+// the lockup and vesting schedules of a ClawbackVestingAccount.
+func NewMsgUpdateVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vesting-schedule VESTING_ACCOUNT_ADDRESS",
+		Short: "Update the lockup and vesting schedules of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both, in the format
+of the fund-vesting-account command. The periods replace the ones of the account and are relative to the
+start time of the account, so the start time of the files is ignored.
+The vested coins of the account cannot decrease and the coins unlocked so far cannot be locked again.
+The coins added to the schedules are transferred from the --from address to the vesting account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var lockupPeriods, vestingPeriods sdkvesting.Periods
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			}
+			if lockupFile != "" {
+				_, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				_, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateVestingSchedule(clientCtx.GetFromAddress(), vestingAcc, lockupPeriods, vestingPeriods)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing the new unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing the new vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewMsgConvertVestingAccountCmd returns a CLI command handler for converting
 // a clawback vesting account into a non-vesting account.
 func NewMsgConvertVestingAccountCmd() *cobra.Command {
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/evmos/v20/x/vesting/types"
 )
 
 type VestingData struct {
@@ -56,3 +58,44 @@ func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
 
 	return startTime, periods, nil
 }
+
+type TemplateData struct {
+	StartTime int64  `json:"start_time"`
+	Interval  string `json:"interval"`
+	Cliff     int64  `json:"cliff_seconds"`
+	Duration  int64  `json:"duration_seconds"`
+	Coins     string `json:"coins"`
+}
+
+// ReadTemplateFile reads the file at path and unmarshals it to get the
+// schedule template. The interval is one of continuous, monthly or quarterly.
+// Returns start time, template, and error.
+func ReadTemplateFile(path string) (int64, *types.ScheduleTemplate, error) {
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data TemplateData
+
+	if err = json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	interval, ok := types.ScheduleInterval_value["SCHEDULE_INTERVAL_"+strings.ToUpper(data.Interval)]
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid schedule interval %q, expected continuous, monthly or quarterly", data.Interval)
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(data.Coins)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	template := types.NewScheduleTemplate(types.ScheduleInterval(interval), data.Cliff, data.Duration, amount)
+	if err := template.Validate(); err != nil {
+		return 0, nil, err
+	}
+
+	return data.StartTime, template, nil
+}
@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/vesting/types"
+)
+
+// SetFunderVestingAccount indexes the given clawback vesting account under its
+// funder.
+func (k Keeper) SetFunderVestingAccount(ctx sdk.Context, funder, vestingAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetFunderVestingAccountKey(funder, vestingAddr), []byte{})
+}
+
+// DeleteFunderVestingAccount removes the given clawback vesting account from
+// the index of its funder.
+func (k Keeper) DeleteFunderVestingAccount(ctx sdk.Context, funder, vestingAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetFunderVestingAccountKey(funder, vestingAddr))
+}
+
+// HasFunderVestingAccount checks if the given clawback vesting account is
+// indexed under the given funder.
+func (k Keeper) HasFunderVestingAccount(ctx sdk.Context, funder, vestingAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetFunderVestingAccountKey(funder, vestingAddr))
+}
+
+// IterateFunderVestingAccounts iterates over the clawback vesting accounts
+// indexed under the given funder and performs a callback function.
+func (k Keeper) IterateFunderVestingAccounts(
+	ctx sdk.Context,
+	funder sdk.AccAddress,
+	cb func(vestingAddr sdk.AccAddress) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFunderVestingAccountsPrefix(funder))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// deleteFunderVestingAccountOf removes the given clawback vesting account from
+// the index of its funder, if the funder address is valid.
+func (k Keeper) deleteFunderVestingAccountOf(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	funder, err := sdk.AccAddressFromBech32(va.FunderAddress)
+	if err != nil {
+		return
+	}
+	k.DeleteFunderVestingAccount(ctx, funder, va.GetAddress())
+}
+
+// IndexFunderVestingAccounts indexes all clawback vesting accounts stored in
+// x/auth under their funders. Accounts with an invalid funder address are
+// skipped.
+func (k Keeper) IndexFunderVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		va, ok := account.(*types.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+
+		funder, err := sdk.AccAddressFromBech32(va.FunderAddress)
+		if err != nil {
+			k.Logger(ctx).Error("skipping vesting account with invalid funder", "address", va.Address, "funder", va.FunderAddress)
+			return false
+		}
+
+		k.SetFunderVestingAccount(ctx, funder, va.GetAddress())
+		return false
+	})
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	testutiltx "github.com/evmos/evmos/v20/testutil/tx"
+	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunderIndexGenesisExportImport(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	vestingAddr, _ := testutiltx.NewAccAddressAndKey()
+	funder, _ := testutiltx.NewAccAddressAndKey()
+
+	// set an indexed clawback vesting account as MsgCreateClawbackVestingAccount does
+	baseAccount := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	baseAccount.AccountNumber = nw.App.AccountKeeper.NextAccountNumber(ctx)
+	vestingAcc := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, balances, time.Now(), lockupPeriods, vestingPeriods)
+	nw.App.AccountKeeper.SetAccount(ctx, vestingAcc)
+	nw.App.VestingKeeper.SetFunderVestingAccount(ctx, funder, vestingAddr)
+
+	// export the vesting account from x/auth
+	authGen := nw.App.AccountKeeper.ExportGenesis(ctx)
+	genAccs, err := authtypes.UnpackAccounts(authGen.Accounts)
+	require.NoError(t, err)
+
+	var exported authtypes.GenesisAccounts
+	for _, acc := range genAccs {
+		if acc.GetAddress().Equals(vestingAddr) {
+			exported = append(exported, acc)
+		}
+	}
+	require.Len(t, exported, 1, "vesting account not exported")
+
+	packed, err := authtypes.PackAccounts(exported)
+	require.NoError(t, err)
+
+	// import it into a new chain
+	imported := network.NewUnitTestNetwork(
+		network.WithCustomGenesis(network.CustomGenesisState{
+			authtypes.ModuleName: &authtypes.GenesisState{Accounts: packed},
+		}),
+	)
+	importedCtx := imported.GetContext()
+
+	require.NotNil(t, imported.App.AccountKeeper.GetAccount(importedCtx, vestingAddr), "vesting account not imported")
+	require.True(t, imported.App.VestingKeeper.HasFunderVestingAccount(importedCtx, funder, vestingAddr), "vesting account not indexed by funder")
+}
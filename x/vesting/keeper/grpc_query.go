@@ -5,8 +5,11 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -16,7 +19,7 @@ import (
 var _ types.QueryServer = Keeper{}
 
 // Balances returns the locked, unvested and vested amount of tokens for a
-// clawback vesting account
+// clawback vesting account at the requested time or at the block time
 func (k Keeper) Balances(
 	goCtx context.Context,
 	req *types.QueryBalancesRequest,
@@ -39,9 +42,14 @@ func (k Keeper) Balances(
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	locked := clawbackAccount.GetLockedUpCoins(ctx.BlockTime())
-	unvested := clawbackAccount.GetVestingCoins(ctx.BlockTime())
-	vested := clawbackAccount.GetVestedCoins(ctx.BlockTime())
+	readTime := ctx.BlockTime()
+	if req.Time != nil {
+		readTime = *req.Time
+	}
+
+	locked := clawbackAccount.GetLockedUpCoins(readTime)
+	unvested := clawbackAccount.GetVestingCoins(readTime)
+	vested := clawbackAccount.GetVestedCoins(readTime)
 
 	return &types.QueryBalancesResponse{
 		Locked:   locked,
@@ -49,3 +57,67 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// VestingAccountsByFunder returns the addresses of the clawback vesting
+// accounts funded by the given funder
+func (k Keeper) VestingAccountsByFunder(
+	goCtx context.Context,
+	req *types.QueryVestingAccountsByFunderRequest,
+) (*types.QueryVestingAccountsByFunderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	funder, err := sdk.AccAddressFromBech32(req.FunderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFunderVestingAccountsPrefix(funder))
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingAccountsByFunderResponse{
+		VestingAddresses: addresses,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// Schedule returns the unlocking and vesting timeline of a clawback vesting
+// account, merged across its lockup and vesting periods and vesting streams
+func (k Keeper) Schedule(
+	goCtx context.Context,
+	req *types.QueryScheduleRequest,
+) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clawbackAccount, err := k.GetClawbackVestingAccount(goCtx, addr)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' either does not exist or is not a vesting account ", addr.String(),
+		)
+	}
+
+	return &types.QueryScheduleResponse{
+		StartTime:      time.Unix(clawbackAccount.GetStartTime(), 0).UTC(),
+		EndTime:        time.Unix(clawbackAccount.EndTime, 0).UTC(),
+		Events:         clawbackAccount.ScheduleEvents(),
+		VestingStreams: clawbackAccount.VestingStreams,
+	}, nil
+}
@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/evmos/evmos/v20/testutil"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/vesting/types"
 )
 
@@ -118,6 +121,50 @@ func TestBalances(t *testing.T) {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid - continuous vesting template at the requested time",
+			malleate: func() {
+				vestingStart := ctx.BlockTime()
+
+				// fund the vesting account with coins to initialize it and
+				// then send all balances to the funding account
+				err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+				require.NoError(t, err, "error while funding the target account")
+				err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+				require.NoError(t, err, "error while sending coins to the funder account")
+
+				msg := types.NewMsgCreateClawbackVestingAccount(
+					funder,
+					vestingAddr,
+					false,
+				)
+				_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+				require.NoError(t, err, "error while creating the vesting account")
+
+				msgFund := types.NewMsgFundVestingAccount(
+					funder,
+					vestingAddr,
+					vestingStart,
+					lockupPeriods,
+					nil,
+				)
+				msgFund.VestingTemplate = types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_CONTINUOUS, 100, 1000, balances)
+				_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, msgFund)
+				require.NoError(t, err, "error while funding the vesting account")
+
+				readTime := vestingStart.Add(250 * time.Second)
+				req = &types.QueryBalancesRequest{
+					Address: vestingAddr.String(),
+					Time:    &readTime,
+				}
+				expRes = &types.QueryBalancesResponse{
+					Locked:   balances,
+					Unvested: balances.Sub(quarter...),
+					Vested:   quarter,
+				}
+			},
+			expPass: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -140,3 +187,86 @@ func TestBalances(t *testing.T) {
 		})
 	}
 }
+
+func TestVestingAccountsByFunder(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	qc := nw.GetVestingClient()
+
+	// create three clawback vesting accounts for the funder and one for another funder
+	vestingAddrs := []sdk.AccAddress{addr3, addr4, vestingAddr}
+	for _, addr := range vestingAddrs {
+		err := testutil.FundAccount(ctx, nw.App.BankKeeper, addr, balances)
+		require.NoError(t, err, "error while funding the target account")
+		_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, false))
+		require.NoError(t, err, "error while creating the vesting account")
+	}
+	otherAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, otherAddr, balances)
+	require.NoError(t, err)
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(addr3, otherAddr, false))
+	require.NoError(t, err)
+
+	_, err = qc.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{FunderAddress: "evmos1"})
+	require.ErrorContains(t, err, "decoding bech32 failed")
+
+	res, err := qc.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{FunderAddress: funder.String()})
+	require.NoError(t, err)
+	expAddresses := make([]string, len(vestingAddrs))
+	for i, addr := range vestingAddrs {
+		expAddresses[i] = addr.String()
+	}
+	require.ElementsMatch(t, expAddresses, res.VestingAddresses)
+
+	// paginate over the accounts of the funder
+	res, err = qc.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{
+		FunderAddress: funder.String(),
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.VestingAddresses, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	nextRes, err := qc.VestingAccountsByFunder(ctx, &types.QueryVestingAccountsByFunderRequest{
+		FunderAddress: funder.String(),
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expAddresses, append(res.VestingAddresses, nextRes.VestingAddresses...))
+}
+
+func TestSchedule(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	vestingStart := ctx.BlockTime()
+
+	_, err := nw.GetVestingClient().Schedule(ctx, &types.QueryScheduleRequest{Address: vestingAddr.String()})
+	require.ErrorContains(t, err, "either does not exist or is not a vesting account")
+
+	err = testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err, "error while funding the target account")
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err, "error while sending coins to the funder account")
+
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false))
+	require.NoError(t, err, "error while creating the vesting account")
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, types.NewMsgFundVestingAccount(funder, vestingAddr, vestingStart, lockupPeriods, vestingPeriods))
+	require.NoError(t, err, "error while funding the vesting account")
+
+	res, err := nw.GetVestingClient().Schedule(ctx, &types.QueryScheduleRequest{Address: vestingAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, vestingStart.Unix(), res.StartTime.Unix())
+	require.Equal(t, vestingStart.Unix()+8000, res.EndTime.Unix())
+	require.Empty(t, res.VestingStreams)
+
+	// the lockup at 5000 is merged between the vesting periods at 4000 and 6000
+	expOffsets := []int64{2000, 4000, 5000, 6000, 8000}
+	require.Len(t, res.Events, len(expOffsets))
+	for i, event := range res.Events {
+		require.Equal(t, vestingStart.Unix()+expOffsets[i], event.Time.Unix())
+	}
+	require.Equal(t, balances, res.Events[2].Unlocked)
+	require.True(t, res.Events[2].Vested.IsZero())
+	require.Equal(t, quarter.Add(quarter...), res.Events[2].TotalVested)
+	require.Equal(t, balances, res.Events[4].TotalVested)
+}
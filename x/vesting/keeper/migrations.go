@@ -7,11 +7,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	v2 "github.com/evmos/evmos/v20/x/vesting/migrations/v2"
 	v3 "github.com/evmos/evmos/v20/x/vesting/migrations/v3"
+	v4 "github.com/evmos/evmos/v20/x/vesting/migrations/v4"
 )
 
 var (
 	_ module.MigrationHandler = Migrator{}.Migrate1to2
 	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -35,3 +37,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.accountKeeper)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.accountKeeper)
+}
@@ -139,3 +139,26 @@ func TestMigrate2to3(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrate3to4(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	// Create account addresses for testing
+	vestingAddr, _ := testutiltx.NewAccAddressAndKey()
+	funder, _ := testutiltx.NewAccAddressAndKey()
+
+	// set a clawback vesting account without indexing it by funder
+	baseAccount := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	baseAccount.AccountNumber = nw.App.AccountKeeper.NextAccountNumber(ctx)
+	vestingAcc := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, balances, time.Now(), lockupPeriods, vestingPeriods)
+	nw.App.AccountKeeper.SetAccount(ctx, vestingAcc)
+	require.False(t, nw.App.VestingKeeper.HasFunderVestingAccount(ctx, funder, vestingAddr))
+
+	// migrate
+	migrator := keeper.NewMigrator(nw.App.VestingKeeper)
+	err := migrator.Migrate3to4(ctx)
+	require.NoError(t, err, "migration failed")
+
+	require.True(t, nw.App.VestingKeeper.HasFunderVestingAccount(ctx, funder, vestingAddr), "vesting account not indexed by funder")
+}
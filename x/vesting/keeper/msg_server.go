@@ -79,6 +79,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		FunderAddress:      funderAddress.String(),
 	}
 	ak.SetAccount(ctx, vestingAcc)
+	k.SetFunderVestingAccount(ctx, funderAddress, vestingAddress)
 
 	if !msg.EnableGovClawback {
 		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
@@ -111,6 +112,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 //   - both vesting and lockup periods are non-empty
 //   - both lockup and vesting periods contain valid amounts and lengths
 //   - both vesting and lockup periods describe the same total amount
+//   - schedule templates are valid and not combined with periods
 func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVestingAccount) (*types.MsgFundVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	ak := k.accountKeeper
@@ -132,7 +134,21 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 		return nil, err
 	}
 
-	vestingCoins := msg.VestingPeriods.TotalAmount()
+	// Expand the schedule templates into periods. A continuous vesting
+	// template is evaluated as a vesting stream instead.
+	var vestingStreams []types.VestingStream
+	if msg.LockupTemplate != nil {
+		msg.LockupPeriods = msg.LockupTemplate.Periods()
+	}
+	if msg.VestingTemplate != nil {
+		if msg.VestingTemplate.IsContinuous() {
+			vestingStreams = append(vestingStreams, msg.VestingTemplate.Stream(msg.GetStartTime().Unix()))
+		} else {
+			msg.VestingPeriods = msg.VestingTemplate.Periods()
+		}
+	}
+
+	vestingCoins := msg.VestingPeriods.TotalAmount().Add(types.StreamsTotalAmount(vestingStreams)...)
 	lockupCoins := msg.LockupPeriods.TotalAmount()
 
 	// If lockup absent, default to an instant unlock schedule
@@ -144,7 +160,7 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 	}
 
 	// If vesting absent, default to an instant vesting schedule
-	if !lockupCoins.IsZero() && len(msg.VestingPeriods) == 0 {
+	if !lockupCoins.IsZero() && len(msg.VestingPeriods) == 0 && len(vestingStreams) == 0 {
 		msg.VestingPeriods = sdkvesting.Periods{
 			{Length: 0, Amount: lockupCoins},
 		}
@@ -155,7 +171,7 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
 	}
 
-	err = vestingAcc.AddGrant(msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
+	err = vestingAcc.AddGrant(msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingStreams, vestingCoins)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +251,7 @@ func (k Keeper) Clawback(
 	}
 
 	// Check if account has any vesting or lockup periods
-	if len(va.VestingPeriods) == 0 && len(va.LockupPeriods) == 0 {
+	if !va.HasSchedules() {
 		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has no vesting or lockup periods", msg.AccountAddress)
 	}
 
@@ -325,8 +341,10 @@ func (k Keeper) UpdateVestingFunder(
 	}
 
 	// Perform clawback account update
+	k.deleteFunderVestingAccountOf(ctx, va)
 	va.FunderAddress = msg.NewFunderAddress
 	ak.SetAccount(ctx, va)
+	k.SetFunderVestingAccount(ctx, newFunder, vestingAccAddr)
 
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
@@ -347,6 +365,83 @@ func (k Keeper) UpdateVestingFunder(
 	return &types.MsgUpdateVestingFunderResponse{}, nil
 }
 
+// UpdateVestingSchedule updates the lockup and vesting schedules of a
+// ClawbackVestingAccount. This can only be executed by the funder of the
+// vesting account, who sends the coins added to the schedules.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - vesting and/or lockup periods are non-empty
+//   - both lockup and vesting periods contain valid amounts and lengths
+func (k Keeper) UpdateVestingSchedule(
+	goCtx context.Context,
+	msg *types.MsgUpdateVestingSchedule,
+) (*types.MsgUpdateVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// NOTE: errors checked during msg validation
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	// Check if there is an active clawback proposal for the given account
+	if k.HasActiveClawbackProposal(ctx, vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"cannot update schedule while there is an active clawback proposal for account %s",
+			msg.VestingAddress,
+		)
+	}
+
+	// Check if vesting account exists
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if current funder is same as in msg
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "schedule can only be updated by the funder: %s", va.FunderAddress)
+	}
+
+	if !va.HasSchedules() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has no vesting or lockup periods", msg.VestingAddress)
+	}
+
+	updatedAcc, topUp, err := va.UpdateSchedule(msg.LockupPeriods, msg.VestingPeriods, ctx.BlockTime().Unix())
+	if err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, &updatedAcc)
+
+	// Send the coins added to the schedules from the funder to vesting account
+	if !topUp.IsZero() {
+		if err = bk.SendCoins(ctx, funderAddr, vestingAddr, topUp); err != nil {
+			return nil, err
+		}
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "update_vesting_schedule", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateVestingSchedule,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, topUp.String()),
+			),
+		},
+	)
+
+	return &types.MsgUpdateVestingScheduleResponse{
+		TopUp: topUp,
+	}, nil
+}
+
 // ConvertVestingAccount converts a ClawbackVestingAccount to the default chain account
 // after its lockup and vesting periods have concluded.
 func (k Keeper) ConvertVestingAccount(
@@ -374,6 +469,7 @@ func (k Keeper) ConvertVestingAccount(
 	// if gov clawback is disabled, remove the entry from the store.
 	// if no entry is found for the address, this will no-op
 	k.DeleteGovClawbackDisabled(ctx, address)
+	k.deleteFunderVestingAccountOf(ctx, vestingAcc)
 
 	baseAcc := vestingAcc.BaseAccount
 	k.accountKeeper.SetAccount(ctx, baseAcc)
@@ -383,7 +479,8 @@ func (k Keeper) ConvertVestingAccount(
 
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // the destination address. Then, it updates the lockup schedule, removes future
-// vesting events and deletes the store entry for governance clawback if it exists.
+// vesting events and deletes the store entries for governance clawback and the funder
+// index.
 func (k Keeper) transferClawback(
 	ctx sdk.Context,
 	vestingAccount types.ClawbackVestingAccount,
@@ -407,6 +504,7 @@ func (k Keeper) transferClawback(
 	// if gov clawback is disabled, remove the entry from the store.
 	// if no entry is found for the address, this will no-op
 	k.DeleteGovClawbackDisabled(ctx, address)
+	k.deleteFunderVestingAccountOf(ctx, &vestingAccount)
 
 	// In case destination is community pool (e.g. Gov Clawback)
 	// call the corresponding function
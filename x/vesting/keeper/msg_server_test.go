@@ -240,6 +240,70 @@ func TestMsgFundVestingAccount(t *testing.T) {
 	}
 }
 
+func TestMsgFundVestingAccountTemplates(t *testing.T) {
+	testCases := []struct {
+		name            string
+		vestingTemplate *types.ScheduleTemplate
+		expPeriods      int
+		expStreams      int
+		expClawback     sdk.Coins
+	}{
+		{
+			name:            "pass - monthly template with cliff",
+			vestingTemplate: types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_MONTHLY, types.SecondsPerMonth, 4*types.SecondsPerMonth, balances),
+			expPeriods:      4,
+			expStreams:      0,
+			// the cliff and the first month have passed
+			expClawback: balances.Sub(quarter...).Sub(quarter...),
+		},
+		{
+			name:            "pass - continuous template",
+			vestingTemplate: types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_CONTINUOUS, 0, 4*types.SecondsPerMonth, balances),
+			expPeriods:      0,
+			expStreams:      1,
+			expClawback:     balances.Sub(quarter...).Sub(quarter...),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			startTime := ctx.BlockTime()
+
+			err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+			require.NoError(t, err, "failed to fund target account")
+			err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+			require.NoError(t, err, "failed to send tokens to funder account")
+
+			msgCreate := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+			_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, msgCreate)
+			require.NoError(t, err)
+
+			msg := types.NewMsgFundVestingAccount(funder, vestingAddr, startTime, nil, nil)
+			msg.VestingTemplate = tc.vestingTemplate
+			require.NoError(t, msg.ValidateBasic())
+			_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, msg)
+			require.NoError(t, err)
+
+			vestAcc, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+			require.NoError(t, err)
+			require.NoError(t, vestAcc.Validate())
+			require.Equal(t, balances, vestAcc.OriginalVesting)
+			require.Len(t, vestAcc.LockupPeriods, 1)
+			require.Len(t, vestAcc.VestingPeriods, tc.expPeriods)
+			require.Len(t, vestAcc.VestingStreams, tc.expStreams)
+			require.Equal(t, startTime.Unix()+tc.vestingTemplate.Duration, vestAcc.EndTime)
+
+			// claw back after two months
+			ctx = ctx.WithBlockTime(startTime.Add(time.Duration(2*types.SecondsPerMonth) * time.Second))
+			res, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawback, res.Coins)
+		})
+	}
+}
+
 // NOTE: This function tests cases which require a different setup than the standard
 // cases in TestMsgFundVestingAccount.
 func TestMsgFundVestingAccountSpecialCases(t *testing.T) {
@@ -610,6 +674,7 @@ func TestMsgClawback(t *testing.T) {
 				require.Equal(t, expRes, res, "expected full balances to be clawed back")
 				require.Equal(t, sdk.NewInt64Coin(baseDenom, 0), balanceVestingAcc)
 				require.Equal(t, balances[0], balanceClaw)
+				require.False(t, nw.App.VestingKeeper.HasFunderVestingAccount(ctx, funder, vestingAddr), "expected the account to be removed from the funder index")
 			} else {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.errContains)
@@ -731,6 +796,8 @@ func TestMsgUpdateVestingFunder(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, expRes, res)
 				require.Equal(t, va.FunderAddress, tc.newFunder.String())
+				require.False(t, nw.App.VestingKeeper.HasFunderVestingAccount(ctx, tc.funder, tc.vestingAcc))
+				require.True(t, nw.App.VestingKeeper.HasFunderVestingAccount(ctx, tc.newFunder, tc.vestingAcc))
 			} else {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.errContains)
@@ -740,6 +807,112 @@ func TestMsgUpdateVestingFunder(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateVestingSchedule(t *testing.T) {
+	half := quarter.Add(quarter...)
+	topUpBalances := balances.Add(quarter...)
+
+	testCases := []struct {
+		name    string
+		funder  sdk.AccAddress
+		lockup  sdkvesting.Periods
+		vesting sdkvesting.Periods
+		// fund determines if the clawback vesting account should be funded for the test case
+		fund        bool
+		expPass     bool
+		expTopUp    sdk.Coins
+		errContains string
+	}{
+		{
+			name:        "fail - wrong funder",
+			funder:      addr3,
+			vesting:     sdkvesting.Periods{{Length: 2000, Amount: half}, {Length: 2000, Amount: half}},
+			fund:        true,
+			expPass:     false,
+			errContains: "schedule can only be updated by the funder",
+		},
+		{
+			name:        "fail - account without schedules",
+			funder:      funder,
+			vesting:     sdkvesting.Periods{{Length: 2000, Amount: half}, {Length: 2000, Amount: half}},
+			fund:        false,
+			expPass:     false,
+			errContains: "has no vesting or lockup periods",
+		},
+		{
+			name:        "fail - vested coins decrease",
+			funder:      funder,
+			vesting:     sdkvesting.Periods{{Length: 10000, Amount: balances}},
+			fund:        true,
+			expPass:     false,
+			errContains: types.ErrInsufficientVestedCoins.Error(),
+		},
+		{
+			name:     "pass - accelerate the vesting",
+			funder:   funder,
+			vesting:  sdkvesting.Periods{{Length: 2000, Amount: half}, {Length: 2000, Amount: half}},
+			fund:     true,
+			expPass:  true,
+			expTopUp: sdk.Coins{},
+		},
+		{
+			name:     "pass - top up the account",
+			funder:   funder,
+			lockup:   sdkvesting.Periods{{Length: 5000, Amount: topUpBalances}},
+			vesting:  append(append(sdkvesting.Periods{}, vestingPeriods...), sdkvesting.Period{Length: 2000, Amount: quarter}),
+			fund:     true,
+			expPass:  true,
+			expTopUp: quarter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			startTime := ctx.BlockTime()
+
+			// fund the account at the vesting address to initialize it and then send all funds to the funder account
+			err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, topUpBalances)
+			require.NoError(t, err)
+			err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, topUpBalances)
+			require.NoError(t, err)
+
+			createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+			_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+			require.NoError(t, err)
+
+			if tc.fund {
+				fundMsg := types.NewMsgFundVestingAccount(funder, vestingAddr, startTime, lockupPeriods, vestingPeriods)
+				_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+				require.NoError(t, err)
+			}
+
+			// update the schedule after the first vesting period
+			ctx = ctx.WithBlockTime(startTime.Add(3000 * time.Second))
+			msg := types.NewMsgUpdateVestingSchedule(tc.funder, vestingAddr, tc.lockup, tc.vesting)
+			require.NoError(t, msg.ValidateBasic())
+			res, err := nw.App.VestingKeeper.UpdateVestingSchedule(ctx, msg)
+
+			if !tc.expPass {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.errContains)
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expTopUp, res.TopUp)
+
+			va, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+			require.NoError(t, err)
+			require.NoError(t, va.Validate())
+			require.Equal(t, balances.Add(tc.expTopUp...), va.OriginalVesting)
+			require.Equal(t, topUpBalances.Sub(balances...).Sub(tc.expTopUp...), nw.App.BankKeeper.GetAllBalances(ctx, funder))
+			require.Equal(t, balances.Add(tc.expTopUp...), nw.App.BankKeeper.GetAllBalances(ctx, vestingAddr))
+		})
+	}
+}
+
 func TestClawbackVestingAccountStore(t *testing.T) {
 	nw := network.NewUnitTestNetwork()
 	ctx := nw.GetContext()
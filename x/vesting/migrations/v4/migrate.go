@@ -0,0 +1,38 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
+)
+
+// MigrateStore migrates the x/vesting module state from the consensus version 3 to
+// version 4.
+// Specifically, it indexes the existing clawback vesting accounts by funder
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	ak vestingtypes.AccountKeeper,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	ak.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		vestAcc, ok := account.(*vestingtypes.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+
+		funder, err := sdk.AccAddressFromBech32(vestAcc.FunderAddress)
+		if err != nil {
+			ctx.Logger().Error("skipping vesting account with invalid funder", "address", vestAcc.Address, "funder", vestAcc.FunderAddress)
+			return false
+		}
+
+		store.Set(vestingtypes.GetFunderVestingAccountKey(funder, vestAcc.GetAddress()), []byte{})
+		return false
+	})
+
+	return nil
+}
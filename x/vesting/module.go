@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -27,7 +28,7 @@ import (
 )
 
 // consensusVersion defines the current x/vesting module consensus version.
-const consensusVersion = 3
+const consensusVersion = 4
 
 var (
 	_ module.AppModule      = AppModule{}
@@ -132,9 +133,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
 		panic(fmt.Errorf("failed to migrate %s to v3: %w", types.ModuleName, err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Errorf("failed to migrate %s to v4: %w", types.ModuleName, err))
+	}
+}
+
+// InitGenesis rebuilds the index of clawback vesting accounts by funder from
+// the accounts initialized by x/auth. The module has no genesis state of its
+// own. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	am.keeper.IndexFunderVestingAccounts(ctx)
+	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis is always empty, as InitGenesis does nothing either.
+// ExportGenesis is always empty, as the funder index is rebuilt by InitGenesis.
 func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	return am.DefaultGenesis(cdc)
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	"errors"
+	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -165,8 +166,20 @@ func (va ClawbackVestingAccount) Validate() error {
 		return errors.New("vesting schedule extends beyond account end time")
 	}
 
+	for _, s := range va.VestingStreams {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		if s.EndTime() > va.EndTime {
+			return errors.New("vesting stream extends beyond account end time")
+		}
+
+		vestingCoins = vestingCoins.Add(s.Amount...)
+	}
+
 	if !CoinEq(vestingCoins, va.OriginalVesting) {
-		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods and streams")
 	}
 
 	return va.BaseVestingAccount.Validate()
@@ -186,9 +199,22 @@ func (va ClawbackVestingAccount) GetLockedUpCoins(blockTime time.Time) sdk.Coins
 	return va.OriginalVesting.Sub(va.GetUnlockedCoins(blockTime)...)
 }
 
-// GetVestedCoins returns the vested coins at blockTime.
+// GetVestedCoins returns the vested coins at blockTime, which are the coins
+// of the passed vesting periods and the coins streamed by the vesting streams.
 func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
-	return ReadSchedule(va.GetStartTime(), va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+	if len(va.VestingStreams) == 0 {
+		return ReadSchedule(va.GetStartTime(), va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+	}
+
+	streamCoins := StreamsTotalAmount(va.VestingStreams)
+	periodCoins, isNeg := va.OriginalVesting.SafeSub(streamCoins...)
+	// safety check
+	if isNeg {
+		periodCoins = va.VestingPeriods.TotalAmount()
+	}
+
+	vested := ReadSchedule(va.GetStartTime(), va.EndTime, va.VestingPeriods, periodCoins, blockTime.Unix())
+	return vested.Add(ReadStreams(va.VestingStreams, blockTime.Unix())...)
 }
 
 // GetPassedPeriodCount returns the amount of passed periods at blockTime.
@@ -210,6 +236,24 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	newVestingPeriods := va.VestingPeriods[:passedPeriodID]
 	newVestingEnd := va.GetStartTime() + newVestingPeriods.TotalLength()
 
+	// Stop the streams at the clawback time, so that the coins streamed so far
+	// are fully vested.
+	var newVestingStreams []VestingStream
+	for _, s := range va.VestingStreams {
+		streamed := s.ReadStream(clawbackTime)
+		if streamed.IsZero() {
+			continue
+		}
+
+		if clawbackTime < s.EndTime() {
+			s.Duration = clawbackTime - s.StartTime
+			s.Amount = streamed
+		}
+
+		newVestingStreams = append(newVestingStreams, s)
+		newVestingEnd = Max64(newVestingEnd, s.EndTime())
+	}
+
 	// Cap the unlocking schedule to the new total vested.
 	//  - If lockup has already passed, all vested coins are unlocked.
 	//  - If lockup has not passed, the vested coins, are still locked.
@@ -228,6 +272,7 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	va.EndTime = Max64(newVestingEnd, newLockingEnd)
 	va.LockupPeriods = newLockupPeriods
 	va.VestingPeriods = newVestingPeriods
+	va.VestingStreams = newVestingStreams
 
 	return va, totalUnvested
 }
@@ -238,16 +283,98 @@ func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
 	return !va.GetLockedUpCoins(blockTime).IsZero()
 }
 
+// HasSchedules returns true if the account has any lockup or vesting periods
+// or vesting streams.
+func (va ClawbackVestingAccount) HasSchedules() bool {
+	return len(va.LockupPeriods) > 0 || len(va.VestingPeriods) > 0 || len(va.VestingStreams) > 0
+}
+
+// ScheduleEvents returns the unlocking and vesting events of the account,
+// merged in a single timeline sorted by time. The events include the ends of
+// the lockup and vesting periods and the cliffs and ends of the vesting
+// streams. Times without unlocked or vested coins are skipped.
+func (va ClawbackVestingAccount) ScheduleEvents() []ScheduleEvent {
+	startTime := va.GetStartTime()
+	eventTimes := make(map[int64]struct{})
+
+	addPeriodEnds := func(periods sdkvesting.Periods) {
+		eventTime := startTime
+		for _, p := range periods {
+			eventTime += p.Length
+			eventTimes[eventTime] = struct{}{}
+		}
+	}
+	addPeriodEnds(va.LockupPeriods)
+	addPeriodEnds(va.VestingPeriods)
+
+	for _, s := range va.VestingStreams {
+		if s.Cliff > 0 {
+			eventTimes[s.StartTime+s.Cliff] = struct{}{}
+		}
+		eventTimes[s.EndTime()] = struct{}{}
+	}
+
+	sortedTimes := make([]int64, 0, len(eventTimes))
+	for t := range eventTimes {
+		sortedTimes = append(sortedTimes, t)
+	}
+	sort.Slice(sortedTimes, func(i, j int) bool { return sortedTimes[i] < sortedTimes[j] })
+
+	// periodCoinsAt returns the coins of the periods ended at readTime
+	periodCoinsAt := func(periods sdkvesting.Periods, readTime int64) sdk.Coins {
+		coins := sdk.Coins{}
+		eventTime := startTime
+		for _, p := range periods {
+			eventTime += p.Length
+			if eventTime > readTime {
+				break
+			}
+			coins = coins.Add(p.Amount...)
+		}
+		return coins
+	}
+
+	var (
+		events        = []ScheduleEvent{}
+		totalUnlocked = sdk.Coins{}
+		totalVested   = sdk.Coins{}
+	)
+
+	for _, t := range sortedTimes {
+		unlocked := periodCoinsAt(va.LockupPeriods, t)
+		vested := periodCoinsAt(va.VestingPeriods, t).Add(ReadStreams(va.VestingStreams, t)...)
+
+		event := ScheduleEvent{
+			Time:          time.Unix(t, 0).UTC(),
+			Unlocked:      unlocked.Sub(totalUnlocked...),
+			Vested:        vested.Sub(totalVested...),
+			TotalUnlocked: unlocked,
+			TotalVested:   vested,
+		}
+		if event.Unlocked.IsZero() && event.Vested.IsZero() {
+			continue
+		}
+
+		events = append(events, event)
+		totalUnlocked = unlocked
+		totalVested = vested
+	}
+
+	return events
+}
+
 // AddGrant merges a new clawback vesting grant into an existing
-// ClawbackVestingAccount.
+// ClawbackVestingAccount. The vesting streams of the grant are added to the
+// vesting streams of the account.
 func (va *ClawbackVestingAccount) AddGrant(
 	grantStartTime int64,
 	grantLockupPeriods, grantVestingPeriods sdkvesting.Periods,
+	grantVestingStreams []VestingStream,
 	grantCoins sdk.Coins,
 ) error {
 	// check if the clawback vesting account has only been initialized and not yet funded --
 	// in that case it's necessary to update the vesting account with the given start time because this is set to zero in the initialization
-	if len(va.LockupPeriods) == 0 && len(va.VestingPeriods) == 0 {
+	if !va.HasSchedules() {
 		va.StartTime = time.Unix(grantStartTime, 0).UTC()
 	}
 
@@ -275,5 +402,98 @@ func (va *ClawbackVestingAccount) AddGrant(
 	va.VestingPeriods = newVestingPeriods
 	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
 
+	for _, s := range grantVestingStreams {
+		va.VestingStreams = append(va.VestingStreams, s)
+		va.EndTime = Max64(va.EndTime, s.EndTime())
+	}
+
 	return nil
 }
+
+// UpdateSchedule returns an account with the given lockup and vesting periods,
+// relative to the start time of the account, and the coins added to the
+// original vesting of the account. An empty schedule is not updated and the
+// vesting streams of the account are kept.
+//
+// The update is rejected if:
+//   - the original vesting coins decrease
+//   - the vested coins decrease at any time from the update time on
+//   - the unlocked coins at the update time decrease
+//   - the lockup and vesting schedules do not describe the same total coins
+func (va ClawbackVestingAccount) UpdateSchedule(
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	updateTime int64,
+) (ClawbackVestingAccount, sdk.Coins, error) {
+	// copy the base vesting account to avoid mutating the account
+	baseVestingAcc := *va.BaseVestingAccount
+	updated := va
+	updated.BaseVestingAccount = &baseVestingAcc
+
+	if len(lockupPeriods) > 0 {
+		updated.LockupPeriods = lockupPeriods
+	}
+	if len(vestingPeriods) > 0 {
+		updated.VestingPeriods = vestingPeriods
+	}
+
+	updated.OriginalVesting = updated.LockupPeriods.TotalAmount()
+	vestingCoins := updated.VestingPeriods.TotalAmount().Add(StreamsTotalAmount(updated.VestingStreams)...)
+	if !CoinEq(updated.OriginalVesting, vestingCoins) {
+		return va, nil, errorsmod.Wrapf(
+			ErrVestingLockup,
+			"vesting and lockup schedules must have same total coins (%s ≠ %s)", vestingCoins, updated.OriginalVesting,
+		)
+	}
+
+	topUp, isNeg := updated.OriginalVesting.SafeSub(va.OriginalVesting...)
+	if isNeg {
+		return va, nil, errorsmod.Wrapf(
+			ErrVestingLockup,
+			"original vesting coins cannot decrease from %s to %s", va.OriginalVesting, updated.OriginalVesting,
+		)
+	}
+
+	updated.EndTime = Max64(
+		va.GetStartTime()+updated.LockupPeriods.TotalLength(),
+		va.GetStartTime()+updated.VestingPeriods.TotalLength(),
+	)
+	for _, s := range updated.VestingStreams {
+		updated.EndTime = Max64(updated.EndTime, s.EndTime())
+	}
+
+	// NOTE: the vested coins are step functions of the periods plus the
+	// unchanged streams, so it is enough to compare them at the update time
+	// and at the end of the remaining vesting periods of the account
+	readTimes := []int64{updateTime}
+	eventTime := va.GetStartTime()
+	for _, p := range va.VestingPeriods {
+		eventTime += p.Length
+		if eventTime > updateTime {
+			readTimes = append(readTimes, eventTime)
+		}
+	}
+
+	for _, readTime := range readTimes {
+		vested := va.GetVestedCoins(time.Unix(readTime, 0))
+		if !updated.GetVestedCoins(time.Unix(readTime, 0)).IsAllGTE(vested) {
+			return va, nil, errorsmod.Wrapf(
+				ErrInsufficientVestedCoins,
+				"vested coins cannot decrease, %s vested at %d", vested, readTime,
+			)
+		}
+	}
+
+	unlocked := va.GetUnlockedCoins(time.Unix(updateTime, 0))
+	if !updated.GetUnlockedCoins(time.Unix(updateTime, 0)).IsAllGTE(unlocked) {
+		return va, nil, errorsmod.Wrapf(
+			ErrInsufficientUnlockedCoins,
+			"unlocked coins cannot decrease, %s unlocked at %d", unlocked, updateTime,
+		)
+	}
+
+	if err := updated.Validate(); err != nil {
+		return va, nil, errorsmod.Wrap(ErrVestingLockup, err.Error())
+	}
+
+	return updated, topUp, nil
+}
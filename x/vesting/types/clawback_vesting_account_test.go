@@ -538,6 +538,176 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestUpdateSchedule() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := cmttime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	testCases := []struct {
+		name       string
+		time       int64
+		lockup     sdkvesting.Periods
+		vesting    sdkvesting.Periods
+		expTopUp   sdk.Coins
+		expEndTime int64
+		expError   error
+	}{
+		{
+			name: "pass - accelerate the vesting",
+			time: now.Add(10 * time.Hour).Unix(),
+			vesting: sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(400), stake(50))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))},
+			},
+			expTopUp:   sdk.Coins{},
+			expEndTime: now.Add(12 * time.Hour).Unix(),
+		},
+		{
+			name:       "pass - extend the lockup",
+			time:       now.Add(10 * time.Hour).Unix(),
+			lockup:     sdkvesting.Periods{{Length: int64(24 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			expTopUp:   sdk.Coins{},
+			expEndTime: now.Add(24 * time.Hour).Unix(),
+		},
+		{
+			name:       "pass - top up the account",
+			time:       now.Add(10 * time.Hour).Unix(),
+			lockup:     sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1500), stake(100))}},
+			vesting:    append(append(sdkvesting.Periods{}, vestingPeriods...), sdkvesting.Period{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(500))}),
+			expTopUp:   sdk.NewCoins(fee(500)),
+			expEndTime: now.Add(24 * time.Hour).Unix(),
+		},
+		{
+			name:     "fail - lockup and vesting totals differ",
+			time:     now.Add(10 * time.Hour).Unix(),
+			lockup:   sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1500), stake(100))}},
+			expError: types.ErrVestingLockup,
+		},
+		{
+			name:   "fail - original vesting decreases",
+			time:   now.Add(10 * time.Hour).Unix(),
+			lockup: sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(500), stake(100))}},
+			vesting: sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(300), stake(100))},
+			},
+			expError: types.ErrVestingLockup,
+		},
+		{
+			name:     "fail - vested coins decrease at the update time",
+			time:     now.Add(10 * time.Hour).Unix(),
+			vesting:  sdkvesting.Periods{{Length: int64(20 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			expError: types.ErrInsufficientVestedCoins,
+		},
+		{
+			name: "fail - unvested coins are delayed",
+			time: now.Add(10 * time.Hour).Unix(),
+			vesting: sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+				{Length: int64(10 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))},
+			},
+			expError: types.ErrInsufficientVestedCoins,
+		},
+		{
+			name:     "fail - unlocked coins are locked again",
+			time:     now.Add(13 * time.Hour).Unix(),
+			lockup:   sdkvesting.Periods{{Length: int64(24 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			expError: types.ErrInsufficientUnlockedCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+			updated, topUp, err := va.UpdateSchedule(tc.lockup, tc.vesting, tc.time)
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expTopUp, topUp)
+			suite.Require().Equal(origCoins.Add(tc.expTopUp...), updated.OriginalVesting)
+			suite.Require().Equal(tc.expEndTime, updated.EndTime)
+			suite.Require().NoError(updated.Validate())
+
+			// the original account is not modified
+			suite.Require().Equal(origCoins, va.OriginalVesting)
+			suite.Require().Equal(lockupPeriods, va.LockupPeriods)
+			suite.Require().Equal(vestingPeriods, va.VestingPeriods)
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestScheduleEvents() {
+	fee := func(x int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, x)) }
+	now := cmttime.Now()
+	start := now.Unix()
+	at := func(offset int64) time.Time { return time.Unix(start+offset, 0).UTC() }
+
+	testCases := []struct {
+		name      string
+		lockup    sdkvesting.Periods
+		vesting   sdkvesting.Periods
+		streams   []types.VestingStream
+		expEvents []types.ScheduleEvent
+	}{
+		{
+			name:    "lockup and vesting periods are merged",
+			lockup:  sdkvesting.Periods{{Length: 10, Amount: fee(1000)}},
+			vesting: sdkvesting.Periods{{Length: 5, Amount: fee(500)}, {Length: 5, Amount: fee(500)}},
+			expEvents: []types.ScheduleEvent{
+				{Time: at(5), Unlocked: sdk.Coins{}, Vested: fee(500), TotalUnlocked: sdk.Coins{}, TotalVested: fee(500)},
+				{Time: at(10), Unlocked: fee(1000), Vested: fee(500), TotalUnlocked: fee(1000), TotalVested: fee(1000)},
+			},
+		},
+		{
+			name:    "periods without coins are skipped",
+			lockup:  sdkvesting.Periods{{Length: 10, Amount: fee(1000)}},
+			vesting: sdkvesting.Periods{{Length: 5, Amount: sdk.Coins{}}, {Length: 20, Amount: fee(1000)}},
+			expEvents: []types.ScheduleEvent{
+				{Time: at(10), Unlocked: fee(1000), Vested: sdk.Coins{}, TotalUnlocked: fee(1000), TotalVested: sdk.Coins{}},
+				{Time: at(25), Unlocked: sdk.Coins{}, Vested: fee(1000), TotalUnlocked: fee(1000), TotalVested: fee(1000)},
+			},
+		},
+		{
+			name:    "cliff and end of vesting streams",
+			lockup:  sdkvesting.Periods{{Length: 0, Amount: fee(1000)}},
+			streams: []types.VestingStream{{StartTime: start, Cliff: 100, Duration: 1000, Amount: fee(1000)}},
+			expEvents: []types.ScheduleEvent{
+				{Time: at(0), Unlocked: fee(1000), Vested: sdk.Coins{}, TotalUnlocked: fee(1000), TotalVested: sdk.Coins{}},
+				{Time: at(100), Unlocked: sdk.Coins{}, Vested: fee(100), TotalUnlocked: fee(1000), TotalVested: fee(100)},
+				{Time: at(1000), Unlocked: sdk.Coins{}, Vested: fee(900), TotalUnlocked: fee(1000), TotalVested: fee(1000)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), fee(1000), now, tc.lockup, tc.vesting)
+			va.VestingStreams = tc.streams
+
+			suite.Require().Equal(tc.expEvents, va.ScheduleEvents())
+		})
+	}
+}
+
 // getPercentOfVestingCoins is a helper function to calculate
 // the specified percentage of the coins in the vesting schedule
 func getPercentOfVestingCoins(percentage int64) sdk.Coins {
@@ -31,6 +31,7 @@ const (
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
 	convertVestingAccount        = "evmos/MsgConvertVestingAccount"
 	fundVestingAccount           = "evmos/MsgFundVestingAccount"
+	updateVestingSchedule        = "evmos/MsgUpdateVestingSchedule"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -71,6 +72,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateVestingFunder{},
 		&MsgFundVestingAccount{},
 		&MsgConvertVestingAccount{},
+		&MsgUpdateVestingSchedule{},
 	)
 
 	registry.RegisterImplementations(
@@ -90,4 +92,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgFundVestingAccount{}, fundVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgUpdateVestingSchedule{}, updateVestingSchedule, nil)
 }
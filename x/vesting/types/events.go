@@ -9,6 +9,7 @@ const (
 	EventTypeFundVestingAccount           = "fund_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeUpdateVestingSchedule        = "update_vesting_schedule"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
@@ -3,6 +3,11 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// prefixGovClawbackDisabledKey to be used in the KVStore to track vesting accounts that are not subject
 	// to clawback from governance.
@@ -10,6 +15,9 @@ const (
 	// prefixGovClawbackProposalKey to be used in the KVStore to track vesting accounts that are subject
 	// to active governance clawback proposals.
 	prefixGovClawbackProposalKey
+	// prefixFunderVestingAccountKey to be used in the KVStore to index the clawback vesting
+	// accounts by funder.
+	prefixFunderVestingAccountKey
 )
 
 var (
@@ -18,6 +26,9 @@ var (
 	// KeyPrefixGovClawbackProposalKey is the slice of prefix bytes for storing the vesting account
 	// of governance clawback proposals.
 	KeyPrefixGovClawbackProposalKey = []byte{prefixGovClawbackProposalKey}
+	// KeyPrefixFunderVestingAccountKey is the slice of prefix bytes for indexing the clawback
+	// vesting accounts by funder.
+	KeyPrefixFunderVestingAccountKey = []byte{prefixFunderVestingAccountKey}
 )
 
 const (
@@ -30,3 +41,18 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// GetFunderVestingAccountsPrefix returns the prefix of the index keys of the
+// clawback vesting accounts of the given funder.
+func GetFunderVestingAccountsPrefix(funder sdk.AccAddress) []byte {
+	funderBz := address.MustLengthPrefix(funder.Bytes())
+	key := make([]byte, 0, len(KeyPrefixFunderVestingAccountKey)+len(funderBz))
+	key = append(key, KeyPrefixFunderVestingAccountKey...)
+	return append(key, funderBz...)
+}
+
+// GetFunderVestingAccountKey returns the index key of the given clawback
+// vesting account under its funder.
+func GetFunderVestingAccountKey(funder, vestingAddr sdk.AccAddress) []byte {
+	return append(GetFunderVestingAccountsPrefix(funder), vestingAddr.Bytes()...)
+}
@@ -20,6 +20,7 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgUpdateVestingSchedule{}
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgUpdateVestingSchedule        = "update_vesting_schedule"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -137,6 +139,31 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 		vestingCoins = vestingCoins.Add(period.Amount...)
 	}
 
+	if msg.LockupTemplate != nil {
+		if len(msg.LockupPeriods) > 0 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup periods and lockup template cannot be both present")
+		}
+		if err := msg.LockupTemplate.Validate(); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid lockup template: %s", err)
+		}
+		// NOTE: locked coins are computed from periods, which cannot describe
+		// a continuous schedule
+		if msg.LockupTemplate.IsContinuous() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "continuous schedule templates are only supported for vesting")
+		}
+		lockupCoins = msg.LockupTemplate.Amount
+	}
+
+	if msg.VestingTemplate != nil {
+		if len(msg.VestingPeriods) > 0 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting periods and vesting template cannot be both present")
+		}
+		if err := msg.VestingTemplate.Validate(); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid vesting template: %s", err)
+		}
+		vestingCoins = msg.VestingTemplate.Amount
+	}
+
 	// If neither schedule is present, the message is invalid.
 	if len(lockupCoins) == 0 && len(vestingCoins) == 0 {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and/or lockup schedules must be present")
@@ -144,7 +171,9 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 
 	// If both schedules are present, they must describe the same total amount.
 	// IsEqual can panic, so use (a == b) <=> (a <= b && b <= a).
-	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !CoinEq(lockupCoins, vestingCoins) {
+	hasLockup := len(msg.LockupPeriods) > 0 || msg.LockupTemplate != nil
+	hasVesting := len(msg.VestingPeriods) > 0 || msg.VestingTemplate != nil
+	if hasLockup && hasVesting && !CoinEq(lockupCoins, vestingCoins) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and lockup schedules must have same total coins")
 	}
 
@@ -273,3 +302,58 @@ func (msg MsgConvertVestingAccount) ValidateBasic() error {
 func (msg *MsgConvertVestingAccount) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
+
+// NewMsgUpdateVestingSchedule creates new instance of MsgUpdateVestingSchedule
+func NewMsgUpdateVestingSchedule(
+	funderAddr, vestingAddr sdk.AccAddress,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *MsgUpdateVestingSchedule {
+	return &MsgUpdateVestingSchedule{
+		FunderAddress:  funderAddr.String(),
+		VestingAddress: vestingAddr.String(),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Type() string { return TypeMsgUpdateVestingSchedule }
+
+// ValidateBasic runs stateless checks on the MsgUpdateVestingSchedule message
+func (msg MsgUpdateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and/or lockup schedules must be present")
+	}
+
+	// NOTE: the schedules replace the ones of the account, which can start
+	// with an instant period, so zero length periods are allowed
+	for _, periods := range []sdkvesting.Periods{msg.LockupPeriods, msg.VestingPeriods} {
+		for i, period := range periods {
+			if period.Length < 0 {
+				return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must not be negative", period.Length, i)
+			}
+			if !period.Amount.IsValid() {
+				return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
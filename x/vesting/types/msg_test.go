@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -224,11 +225,66 @@ func (suite *MsgsTestSuite) TestMsgFundVestingAccount() {
 	for _, tc := range testCases {
 		suite.Run(tc.msg, func() {
 			tx := types.MsgFundVestingAccount{
-				tc.funderAddr,
-				tc.vestingAddr,
-				tc.startTime,
-				tc.lockupPeriods,
-				tc.vestingPeriods,
+				FunderAddress:  tc.funderAddr,
+				VestingAddress: tc.vestingAddr,
+				StartTime:      tc.startTime,
+				LockupPeriods:  tc.lockupPeriods,
+				VestingPeriods: tc.vestingPeriods,
+			}
+			err := tx.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err, "failed to validate message")
+			} else {
+				suite.Require().Error(err, "expected message validation to fail")
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgFundVestingAccountTemplates() {
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 4800)}
+	periods := sdkvesting.Periods{{Length: 200000, Amount: coins}}
+	monthly := types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_MONTHLY, 12*types.SecondsPerMonth, 48*types.SecondsPerMonth, coins)
+	continuous := types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_CONTINUOUS, 100, 1000, coins)
+
+	testCases := []struct {
+		msg             string
+		lockupPeriods   sdkvesting.Periods
+		vestingPeriods  sdkvesting.Periods
+		lockupTemplate  *types.ScheduleTemplate
+		vestingTemplate *types.ScheduleTemplate
+		expPass         bool
+	}{
+		{"pass - vesting template", nil, nil, nil, monthly, true},
+		{"pass - continuous vesting template", nil, nil, nil, continuous, true},
+		{"pass - lockup template with vesting periods", nil, periods, monthly, nil, true},
+		{"pass - lockup and vesting templates", nil, nil, monthly, continuous, true},
+		{"fail - continuous lockup template", nil, nil, continuous, nil, false},
+		{"fail - vesting periods and template", nil, periods, nil, monthly, false},
+		{"fail - lockup periods and template", periods, nil, monthly, nil, false},
+		{
+			"fail - template amount mismatch", periods, nil, nil,
+			types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_MONTHLY, 0, types.SecondsPerMonth, sdk.Coins{sdk.NewInt64Coin("atom", 1)}),
+			false,
+		},
+		{
+			"fail - invalid template", nil, nil, nil,
+			types.NewScheduleTemplate(types.SCHEDULE_INTERVAL_QUARTERLY, 0, types.SecondsPerMonth, coins),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tx := types.MsgFundVestingAccount{
+				FunderAddress:   sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				VestingAddress:  sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				StartTime:       time.Unix(100200300, 0),
+				LockupPeriods:   tc.lockupPeriods,
+				VestingPeriods:  tc.vestingPeriods,
+				LockupTemplate:  tc.lockupTemplate,
+				VestingTemplate: tc.vestingTemplate,
 			}
 			err := tx.ValidateBasic()
 
@@ -446,6 +502,88 @@ func (suite *MsgsTestSuite) TestMsgUpdateVestingFunder() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgUpdateVestingScheduleGetters() {
+	msgInvalid := types.MsgUpdateVestingSchedule{}
+	msg := types.NewMsgUpdateVestingSchedule(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		nil,
+		sdkvesting.Periods{{Length: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))}},
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgUpdateVestingSchedule, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateVestingSchedule() {
+	var (
+		funder     = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		vestingAcc = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		coins      = sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgUpdateVestingSchedule
+		expectPass bool
+	}{
+		{
+			name:       "msg update vesting schedule - valid lockup",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, sdkvesting.Periods{{Length: 100, Amount: coins}}, nil),
+			expectPass: true,
+		},
+		{
+			name:       "msg update vesting schedule - valid instant vesting period",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, nil, sdkvesting.Periods{{Length: 0, Amount: coins}}),
+			expectPass: true,
+		},
+		{
+			name: "msg update vesting schedule - invalid funder address",
+			msg: &types.MsgUpdateVestingSchedule{
+				FunderAddress:  "invalid_address",
+				VestingAddress: vestingAcc.String(),
+				LockupPeriods:  sdkvesting.Periods{{Length: 100, Amount: coins}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "msg update vesting schedule - invalid vesting address",
+			msg: &types.MsgUpdateVestingSchedule{
+				FunderAddress:  funder.String(),
+				VestingAddress: "invalid_address",
+				LockupPeriods:  sdkvesting.Periods{{Length: 100, Amount: coins}},
+			},
+			expectPass: false,
+		},
+		{
+			name:       "msg update vesting schedule - empty schedules",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, nil, nil),
+			expectPass: false,
+		},
+		{
+			name:       "msg update vesting schedule - negative period length",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, sdkvesting.Periods{{Length: -1, Amount: coins}}, nil),
+			expectPass: false,
+		},
+		{
+			name: "msg update vesting schedule - invalid period amount",
+			msg: types.NewMsgUpdateVestingSchedule(funder, vestingAcc, nil, sdkvesting.Periods{
+				{Length: 100, Amount: sdk.Coins{sdk.Coin{Denom: "test", Amount: math.NewInt(-1)}}},
+			}),
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.name)
+		}
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgConvertVestingAccountGetters() {
 	msgInvalid := types.MsgConvertVestingAccount{}
 	msg := types.NewMsgConvertVestingAccount(
@@ -8,23 +8,28 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +41,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryBalancesRequest struct {
 	// address of the clawback vesting account
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// time at which the balances are computed. The block time is used if it is
+	// not set.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
 }
 
 func (m *QueryBalancesRequest) Reset()         { *m = QueryBalancesRequest{} }
@@ -78,6 +86,13 @@ func (m *QueryBalancesRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryBalancesRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
 // QueryBalancesResponse is the response type for the Query/Balances RPC
 // method.
 type QueryBalancesResponse struct {
@@ -143,40 +158,389 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// QueryVestingAccountsByFunderRequest is the request type for the
+// Query/VestingAccountsByFunder RPC method.
+type QueryVestingAccountsByFunderRequest struct {
+	// funder_address of the clawback vesting accounts
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsByFunderRequest) Reset()         { *m = QueryVestingAccountsByFunderRequest{} }
+func (m *QueryVestingAccountsByFunderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsByFunderRequest) ProtoMessage()    {}
+func (*QueryVestingAccountsByFunderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{2}
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsByFunderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsByFunderRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsByFunderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsByFunderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsByFunderRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsByFunderRequest) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *QueryVestingAccountsByFunderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingAccountsByFunderResponse is the response type for the
+// Query/VestingAccountsByFunder RPC method.
+type QueryVestingAccountsByFunderResponse struct {
+	// vesting_addresses of the clawback vesting accounts funded by the funder
+	VestingAddresses []string `protobuf:"bytes,1,rep,name=vesting_addresses,json=vestingAddresses,proto3" json:"vesting_addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Reset()         { *m = QueryVestingAccountsByFunderResponse{} }
+func (m *QueryVestingAccountsByFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsByFunderResponse) ProtoMessage()    {}
+func (*QueryVestingAccountsByFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{3}
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsByFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsByFunderResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsByFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsByFunderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsByFunderResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsByFunderResponse) GetVestingAddresses() []string {
+	if m != nil {
+		return m.VestingAddresses
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduleRequest is the request type for the Query/Schedule RPC method.
+type QueryScheduleRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{4}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ScheduleEvent defines the coins unlocked and vested at a time of the
+// schedule of a clawback vesting account.
+type ScheduleEvent struct {
+	// time of the event
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// unlocked defines the coins unlocked at the time of the event
+	Unlocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked"`
+	// vested defines the coins vested at the time of the event. The coins
+	// streamed by the vesting streams since the previous event are included.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// total_unlocked defines the total coins unlocked after the event
+	TotalUnlocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_unlocked,json=totalUnlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_unlocked"`
+	// total_vested defines the total coins vested after the event
+	TotalVested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_vested,json=totalVested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_vested"`
+}
+
+func (m *ScheduleEvent) Reset()         { *m = ScheduleEvent{} }
+func (m *ScheduleEvent) String() string { return proto.CompactTextString(m) }
+func (*ScheduleEvent) ProtoMessage()    {}
+func (*ScheduleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{5}
+}
+func (m *ScheduleEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleEvent.Merge(m, src)
+}
+func (m *ScheduleEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleEvent proto.InternalMessageInfo
+
+func (m *ScheduleEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ScheduleEvent) GetUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *ScheduleEvent) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *ScheduleEvent) GetTotalUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalUnlocked
+	}
+	return nil
+}
+
+func (m *ScheduleEvent) GetTotalVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalVested
+	}
+	return nil
+}
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC
+// method.
+type QueryScheduleResponse struct {
+	// start_time of the vesting account schedules
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time at which all the coins of the account are unlocked and vested
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// events of the lockup and vesting periods merged in a single timeline,
+	// including the cliffs and ends of the vesting streams
+	Events []ScheduleEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// vesting_streams of the account, which vest linearly between their cliff
+	// and end events
+	VestingStreams []VestingStream `protobuf:"bytes,4,rep,name=vesting_streams,json=vestingStreams,proto3" json:"vesting_streams"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{6}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryScheduleResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryScheduleResponse) GetEvents() []ScheduleEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QueryScheduleResponse) GetVestingStreams() []VestingStream {
+	if m != nil {
+		return m.VestingStreams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v2.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v2.QueryBalancesResponse")
+	proto.RegisterType((*QueryVestingAccountsByFunderRequest)(nil), "evmos.vesting.v2.QueryVestingAccountsByFunderRequest")
+	proto.RegisterType((*QueryVestingAccountsByFunderResponse)(nil), "evmos.vesting.v2.QueryVestingAccountsByFunderResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "evmos.vesting.v2.QueryScheduleRequest")
+	proto.RegisterType((*ScheduleEvent)(nil), "evmos.vesting.v2.ScheduleEvent")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "evmos.vesting.v2.QueryScheduleResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/query.proto", fileDescriptor_e31744b0ce27e85a) }
 
 var fileDescriptor_e31744b0ce27e85a = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0xf5, 0x82, 0x4a, 0xe9, 0xf6, 0xd2, 0x5a, 0x54, 0x72, 0x11, 0x32, 0x08, 0x55, 0x14, 0xa1,
-	0x76, 0x17, 0x5c, 0xf5, 0x07, 0x68, 0x7f, 0xa0, 0x1c, 0x7b, 0x5b, 0xdb, 0x2b, 0x63, 0x61, 0x76,
-	0x0c, 0xbb, 0xb6, 0x8a, 0xaa, 0x5e, 0x7a, 0xeb, 0xad, 0x12, 0x3f, 0x11, 0xe5, 0x94, 0xcf, 0xe0,
-	0x88, 0x94, 0x4b, 0x72, 0x49, 0x22, 0x88, 0x94, 0xdf, 0x88, 0x6c, 0x2f, 0x28, 0x22, 0x91, 0x72,
-	0xe2, 0x62, 0x8f, 0xfd, 0x66, 0xde, 0xbc, 0x37, 0x33, 0xb8, 0xc1, 0xd3, 0x29, 0x48, 0x9a, 0x72,
-	0xa9, 0x42, 0x11, 0xd0, 0xd4, 0xa1, 0xb3, 0x84, 0xcf, 0x17, 0x24, 0x9e, 0x83, 0x02, 0xf3, 0x4d,
-	0x8e, 0x12, 0x8d, 0x92, 0xd4, 0xa9, 0xbf, 0x65, 0xd3, 0x50, 0x00, 0xcd, 0x9f, 0x45, 0x52, 0xdd,
-	0xf6, 0x40, 0x66, 0x1c, 0x2e, 0x93, 0x9c, 0xa6, 0x03, 0x97, 0x2b, 0x36, 0xa0, 0x1e, 0x84, 0x42,
-	0xe3, 0xb5, 0x00, 0x02, 0xc8, 0x43, 0x9a, 0x45, 0xfa, 0x6f, 0x23, 0x00, 0x08, 0x22, 0x4e, 0x59,
-	0x1c, 0x52, 0x26, 0x04, 0x28, 0xa6, 0x42, 0x10, 0xb2, 0x40, 0xdb, 0x7d, 0x5c, 0xfb, 0x91, 0xe9,
-	0x18, 0xb2, 0x88, 0x09, 0x8f, 0xcb, 0x11, 0x9f, 0x25, 0x5c, 0x2a, 0xd3, 0xc2, 0x2f, 0x99, 0xef,
-	0xcf, 0xb9, 0x94, 0x16, 0x6a, 0xa1, 0xee, 0xab, 0xd1, 0xee, 0xb3, 0x7d, 0x59, 0xc2, 0xef, 0x0e,
-	0x4a, 0x64, 0x0c, 0x42, 0x72, 0x73, 0x8c, 0x2b, 0x11, 0x78, 0x13, 0xee, 0x5b, 0xa8, 0x55, 0xee,
-	0xbe, 0x76, 0xde, 0x93, 0x42, 0x30, 0xc9, 0x04, 0x13, 0x2d, 0x98, 0x7c, 0x83, 0x50, 0x0c, 0xbf,
-	0xae, 0xae, 0x9a, 0xc6, 0xe9, 0x75, 0xb3, 0x1b, 0x84, 0x6a, 0x9c, 0xb8, 0xc4, 0x83, 0x29, 0xd5,
-	0xee, 0x8a, 0xd7, 0x67, 0xe9, 0x4f, 0xa8, 0x5a, 0xc4, 0x5c, 0xe6, 0x05, 0xf2, 0xe4, 0xee, 0xac,
-	0x87, 0x46, 0x9a, 0xdf, 0x8c, 0x70, 0x35, 0x11, 0xd9, 0xb0, 0xb8, 0x6f, 0x95, 0x8e, 0xd4, 0x6b,
-	0xdf, 0x21, 0xf3, 0xa5, 0x7b, 0x95, 0x8f, 0xe5, 0xab, 0xe0, 0x77, 0x96, 0x08, 0xbf, 0xc8, 0x67,
-	0x6b, 0xfe, 0x43, 0xb8, 0xba, 0x1b, 0xb0, 0xd9, 0x21, 0x87, 0xe7, 0x41, 0x9e, 0x5a, 0x5a, 0xfd,
-	0xe3, 0xb3, 0x79, 0xc5, 0xa6, 0xda, 0x9f, 0xfe, 0x9e, 0xdf, 0x2e, 0x4b, 0x1d, 0xf3, 0x03, 0x7d,
-	0x74, 0x95, 0xae, 0xce, 0xa5, 0xbf, 0xf5, 0xc2, 0xff, 0x0c, 0xbf, 0xaf, 0x36, 0x36, 0x5a, 0x6f,
-	0x6c, 0x74, 0xb3, 0xb1, 0xd1, 0xff, 0xad, 0x6d, 0xac, 0xb7, 0xb6, 0x71, 0xb1, 0xb5, 0x8d, 0x9f,
-	0xbd, 0x07, 0x36, 0x0b, 0x26, 0xcd, 0xe7, 0xf4, 0xe9, 0xaf, 0x3d, 0x6b, 0x6e, 0xd7, 0xad, 0xe4,
-	0x07, 0xf7, 0xe5, 0x3e, 0x00, 0x00, 0xff, 0xff, 0x04, 0xf4, 0x26, 0xc1, 0x09, 0x03, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xf7, 0x1a, 0x03, 0x66, 0x28, 0x14, 0x46, 0x54, 0x75, 0x2d, 0x64, 0x23, 0x97, 0x82, 0x45,
+	0xdb, 0x1d, 0x30, 0xa5, 0xaa, 0x54, 0x45, 0x11, 0x26, 0x21, 0xb7, 0x28, 0x31, 0x09, 0x87, 0x5c,
+	0xac, 0xf1, 0xee, 0xb0, 0xac, 0xb0, 0x77, 0x8c, 0x67, 0xd6, 0x09, 0x42, 0x5c, 0x72, 0xcb, 0x29,
+	0x48, 0xc9, 0x31, 0x1f, 0x20, 0x8a, 0x72, 0xc8, 0x97, 0x88, 0xc4, 0x11, 0x29, 0x97, 0xe4, 0x12,
+	0x22, 0x88, 0xc4, 0xd7, 0x88, 0x76, 0xe6, 0xad, 0xff, 0x60, 0x8c, 0xc3, 0xc1, 0xb9, 0x80, 0x77,
+	0xde, 0x9b, 0xdf, 0xef, 0xfd, 0xf9, 0xbd, 0x37, 0x68, 0x9a, 0xd5, 0x2b, 0x5c, 0x90, 0x3a, 0x13,
+	0xd2, 0xf5, 0x1c, 0x52, 0xcf, 0x91, 0x5d, 0x9f, 0xd5, 0xf6, 0xcc, 0x6a, 0x8d, 0x4b, 0x8e, 0x27,
+	0x94, 0xd5, 0x04, 0xab, 0x59, 0xcf, 0x25, 0x27, 0x69, 0xc5, 0xf5, 0x38, 0x51, 0x7f, 0xb5, 0x53,
+	0x72, 0xc1, 0xe2, 0x22, 0xc0, 0x28, 0x51, 0xc1, 0xf4, 0x6d, 0x52, 0x5f, 0x2a, 0x31, 0x49, 0x97,
+	0x48, 0x95, 0x3a, 0xae, 0x47, 0xa5, 0xcb, 0x3d, 0xf0, 0x4d, 0xb5, 0xfa, 0x86, 0x5e, 0x16, 0x77,
+	0x1b, 0xf6, 0x8e, 0x70, 0x42, 0x6e, 0x6d, 0x9f, 0x72, 0xb8, 0xc3, 0xd5, 0x4f, 0x12, 0xfc, 0x82,
+	0xd3, 0x69, 0x87, 0x73, 0xa7, 0xcc, 0x08, 0xad, 0xba, 0x84, 0x7a, 0x1e, 0x97, 0x8a, 0x52, 0x80,
+	0x35, 0x0d, 0x56, 0xf5, 0x55, 0xf2, 0xb7, 0x88, 0x74, 0x2b, 0x4c, 0x48, 0x5a, 0xa9, 0x6a, 0x87,
+	0xcc, 0x16, 0x9a, 0xba, 0x1f, 0x84, 0x9d, 0xa7, 0x65, 0xea, 0x59, 0x4c, 0x14, 0xd8, 0xae, 0xcf,
+	0x84, 0xc4, 0x09, 0x34, 0x4c, 0x6d, 0xbb, 0xc6, 0x84, 0x48, 0x18, 0x33, 0x46, 0x76, 0xa4, 0x10,
+	0x7e, 0xe2, 0x7f, 0x50, 0x2c, 0x00, 0x49, 0x44, 0x67, 0x8c, 0xec, 0x68, 0x2e, 0x69, 0x6a, 0x06,
+	0x33, 0x64, 0x30, 0x1f, 0x84, 0x0c, 0xf9, 0xd8, 0xe1, 0x49, 0xda, 0x28, 0x28, 0xef, 0xcc, 0xa7,
+	0x28, 0xfa, 0xe5, 0x02, 0x91, 0xa8, 0x72, 0x4f, 0x30, 0xbc, 0x8d, 0x86, 0xca, 0xdc, 0xda, 0x61,
+	0x76, 0xc2, 0x98, 0x19, 0xc8, 0x8e, 0xe6, 0x7e, 0x33, 0x75, 0x9d, 0xcc, 0xa0, 0x4e, 0x26, 0xd4,
+	0xc9, 0x5c, 0xe3, 0xae, 0x97, 0x5f, 0x39, 0xfa, 0x9c, 0x8e, 0xbc, 0x39, 0x49, 0x67, 0x1d, 0x57,
+	0x6e, 0xfb, 0x25, 0xd3, 0xe2, 0x15, 0x02, 0x45, 0xd5, 0xff, 0xfe, 0x16, 0xf6, 0x0e, 0x91, 0x7b,
+	0x55, 0x26, 0xd4, 0x05, 0xf1, 0xfa, 0xfc, 0xdd, 0x82, 0x51, 0x00, 0x7c, 0x5c, 0x46, 0x71, 0xdf,
+	0x0b, 0x6a, 0xca, 0xec, 0x44, 0xb4, 0x4f, 0x5c, 0x0d, 0x86, 0x20, 0x2f, 0xe0, 0x1a, 0xe8, 0x57,
+	0x5e, 0x1a, 0x3f, 0xf3, 0xd2, 0x40, 0xbf, 0xab, 0xda, 0x6e, 0x6a, 0xbd, 0xac, 0x5a, 0x16, 0xf7,
+	0x3d, 0x29, 0xf2, 0x7b, 0xeb, 0xbe, 0x67, 0xb3, 0x5a, 0xd8, 0xd3, 0x3f, 0xd0, 0xf8, 0x96, 0x3a,
+	0x28, 0xb6, 0xb7, 0x76, 0x4c, 0x9f, 0xae, 0x42, 0x83, 0xd7, 0x11, 0x6a, 0x6a, 0x17, 0xda, 0x3c,
+	0xd7, 0x16, 0xbc, 0x1e, 0x93, 0x30, 0x85, 0x7b, 0xd4, 0x61, 0x40, 0x51, 0x68, 0xb9, 0x99, 0x79,
+	0x65, 0xa0, 0xd9, 0xab, 0xc3, 0x02, 0x05, 0xfc, 0x89, 0x26, 0x41, 0xe9, 0x61, 0x60, 0x4c, 0x28,
+	0x31, 0x8c, 0x14, 0x26, 0xc0, 0xb0, 0x1a, 0x9e, 0xe3, 0x3b, 0x97, 0x44, 0x37, 0xdf, 0x33, 0x3a,
+	0xcd, 0xd4, 0x16, 0xde, 0x22, 0x28, 0x7f, 0xc3, 0xda, 0x66, 0xb6, 0x5f, 0x66, 0x3d, 0x95, 0x9f,
+	0x79, 0x1e, 0x43, 0x63, 0xa1, 0xf7, 0xed, 0x3a, 0xf3, 0x24, 0xfe, 0x0f, 0x66, 0xc1, 0xe8, 0x39,
+	0x0b, 0xf1, 0xa0, 0xc5, 0xcd, 0x79, 0xd0, 0x5a, 0x04, 0xdd, 0xf7, 0x51, 0x8b, 0xa0, 0xfc, 0x1f,
+	0xa6, 0x45, 0xfc, 0x18, 0x8d, 0x4b, 0x2e, 0x69, 0xb9, 0xd8, 0xc8, 0x2e, 0xd6, 0x27, 0xc6, 0x31,
+	0xc5, 0xf3, 0x30, 0x4c, 0x51, 0xa0, 0x9f, 0x34, 0x31, 0x24, 0x3a, 0xd8, 0x27, 0xda, 0x51, 0xc5,
+	0xb2, 0xa9, 0x27, 0xef, 0x6d, 0xb8, 0xd5, 0x9a, 0x22, 0x02, 0x4d, 0xaf, 0x21, 0x24, 0x24, 0xad,
+	0xc9, 0xe2, 0xb5, 0xf5, 0x31, 0xa2, 0xee, 0x05, 0x16, 0x7c, 0x13, 0xc5, 0x99, 0x67, 0x17, 0xbf,
+	0x73, 0xdd, 0x36, 0x21, 0x86, 0x99, 0x67, 0x2b, 0x80, 0x1b, 0x68, 0x88, 0x05, 0x42, 0x15, 0xd0,
+	0xf7, 0xb4, 0x79, 0xf1, 0x51, 0x33, 0xdb, 0x04, 0x9d, 0x8f, 0x05, 0x18, 0x05, 0xb8, 0x84, 0xef,
+	0xa2, 0x9f, 0xc3, 0xc1, 0x14, 0xb2, 0xc6, 0x68, 0x45, 0x24, 0x62, 0xdd, 0x70, 0x60, 0xc8, 0x37,
+	0x94, 0x1f, 0xe0, 0x8c, 0xd7, 0x5b, 0x0f, 0x45, 0xee, 0x7c, 0x00, 0x0d, 0xaa, 0x72, 0xe1, 0x67,
+	0x06, 0x8a, 0x87, 0x2f, 0x01, 0x9e, 0xeb, 0x44, 0xbb, 0xec, 0x4d, 0x4a, 0xce, 0xf7, 0xf4, 0xd3,
+	0xc5, 0xcf, 0xfc, 0xf5, 0xf4, 0xc3, 0xd7, 0x17, 0xd1, 0x39, 0x3c, 0x4b, 0x3a, 0x9e, 0xd4, 0x12,
+	0xf8, 0x92, 0x7d, 0x98, 0xea, 0x03, 0xfc, 0xde, 0x40, 0xbf, 0x76, 0x59, 0x51, 0x78, 0xa5, 0x0b,
+	0xe5, 0xd5, 0x9b, 0x36, 0xf9, 0xef, 0x75, 0xaf, 0x41, 0xe0, 0xff, 0xab, 0xc0, 0x57, 0xf0, 0x72,
+	0x67, 0xe0, 0x7a, 0x47, 0x0b, 0xb2, 0xdf, 0xbe, 0xc2, 0x0f, 0x08, 0x05, 0x30, 0x55, 0xd3, 0xb0,
+	0x9b, 0x5d, 0x6b, 0x7a, 0x61, 0xdb, 0x25, 0xe7, 0x7b, 0xfa, 0xf5, 0xae, 0xa9, 0x00, 0xdf, 0x66,
+	0x4d, 0xf3, 0xb7, 0x8e, 0x4e, 0x53, 0xc6, 0xf1, 0x69, 0xca, 0xf8, 0x72, 0x9a, 0x32, 0x0e, 0xcf,
+	0x52, 0x91, 0xe3, 0xb3, 0x54, 0xe4, 0xe3, 0x59, 0x2a, 0xf2, 0x68, 0xa1, 0x65, 0xdc, 0x34, 0x12,
+	0xe0, 0xe5, 0x16, 0xc9, 0x93, 0x06, 0xaa, 0x1a, 0xbb, 0xd2, 0x90, 0x52, 0xf9, 0xf2, 0xb7, 0x01,
+	0x00, 0x53, 0x4c, 0xe2, 0x76, 0xa9, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,6 +557,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// VestingAccountsByFunder retrieves the addresses of the clawback vesting
+	// accounts funded by the given funder
+	VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error)
+	// Schedule retrieves the unlock and vesting timeline of a clawback vesting
+	// account
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -212,10 +582,34 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) VestingAccountsByFunder(ctx context.Context, in *QueryVestingAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryVestingAccountsByFunderResponse, error) {
+	out := new(QueryVestingAccountsByFunderResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/VestingAccountsByFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/Schedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// VestingAccountsByFunder retrieves the addresses of the clawback vesting
+	// accounts funded by the given funder
+	VestingAccountsByFunder(context.Context, *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error)
+	// Schedule retrieves the unlock and vesting timeline of a clawback vesting
+	// account
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -225,6 +619,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (*UnimplementedQueryServer) VestingAccountsByFunder(ctx context.Context, req *QueryVestingAccountsByFunderRequest) (*QueryVestingAccountsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountsByFunder not implemented")
+}
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -248,6 +648,42 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingAccountsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/VestingAccountsByFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccountsByFunder(ctx, req.(*QueryVestingAccountsByFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -256,6 +692,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "VestingAccountsByFunder",
+			Handler:    _Query_VestingAccountsByFunder_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/query.proto",
@@ -281,6 +725,16 @@ func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -356,27 +810,301 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryVestingAccountsByFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryVestingAccountsByFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsByFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsByFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsByFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingAddresses) > 0 {
+		for iNdEx := len(m.VestingAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VestingAddresses[iNdEx])
+			copy(dAtA[i:], m.VestingAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalVested) > 0 {
+		for iNdEx := len(m.TotalVested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalVested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalUnlocked) > 0 {
+		for iNdEx := len(m.TotalUnlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalUnlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unlocked) > 0 {
+		for iNdEx := len(m.Unlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingStreams) > 0 {
+		for iNdEx := len(m.VestingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -404,16 +1132,631 @@ func (m *QueryBalancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryVestingAccountsByFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsByFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingAddresses) > 0 {
+		for _, s := range m.VestingAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unlocked) > 0 {
+		for _, e := range m.Unlocked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalUnlocked) > 0 {
+		for _, e := range m.TotalUnlocked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalVested) > 0 {
+		for _, e := range m.TotalVested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingStreams) > 0 {
+		for _, e := range m.VestingStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountsByFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountsByFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsByFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddresses = append(m.VestingAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -436,10 +1779,10 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -495,7 +1838,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,15 +1861,15 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -553,14 +1896,13 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locked = append(m.Locked, types.Coin{})
-			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -587,8 +1929,8 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unvested = append(m.Unvested, types.Coin{})
-			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unlocked = append(m.Unlocked, types.Coin{})
+			if err := m.Unlocked[len(m.Unlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -626,6 +1968,258 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnlocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalUnlocked = append(m.TotalUnlocked, types.Coin{})
+			if err := m.TotalUnlocked[len(m.TotalUnlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalVested = append(m.TotalVested, types.Coin{})
+			if err := m.TotalVested[len(m.TotalVested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, ScheduleEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingStreams = append(m.VestingStreams, VestingStream{})
+			if err := m.VestingStreams[len(m.VestingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,192 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// SecondsPerMonth is the length of a month tranche, defined as a twelfth
+	// of the average length of a year (365.25 days).
+	SecondsPerMonth int64 = 2_629_800
+	// SecondsPerQuarter is the length of a quarter tranche.
+	SecondsPerQuarter = 3 * SecondsPerMonth
+)
+
+// IntervalLength returns the length in seconds of the tranches of the given
+// interval, or zero for the continuous and unspecified intervals.
+func IntervalLength(interval ScheduleInterval) int64 {
+	switch interval {
+	case SCHEDULE_INTERVAL_MONTHLY:
+		return SecondsPerMonth
+	case SCHEDULE_INTERVAL_QUARTERLY:
+		return SecondsPerQuarter
+	default:
+		return 0
+	}
+}
+
+// NewScheduleTemplate creates a new ScheduleTemplate instance
+func NewScheduleTemplate(interval ScheduleInterval, cliff, duration int64, amount sdk.Coins) *ScheduleTemplate {
+	return &ScheduleTemplate{
+		Interval: interval,
+		Cliff:    cliff,
+		Duration: duration,
+		Amount:   amount,
+	}
+}
+
+// IsContinuous returns true if the template streams the coins every second.
+func (t ScheduleTemplate) IsContinuous() bool {
+	return t.Interval == SCHEDULE_INTERVAL_CONTINUOUS
+}
+
+// Validate performs a stateless validation of the schedule template.
+func (t ScheduleTemplate) Validate() error {
+	if _, ok := ScheduleInterval_name[int32(t.Interval)]; !ok || t.Interval == SCHEDULE_INTERVAL_UNSPECIFIED {
+		return fmt.Errorf("invalid schedule interval: %s", t.Interval)
+	}
+
+	if t.Duration < 1 {
+		return fmt.Errorf("invalid duration of %d, duration must be greater than 0", t.Duration)
+	}
+
+	if t.Cliff < 0 || t.Cliff > t.Duration {
+		return fmt.Errorf("invalid cliff of %d, cliff must be between 0 and the duration %d", t.Cliff, t.Duration)
+	}
+
+	if length := IntervalLength(t.Interval); length > 0 && t.Duration%length != 0 {
+		return fmt.Errorf("duration %d must be a multiple of the %s interval length %d", t.Duration, t.Interval, length)
+	}
+
+	if !t.Amount.IsValid() || t.Amount.IsZero() {
+		return fmt.Errorf("invalid schedule amount: %s", t.Amount)
+	}
+
+	return nil
+}
+
+// Periods expands the tranches of the template into periods relative to the
+// start time. The coins of the tranches before the cliff are released in a
+// single period ending at the cliff. It returns no periods for the
+// continuous interval.
+func (t ScheduleTemplate) Periods() sdkvesting.Periods {
+	length := IntervalLength(t.Interval)
+	if length == 0 {
+		return nil
+	}
+
+	var (
+		periods  = sdkvesting.Periods{}
+		count    = t.Duration / length
+		lastTime = int64(0)
+		released = sdk.Coins{}
+	)
+
+	// emit appends a period releasing the cumulative coins of the first k
+	// tranches at the given time, skipping the periods without coins
+	emit := func(time, k int64) {
+		cumulative := linearCoins(t.Amount, k, count)
+		amount := cumulative.Sub(released...)
+		if amount.IsZero() {
+			return
+		}
+		periods = append(periods, sdkvesting.Period{Length: time - lastTime, Amount: amount})
+		lastTime = time
+		released = cumulative
+	}
+
+	cliffTranches := t.Cliff / length
+	if t.Cliff > 0 {
+		emit(t.Cliff, cliffTranches)
+	}
+
+	for k := cliffTranches + 1; k <= count; k++ {
+		emit(k*length, k)
+	}
+
+	return periods
+}
+
+// Stream returns the vesting stream of the template for the given start time.
+func (t ScheduleTemplate) Stream(startTime int64) VestingStream {
+	return VestingStream{
+		StartTime: startTime,
+		Cliff:     t.Cliff,
+		Duration:  t.Duration,
+		Amount:    t.Amount,
+	}
+}
+
+// EndTime returns the unix time at which all the coins of the stream are
+// vested.
+func (s VestingStream) EndTime() int64 {
+	return s.StartTime + s.Duration
+}
+
+// Validate performs a stateless validation of the vesting stream.
+func (s VestingStream) Validate() error {
+	if s.Duration < 1 {
+		return fmt.Errorf("invalid stream duration of %d, duration must be greater than 0", s.Duration)
+	}
+
+	if s.Cliff < 0 || s.Cliff > s.Duration {
+		return fmt.Errorf("invalid stream cliff of %d, cliff must be between 0 and the duration %d", s.Cliff, s.Duration)
+	}
+
+	if !s.Amount.IsValid() {
+		return fmt.Errorf("invalid stream amount: %s", s.Amount)
+	}
+
+	return nil
+}
+
+// ReadStream returns the coins of the stream vested at readTime.
+func (s VestingStream) ReadStream(readTime int64) sdk.Coins {
+	elapsed := readTime - s.StartTime
+	switch {
+	case elapsed <= 0 || elapsed < s.Cliff:
+		return sdk.Coins{}
+	case elapsed >= s.Duration:
+		return s.Amount
+	default:
+		return linearCoins(s.Amount, elapsed, s.Duration)
+	}
+}
+
+// ReadStreams returns the total coins of the streams vested at readTime.
+func ReadStreams(streams []VestingStream, readTime int64) sdk.Coins {
+	coins := sdk.Coins{}
+	for _, s := range streams {
+		coins = coins.Add(s.ReadStream(readTime)...)
+	}
+	return coins
+}
+
+// StreamsTotalAmount returns the total coins of the streams.
+func StreamsTotalAmount(streams []VestingStream) sdk.Coins {
+	coins := sdk.Coins{}
+	for _, s := range streams {
+		coins = coins.Add(s.Amount...)
+	}
+	return coins
+}
+
+// linearCoins returns the share numerator/denominator of the coins,
+// truncating the amounts.
+func linearCoins(coins sdk.Coins, numerator, denominator int64) sdk.Coins {
+	res := sdk.Coins{}
+	for _, coin := range coins {
+		amount := coin.Amount.Mul(math.NewInt(numerator)).Quo(math.NewInt(denominator))
+		if amount.IsPositive() {
+			res = res.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return res
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (suite *ScheduleTestSuite) TestScheduleTemplateValidate() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	testCases := []struct {
+		name     string
+		template *ScheduleTemplate
+		expError bool
+	}{
+		{"valid monthly", NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, SecondsPerMonth, 4*SecondsPerMonth, coins), false},
+		{"valid quarterly", NewScheduleTemplate(SCHEDULE_INTERVAL_QUARTERLY, 0, 4*SecondsPerQuarter, coins), false},
+		{"valid continuous", NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 0, 1, coins), false},
+		{"unspecified interval", NewScheduleTemplate(SCHEDULE_INTERVAL_UNSPECIFIED, 0, 100, coins), true},
+		{"unknown interval", NewScheduleTemplate(ScheduleInterval(9), 0, 100, coins), true},
+		{"zero duration", NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 0, 0, coins), true},
+		{"negative cliff", NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, -1, 100, coins), true},
+		{"cliff after duration", NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 101, 100, coins), true},
+		{"duration not multiple of interval", NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, 0, SecondsPerMonth+1, coins), true},
+		{"zero amount", NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 0, 100, sdk.Coins{}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.template.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ScheduleTestSuite) TestScheduleTemplatePeriods() {
+	testCases := []struct {
+		name       string
+		template   *ScheduleTemplate
+		expPeriods sdkvesting.Periods
+	}{
+		{
+			name:       "continuous",
+			template:   NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 0, 100, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			expPeriods: nil,
+		},
+		{
+			name:     "monthly without cliff",
+			template: NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, 0, 3*SecondsPerMonth, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			expPeriods: sdkvesting.Periods{
+				period(SecondsPerMonth, 33),
+				period(SecondsPerMonth, 33),
+				period(SecondsPerMonth, 34),
+			},
+		},
+		{
+			name:     "monthly with cliff on a tranche",
+			template: NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, 2*SecondsPerMonth, 4*SecondsPerMonth, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			expPeriods: sdkvesting.Periods{
+				period(2*SecondsPerMonth, 50),
+				period(SecondsPerMonth, 25),
+				period(SecondsPerMonth, 25),
+			},
+		},
+		{
+			name:     "quarterly with cliff between tranches",
+			template: NewScheduleTemplate(SCHEDULE_INTERVAL_QUARTERLY, SecondsPerQuarter+10, 2*SecondsPerQuarter, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			expPeriods: sdkvesting.Periods{
+				period(SecondsPerQuarter+10, 50),
+				period(SecondsPerQuarter-10, 50),
+			},
+		},
+		{
+			name:     "cliff at the end",
+			template: NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, 2*SecondsPerMonth, 2*SecondsPerMonth, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			expPeriods: sdkvesting.Periods{
+				period(2*SecondsPerMonth, 100),
+			},
+		},
+		{
+			name:     "tranches without coins are merged",
+			template: NewScheduleTemplate(SCHEDULE_INTERVAL_MONTHLY, 0, 4*SecondsPerMonth, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))),
+			expPeriods: sdkvesting.Periods{
+				period(2*SecondsPerMonth, 1),
+				period(2*SecondsPerMonth, 1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			periods := tc.template.Periods()
+			suite.Require().Equal(tc.expPeriods, periods)
+			if periods != nil {
+				suite.Require().Equal(tc.template.Duration, periods.TotalLength())
+				suite.Require().Equal(tc.template.Amount, periods.TotalAmount())
+			}
+		})
+	}
+}
+
+func (suite *ScheduleTestSuite) TestReadStream() {
+	stream := NewScheduleTemplate(
+		SCHEDULE_INTERVAL_CONTINUOUS, 100, 1000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000)),
+	).Stream(500)
+
+	testCases := []struct {
+		name      string
+		readTime  int64
+		expAmount sdk.Coins
+	}{
+		{"before start", 400, sdk.Coins{}},
+		{"before cliff", 599, sdk.Coins{}},
+		{"at cliff", 600, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))},
+		{"streaming", 1001, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1503))},
+		{"at end", 1500, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000))},
+		{"after end", 2000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000))},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expAmount, stream.ReadStream(tc.readTime), tc.name)
+	}
+}
+
+func (suite *ScheduleTestSuite) TestClawbackAccountWithStream() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	startTime := int64(1000)
+	stream := NewScheduleTemplate(SCHEDULE_INTERVAL_CONTINUOUS, 0, 1000, coins).Stream(startTime)
+
+	acc := &ClawbackVestingAccount{
+		BaseVestingAccount: &sdkvesting.BaseVestingAccount{
+			BaseAccount: authtypes.NewBaseAccountWithAddress(sdk.AccAddress("test_address")),
+		},
+	}
+
+	// add a vesting grant with an instant unlock and a stream of half the coins
+	periodCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	err := acc.AddGrant(
+		startTime,
+		sdkvesting.Periods{{Length: 0, Amount: coins.Add(periodCoins...)}},
+		sdkvesting.Periods{{Length: 2000, Amount: periodCoins}},
+		[]VestingStream{stream},
+		coins.Add(periodCoins...),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(startTime+2000, acc.EndTime)
+
+	suite.Require().True(acc.GetVestedCoins(time.Unix(startTime, 0)).IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), acc.GetVestedCoins(time.Unix(startTime+250, 0)))
+	suite.Require().Equal(coins, acc.GetVestedCoins(time.Unix(startTime+1500, 0)))
+	suite.Require().Equal(coins.Add(periodCoins...), acc.GetVestedCoins(time.Unix(startTime+2000, 0)))
+
+	// the clawback stops the stream and removes the unvested period
+	clawedBackAcc, clawedBack := acc.ComputeClawback(startTime + 400)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1600)), clawedBack)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)), clawedBackAcc.OriginalVesting)
+	suite.Require().Len(clawedBackAcc.VestingStreams, 1)
+	suite.Require().Empty(clawedBackAcc.VestingPeriods)
+	suite.Require().Equal(startTime+400, clawedBackAcc.EndTime)
+	suite.Require().Equal(clawedBackAcc.OriginalVesting, clawedBackAcc.GetVestedCoins(time.Unix(startTime+400, 0)))
+	suite.Require().NoError(clawedBackAcc.Validate())
+}
@@ -157,6 +157,12 @@ type MsgFundVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// lockup_template defines the unlocking schedule relative to the start_time
+	// as a template, instead of the lockup_periods
+	LockupTemplate *ScheduleTemplate `protobuf:"bytes,6,opt,name=lockup_template,json=lockupTemplate,proto3" json:"lockup_template,omitempty"`
+	// vesting_template defines the vesting schedule relative to the start_time
+	// as a template, instead of the vesting_periods
+	VestingTemplate *ScheduleTemplate `protobuf:"bytes,7,opt,name=vesting_template,json=vestingTemplate,proto3" json:"vesting_template,omitempty"`
 }
 
 func (m *MsgFundVestingAccount) Reset()         { *m = MsgFundVestingAccount{} }
@@ -227,6 +233,20 @@ func (m *MsgFundVestingAccount) GetVestingPeriods() github_com_cosmos_cosmos_sdk
 	return nil
 }
 
+func (m *MsgFundVestingAccount) GetLockupTemplate() *ScheduleTemplate {
+	if m != nil {
+		return m.LockupTemplate
+	}
+	return nil
+}
+
+func (m *MsgFundVestingAccount) GetVestingTemplate() *ScheduleTemplate {
+	if m != nil {
+		return m.VestingTemplate
+	}
+	return nil
+}
+
 // MsgFundVestingAccountResponse defines the
 // MsgFundVestingAccount response type.
 type MsgFundVestingAccountResponse struct {
@@ -565,6 +585,132 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// MsgUpdateVestingSchedule defines a message that enables the funder of a
+// ClawbackVestingAccount to top up, extend the lockup of or accelerate the
+// vesting of the account. The vested coins of the account can never decrease
+// and the coins added to the schedules are sent from the funder.
+type MsgUpdateVestingSchedule struct {
+	// funder_address is the funder address of the ClawbackVestingAccount
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount being updated
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// lockup_periods defines the new unlocking schedule relative to the start
+	// time of the account. The unlocking schedule is not updated if empty.
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the new vesting schedule relative to the start
+	// time of the account, in addition to the vesting streams of the account.
+	// The vesting schedule is not updated if empty.
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *MsgUpdateVestingSchedule) Reset()         { *m = MsgUpdateVestingSchedule{} }
+func (m *MsgUpdateVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingSchedule) ProtoMessage()    {}
+func (*MsgUpdateVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{10}
+}
+func (m *MsgUpdateVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingSchedule.Merge(m, src)
+}
+func (m *MsgUpdateVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateVestingSchedule) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingSchedule) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingSchedule) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgUpdateVestingSchedule) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgUpdateVestingScheduleResponse defines the MsgUpdateVestingSchedule
+// response type.
+type MsgUpdateVestingScheduleResponse struct {
+	// top_up is the amount of coins sent from the funder to the account
+	TopUp github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=top_up,json=topUp,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up"`
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Reset()         { *m = MsgUpdateVestingScheduleResponse{} }
+func (m *MsgUpdateVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{11}
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateVestingScheduleResponse) GetTopUp() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
@@ -576,70 +722,80 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgUpdateVestingSchedule)(nil), "evmos.vesting.v2.MsgUpdateVestingSchedule")
+	proto.RegisterType((*MsgUpdateVestingScheduleResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingScheduleResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
 
 var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6b, 0x24, 0x45,
-	0x18, 0x9e, 0xda, 0xd9, 0x2c, 0xbb, 0x15, 0x13, 0x37, 0x1d, 0x57, 0xb3, 0x6d, 0xd2, 0x33, 0x36,
-	0x86, 0x4c, 0xc6, 0xd8, 0xb5, 0xd3, 0x1b, 0x95, 0x0d, 0x5e, 0x76, 0x22, 0xd1, 0x4b, 0x40, 0x82,
-	0x7a, 0xf0, 0x32, 0xd4, 0x74, 0x57, 0x7a, 0x9b, 0x64, 0xba, 0x9a, 0xa9, 0xea, 0x4e, 0x04, 0x05,
-	0xd9, 0x93, 0x08, 0xe2, 0x82, 0x7f, 0x40, 0x0f, 0x82, 0x28, 0x42, 0x7e, 0xc6, 0x22, 0x22, 0x0b,
-	0x5e, 0x3c, 0xb9, 0x92, 0x28, 0xf1, 0x67, 0x48, 0x7d, 0x74, 0xcd, 0x3a, 0xa9, 0x7c, 0xec, 0x41,
-	0x2f, 0xf3, 0x51, 0xef, 0x53, 0xef, 0xfb, 0xd4, 0xf3, 0xbc, 0xf5, 0x16, 0xbc, 0x49, 0xca, 0x01,
-	0x65, 0xa8, 0x24, 0x8c, 0xa7, 0x59, 0x82, 0xca, 0x10, 0xf1, 0xfd, 0x20, 0x1f, 0x52, 0x4e, 0x9d,
-	0xeb, 0x32, 0x14, 0xe8, 0x50, 0x50, 0x86, 0xee, 0x0c, 0x1e, 0xa4, 0x19, 0x45, 0xf2, 0x53, 0x81,
-	0x5c, 0x2f, 0xa2, 0x4c, 0x24, 0xe8, 0x63, 0x46, 0x50, 0xd9, 0xe9, 0x13, 0x8e, 0x3b, 0x28, 0xa2,
-	0x69, 0xa6, 0xe3, 0x2f, 0xe8, 0xf8, 0x80, 0x25, 0xa8, 0xec, 0x88, 0x2f, 0x1d, 0x78, 0x59, 0x07,
-	0x4c, 0x65, 0xbd, 0xb7, 0x2a, 0xa7, 0x50, 0xcf, 0x25, 0x34, 0xa1, 0xf2, 0x27, 0x12, 0xbf, 0xf4,
-	0xea, 0x7c, 0x42, 0x69, 0xb2, 0x4b, 0x10, 0xce, 0x53, 0x84, 0xb3, 0x8c, 0x72, 0xcc, 0x53, 0x9a,
-	0x31, 0x1d, 0x6d, 0xe8, 0xa8, 0xfc, 0xd7, 0x2f, 0xb6, 0x11, 0x4f, 0x07, 0x84, 0x71, 0x3c, 0xc8,
-	0x15, 0xc0, 0xff, 0x0b, 0xc0, 0xc6, 0x26, 0x4b, 0xd6, 0x87, 0x04, 0x73, 0xb2, 0xbe, 0x8b, 0xf7,
-	0xfa, 0x38, 0xda, 0xf9, 0x40, 0xd5, 0xbd, 0x1b, 0x45, 0xb4, 0xc8, 0xb8, 0xb3, 0x08, 0xa7, 0xb7,
-	0x8b, 0x2c, 0x26, 0xc3, 0x1e, 0x8e, 0xe3, 0x21, 0x61, 0x6c, 0x0e, 0x34, 0x41, 0xeb, 0xda, 0xd6,
-	0x94, 0x5a, 0xbd, 0xab, 0x16, 0x9d, 0x25, 0xf8, 0xac, 0x26, 0x6c, 0x70, 0x97, 0x24, 0x6e, 0x5a,
-	0x2f, 0x57, 0xc0, 0x00, 0xce, 0x92, 0x0c, 0xf7, 0x77, 0x49, 0x2f, 0xa1, 0x65, 0x2f, 0xd2, 0x45,
-	0xe7, 0xea, 0x4d, 0xd0, 0xba, 0xba, 0x35, 0xa3, 0x42, 0x6f, 0xd3, 0xb2, 0x62, 0xb3, 0xd6, 0xfd,
-	0xfb, 0xeb, 0x46, 0xed, 0xfe, 0xf1, 0x41, 0x7b, 0x3c, 0xff, 0xe7, 0xc7, 0x07, 0xed, 0x45, 0x65,
-	0xda, 0x39, 0x67, 0xf0, 0x97, 0xe1, 0xd2, 0x39, 0x90, 0x2d, 0xc2, 0x72, 0x9a, 0x31, 0xe2, 0x7f,
-	0x76, 0x19, 0xde, 0xd8, 0x64, 0xc9, 0x46, 0x91, 0xc5, 0xff, 0xb1, 0x10, 0xef, 0x40, 0xc8, 0x38,
-	0x1e, 0xf2, 0x9e, 0x70, 0x45, 0x9e, 0x7f, 0x32, 0x74, 0x03, 0x65, 0x59, 0x50, 0x59, 0x16, 0xbc,
-	0x57, 0x59, 0xd6, 0x9d, 0x7a, 0xf8, 0x7b, 0xa3, 0xf6, 0xe0, 0x71, 0x03, 0x7c, 0x77, 0x7c, 0xd0,
-	0x06, 0x5b, 0xd7, 0xe4, 0x66, 0x11, 0x76, 0xbe, 0x00, 0x70, 0x7a, 0x97, 0x46, 0x3b, 0x45, 0xde,
-	0xcb, 0xc9, 0x30, 0xa5, 0x31, 0x9b, 0xbb, 0xdc, 0xac, 0xb7, 0x26, 0x43, 0x2f, 0x50, 0xbd, 0x35,
-	0x6a, 0x5d, 0xd5, 0x5b, 0xc1, 0xbb, 0x12, 0xd6, 0xdd, 0x10, 0x29, 0xbf, 0x7f, 0xdc, 0xb8, 0x93,
-	0xa4, 0xfc, 0x5e, 0xd1, 0x0f, 0x22, 0x3a, 0x40, 0xba, 0x1b, 0xd5, 0xd7, 0xab, 0x2c, 0xde, 0x41,
-	0xfb, 0x08, 0x17, 0xfc, 0x9e, 0xe9, 0x4f, 0xfe, 0x51, 0x4e, 0x98, 0xce, 0xc0, 0x14, 0x97, 0x29,
-	0x55, 0x5d, 0xaf, 0x39, 0x5f, 0x82, 0x91, 0x06, 0x15, 0xa1, 0x89, 0xff, 0x95, 0x50, 0xa5, 0xb5,
-	0x5e, 0x5c, 0xbb, 0x2d, 0x1a, 0x68, 0xcc, 0x3e, 0xd1, 0x3f, 0x2f, 0x9a, 0xfe, 0x39, 0x69, 0xb8,
-	0xdf, 0x80, 0x0b, 0xd6, 0x80, 0xe9, 0x95, 0x1f, 0x00, 0x9c, 0x14, 0x7d, 0xa5, 0x3b, 0xea, 0x29,
-	0x3a, 0x04, 0xab, 0x4c, 0xe3, 0x1d, 0xa2, 0x97, 0x2b, 0xe0, 0x4b, 0xf0, 0x99, 0x98, 0xb0, 0x11,
-	0xaa, 0x2e, 0x51, 0x93, 0x62, 0x4d, 0x43, 0xd6, 0x96, 0x4f, 0x39, 0xd8, 0xcc, 0xe8, 0x62, 0x68,
-	0x76, 0xfe, 0x27, 0x70, 0xf6, 0x89, 0xbf, 0xd5, 0x21, 0x9c, 0x6d, 0x38, 0x21, 0xa6, 0x94, 0xe0,
-	0x2a, 0x1c, 0xba, 0x59, 0x39, 0x24, 0xe6, 0x98, 0xb1, 0x67, 0x9d, 0xa6, 0x59, 0xf7, 0x35, 0x6d,
-	0x4e, 0xeb, 0x4c, 0x73, 0x94, 0x1b, 0x62, 0x83, 0xf6, 0x42, 0xa5, 0xf7, 0x7f, 0x06, 0xf0, 0xf9,
-	0x4d, 0x96, 0xbc, 0x9f, 0xc7, 0x98, 0x13, 0x2d, 0xe8, 0x86, 0xe4, 0x7d, 0x51, 0xdd, 0x56, 0xa0,
-	0x93, 0x91, 0xbd, 0xde, 0x18, 0x54, 0x49, 0x77, 0x3d, 0x23, 0x7b, 0x1b, 0xe7, 0xdd, 0xc3, 0xba,
-	0xed, 0x1e, 0xae, 0xad, 0x9e, 0x22, 0xe1, 0xbc, 0x91, 0xd0, 0xc2, 0xd9, 0x6f, 0x42, 0xcf, 0x1e,
-	0x31, 0xdd, 0xf1, 0x31, 0x9c, 0x13, 0x7a, 0xd3, 0xac, 0x24, 0x43, 0x3e, 0x36, 0x4b, 0x2c, 0xe4,
-	0x80, 0x95, 0xdc, 0x1b, 0xa7, 0x4d, 0x3e, 0x6f, 0x64, 0xb0, 0xad, 0x82, 0xef, 0xc3, 0xe6, 0x69,
-	0xb1, 0x8a, 0x61, 0xf8, 0xcb, 0x15, 0x58, 0xdf, 0x64, 0x89, 0xf3, 0x13, 0x80, 0xf3, 0x67, 0xbe,
-	0x01, 0x9d, 0x60, 0xfc, 0x05, 0x0c, 0xce, 0x99, 0xa7, 0xee, 0x9d, 0xa7, 0xde, 0x62, 0x84, 0x7b,
-	0xf3, 0xfe, 0xaf, 0x7f, 0x7e, 0x75, 0xe9, 0x75, 0x67, 0x15, 0x59, 0x9e, 0x64, 0x14, 0xc9, 0x14,
-	0xe6, 0xe1, 0xe8, 0x19, 0x6d, 0x34, 0xd7, 0x6f, 0x00, 0x74, 0x2c, 0xd3, 0x7b, 0xc9, 0xca, 0xe7,
-	0x24, 0xd0, 0x45, 0x17, 0x04, 0x1a, 0xba, 0x1d, 0x49, 0xf7, 0x15, 0x67, 0xd9, 0x4a, 0x57, 0xf4,
-	0xd5, 0x09, 0x8e, 0x7b, 0xf0, 0xaa, 0x19, 0x1a, 0x0b, 0x76, 0xa1, 0x74, 0xd8, 0x5d, 0x3c, 0x33,
-	0x6c, 0x48, 0x2c, 0x4a, 0x12, 0x0d, 0x67, 0xc1, 0xae, 0x59, 0x55, 0xec, 0x5b, 0x00, 0x67, 0x6d,
-	0x37, 0xb0, 0x65, 0xad, 0x62, 0x41, 0xba, 0xb7, 0x2e, 0x8a, 0x34, 0xd4, 0x42, 0x49, 0x6d, 0xc5,
-	0x69, 0x5b, 0xa9, 0x15, 0x72, 0xa7, 0x51, 0x48, 0x5d, 0x43, 0xe7, 0x47, 0x00, 0x6f, 0xd8, 0x6f,
-	0x4e, 0xdb, 0xae, 0x87, 0x0d, 0xeb, 0x86, 0x17, 0xc7, 0x1a, 0xb6, 0xab, 0x92, 0x6d, 0xe0, 0xac,
-	0xd8, 0x85, 0x54, 0x7b, 0xc7, 0x0d, 0x75, 0x27, 0x3e, 0x15, 0xa3, 0xae, 0xfb, 0xd6, 0xc3, 0x43,
-	0x0f, 0x3c, 0x3a, 0xf4, 0xc0, 0x1f, 0x87, 0x1e, 0x78, 0x70, 0xe4, 0xd5, 0x1e, 0x1d, 0x79, 0xb5,
-	0xdf, 0x8e, 0xbc, 0xda, 0x87, 0xed, 0x27, 0x66, 0xa6, 0x4a, 0xac, 0xd3, 0x87, 0xb7, 0xd0, 0xfe,
-	0xbf, 0x5f, 0xb2, 0xfe, 0x15, 0xf9, 0xf8, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x56, 0xd4,
-	0x08, 0x47, 0x92, 0x0a, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0xd4, 0x4d, 0xda, 0x4e, 0x7e, 0x49, 0x93, 0xcd, 0xaf, 0xe0, 0x9a, 0x64, 0x6d, 0x56,
+	0x44, 0x71, 0x4c, 0xd8, 0xad, 0xdd, 0x50, 0x54, 0x8b, 0x4b, 0x1d, 0x14, 0x90, 0x90, 0x25, 0x64,
+	0x5a, 0x0e, 0x5c, 0xac, 0xf5, 0x7a, 0xb2, 0xb1, 0x62, 0xef, 0xac, 0x3c, 0xb3, 0x4e, 0x90, 0x40,
+	0x42, 0x3d, 0x82, 0x10, 0x95, 0xf8, 0x02, 0x20, 0x81, 0x84, 0x40, 0x48, 0xf9, 0x10, 0x1c, 0x2a,
+	0xc4, 0xa1, 0x12, 0x17, 0x4e, 0x14, 0x25, 0xa0, 0xf0, 0x29, 0x10, 0x9a, 0xbf, 0x6e, 0xed, 0x71,
+	0xec, 0x4a, 0x14, 0xb8, 0x78, 0x77, 0xe7, 0x7d, 0xe6, 0x7d, 0x9f, 0x79, 0xe6, 0x99, 0xd7, 0x03,
+	0xaf, 0xa2, 0x7e, 0x17, 0x13, 0xaf, 0x8f, 0x08, 0x6d, 0x47, 0xa1, 0xd7, 0x2f, 0x7b, 0xf4, 0xd0,
+	0x8d, 0x7b, 0x98, 0x62, 0x6b, 0x91, 0x87, 0x5c, 0x19, 0x72, 0xfb, 0xe5, 0xec, 0x92, 0xdf, 0x6d,
+	0x47, 0xd8, 0xe3, 0xbf, 0x02, 0x94, 0xb5, 0x03, 0x4c, 0x58, 0x82, 0xa6, 0x4f, 0x90, 0xd7, 0x2f,
+	0x35, 0x11, 0xf5, 0x4b, 0x5e, 0x80, 0xdb, 0x91, 0x8c, 0x3f, 0x2b, 0xe3, 0x5d, 0x12, 0x7a, 0xfd,
+	0x12, 0x7b, 0xc8, 0xc0, 0x0b, 0x32, 0xa0, 0x2b, 0xcb, 0xb9, 0xaa, 0x9c, 0x4c, 0x3f, 0x42, 0xef,
+	0xf1, 0xf8, 0xff, 0x43, 0x1c, 0x62, 0xfe, 0xea, 0xb1, 0x37, 0x39, 0xba, 0x12, 0x62, 0x1c, 0x76,
+	0x90, 0xe7, 0xc7, 0x6d, 0xcf, 0x8f, 0x22, 0x4c, 0x7d, 0xda, 0xc6, 0x11, 0x91, 0xd1, 0x9c, 0x8c,
+	0xf2, 0xaf, 0x66, 0xb2, 0xeb, 0xd1, 0x76, 0x17, 0x11, 0xea, 0x77, 0x63, 0x01, 0x70, 0x7e, 0x07,
+	0x30, 0x57, 0x23, 0xe1, 0x76, 0x0f, 0xf9, 0x14, 0x6d, 0x77, 0xfc, 0x83, 0xa6, 0x1f, 0xec, 0xbf,
+	0x23, 0xea, 0xde, 0x0a, 0x02, 0x9c, 0x44, 0xd4, 0x5a, 0x83, 0x0b, 0xbb, 0x49, 0xd4, 0x42, 0xbd,
+	0x86, 0xdf, 0x6a, 0xf5, 0x10, 0x21, 0x19, 0x90, 0x07, 0x85, 0x4b, 0xf5, 0x79, 0x31, 0x7a, 0x4b,
+	0x0c, 0x5a, 0xeb, 0xf0, 0xb2, 0x24, 0xac, 0x71, 0xe7, 0x38, 0x6e, 0x41, 0x0e, 0x2b, 0xa0, 0x0b,
+	0x97, 0x51, 0xe4, 0x37, 0x3b, 0xa8, 0x11, 0xe2, 0x7e, 0x23, 0x90, 0x45, 0x33, 0xe9, 0x3c, 0x28,
+	0x5c, 0xac, 0x2f, 0x89, 0xd0, 0xeb, 0xb8, 0xaf, 0xd8, 0x54, 0xaa, 0x7f, 0x7c, 0x9e, 0x4b, 0xdd,
+	0x3d, 0x3d, 0x2a, 0x0e, 0xe7, 0xff, 0xe8, 0xf4, 0xa8, 0xb8, 0x26, 0x54, 0x9b, 0xb0, 0x06, 0x67,
+	0x03, 0xae, 0x4f, 0x80, 0xd4, 0x11, 0x89, 0x71, 0x44, 0x90, 0xf3, 0xe5, 0x0c, 0xbc, 0x52, 0x23,
+	0xe1, 0x4e, 0x12, 0xb5, 0x9e, 0xb2, 0x10, 0x6f, 0x40, 0x48, 0xa8, 0xdf, 0xa3, 0x0d, 0xb6, 0x2b,
+	0x7c, 0xfd, 0x73, 0xe5, 0xac, 0x2b, 0xb6, 0xcc, 0x55, 0x5b, 0xe6, 0xde, 0x56, 0x5b, 0x56, 0x9d,
+	0xbf, 0xff, 0x4b, 0x2e, 0x75, 0xef, 0x61, 0x0e, 0x7c, 0x7d, 0x7a, 0x54, 0x04, 0xf5, 0x4b, 0x7c,
+	0x32, 0x0b, 0x5b, 0x9f, 0x00, 0xb8, 0xd0, 0xc1, 0xc1, 0x7e, 0x12, 0x37, 0x62, 0xd4, 0x6b, 0xe3,
+	0x16, 0xc9, 0x9c, 0xcf, 0xa7, 0x0b, 0x73, 0x65, 0xdb, 0x15, 0xde, 0x1b, 0x58, 0x5b, 0x78, 0xcf,
+	0x7d, 0x8b, 0xc3, 0xaa, 0x3b, 0x2c, 0xe5, 0x37, 0x0f, 0x73, 0x37, 0xc3, 0x36, 0xdd, 0x4b, 0x9a,
+	0x6e, 0x80, 0xbb, 0x9e, 0x74, 0xab, 0x78, 0xbc, 0x44, 0x5a, 0xfb, 0xde, 0xa1, 0xe7, 0x27, 0x74,
+	0x4f, 0x5b, 0x93, 0xbe, 0x17, 0x23, 0x22, 0x33, 0x10, 0xc1, 0x65, 0x5e, 0x54, 0x97, 0x63, 0xd6,
+	0xa7, 0x60, 0xa0, 0x81, 0x22, 0x34, 0xf3, 0x8f, 0x12, 0x52, 0x5a, 0x2b, 0x46, 0x6f, 0xc2, 0xcb,
+	0x52, 0x20, 0x8a, 0xba, 0x71, 0xc7, 0xa7, 0x28, 0x33, 0xcb, 0x05, 0x77, 0xdc, 0xe1, 0xb3, 0xef,
+	0xbe, 0x1d, 0xec, 0xa1, 0x56, 0xd2, 0x41, 0xb7, 0x25, 0xb2, 0x2e, 0xb5, 0x55, 0xdf, 0x56, 0x0d,
+	0x2e, 0xaa, 0xd5, 0xe9, 0x6c, 0x17, 0xa6, 0xce, 0xa6, 0x94, 0x51, 0x03, 0x95, 0xeb, 0xcc, 0xdc,
+	0x43, 0xd6, 0x62, 0xde, 0x7e, 0x4e, 0x7b, 0x7b, 0xd4, 0x8c, 0x4e, 0x0e, 0xae, 0x1a, 0x03, 0xda,
+	0xc7, 0xdf, 0x02, 0x38, 0xc7, 0x3c, 0x2f, 0xdd, 0xfe, 0x04, 0xee, 0xf5, 0x45, 0xa6, 0x61, 0xf7,
+	0xca, 0x61, 0x05, 0x7c, 0x1e, 0xfe, 0xaf, 0x85, 0xc8, 0x00, 0x95, 0xe6, 0xa8, 0x39, 0x36, 0x26,
+	0x21, 0x95, 0x8d, 0x31, 0x0b, 0x5b, 0x1a, 0x1c, 0x5a, 0xc9, 0xce, 0xf9, 0x00, 0x2e, 0x3f, 0xf2,
+	0xa9, 0x16, 0x61, 0xed, 0xc2, 0x19, 0xd6, 0x61, 0x19, 0x57, 0xe6, 0x9e, 0xab, 0xca, 0x3d, 0xac,
+	0x07, 0x6b, 0xeb, 0x6c, 0xe3, 0x76, 0x54, 0x7d, 0x59, 0x1a, 0xa7, 0x70, 0xa6, 0x71, 0x84, 0x53,
+	0xd8, 0x04, 0xe9, 0x13, 0x91, 0xde, 0xf9, 0x11, 0xc0, 0x67, 0x6a, 0x24, 0xbc, 0x13, 0xb7, 0x7c,
+	0x8a, 0xa4, 0xa0, 0x3b, 0x9c, 0xf7, 0xb4, 0xba, 0x6d, 0x42, 0x2b, 0x42, 0x07, 0x8d, 0x21, 0xa8,
+	0x90, 0x6e, 0x31, 0x42, 0x07, 0x3b, 0x93, 0x7a, 0x44, 0xda, 0xd4, 0x23, 0x2a, 0x5b, 0x63, 0x24,
+	0x5c, 0xd1, 0x12, 0x1a, 0x38, 0x3b, 0x79, 0x68, 0x9b, 0x23, 0xda, 0x1d, 0xef, 0xc3, 0x0c, 0xd3,
+	0x1b, 0x47, 0x7d, 0xd4, 0xa3, 0x43, 0x7d, 0xce, 0x40, 0x0e, 0x18, 0xc9, 0xbd, 0x32, 0xae, 0x2b,
+	0xdb, 0x83, 0x0d, 0x36, 0x55, 0x70, 0x1c, 0x98, 0x1f, 0x17, 0xd3, 0x0c, 0xbf, 0x4f, 0xc3, 0xcc,
+	0xf0, 0x22, 0xd4, 0x59, 0xfa, 0xdb, 0x5b, 0xb1, 0xa1, 0x81, 0xa6, 0xff, 0x6b, 0x0d, 0xf4, 0xfc,
+	0xbf, 0xd9, 0x40, 0x2b, 0x37, 0xc6, 0x18, 0xd1, 0x36, 0x1b, 0x51, 0xed, 0x94, 0xf3, 0x31, 0x80,
+	0xf9, 0x71, 0x41, 0x7d, 0xcc, 0x43, 0x38, 0x4b, 0x71, 0xdc, 0x48, 0xe2, 0xa7, 0x77, 0xce, 0x29,
+	0x8e, 0xef, 0xc4, 0xe5, 0x3f, 0x2f, 0xc0, 0x74, 0x8d, 0x84, 0xd6, 0x0f, 0x00, 0xae, 0x9c, 0x79,
+	0xe9, 0x29, 0x8d, 0x36, 0xf2, 0x09, 0x17, 0x88, 0xec, 0xcd, 0x27, 0x9e, 0xa2, 0xbd, 0xfe, 0xea,
+	0xdd, 0x9f, 0x7e, 0xfb, 0xec, 0xdc, 0x0d, 0x6b, 0xcb, 0x33, 0xdc, 0x51, 0xbd, 0x80, 0xa7, 0xd0,
+	0x37, 0xa5, 0x86, 0xb6, 0xb4, 0xe4, 0xfa, 0x05, 0x80, 0x96, 0xe1, 0xba, 0xb2, 0x6e, 0xe4, 0x33,
+	0x0a, 0xcc, 0x7a, 0x53, 0x02, 0x35, 0xdd, 0x12, 0xa7, 0xfb, 0xa2, 0xb5, 0x61, 0xa4, 0xcb, 0x3c,
+	0x32, 0xc2, 0xf1, 0x00, 0x5e, 0xd4, 0xff, 0x44, 0xab, 0x66, 0xa1, 0x64, 0x38, 0xbb, 0x76, 0x66,
+	0x58, 0x93, 0x58, 0xe3, 0x24, 0x72, 0xd6, 0xaa, 0x59, 0x33, 0x55, 0xec, 0x2b, 0x00, 0x97, 0x4d,
+	0x6d, 0xbd, 0x60, 0xac, 0x62, 0x40, 0x66, 0xaf, 0x4d, 0x8b, 0xd4, 0xd4, 0xca, 0x9c, 0xda, 0xa6,
+	0x55, 0x34, 0x52, 0x4b, 0xf8, 0x4c, 0xad, 0x90, 0x38, 0x52, 0xd6, 0x77, 0x00, 0x5e, 0x31, 0xb7,
+	0xe3, 0xa2, 0x59, 0x0f, 0x13, 0x36, 0x5b, 0x9e, 0x1e, 0xab, 0xd9, 0x6e, 0x71, 0xb6, 0xae, 0xb5,
+	0x69, 0x16, 0x52, 0xcc, 0x1d, 0xd9, 0x50, 0xc6, 0xd7, 0xdc, 0x9b, 0x8b, 0x93, 0xf5, 0x52, 0xd8,
+	0x6c, 0x79, 0x7a, 0xec, 0x94, 0x7c, 0x87, 0xd4, 0x25, 0xaa, 0xd2, 0xcc, 0x87, 0xac, 0x0f, 0x54,
+	0x5f, 0xbb, 0x7f, 0x6c, 0x83, 0x07, 0xc7, 0x36, 0xf8, 0xf5, 0xd8, 0x06, 0xf7, 0x4e, 0xec, 0xd4,
+	0x83, 0x13, 0x3b, 0xf5, 0xf3, 0x89, 0x9d, 0x7a, 0xb7, 0xf8, 0x48, 0x43, 0x11, 0x89, 0x65, 0xfa,
+	0xf2, 0x35, 0xef, 0xf0, 0xf1, 0x4e, 0xd9, 0x9c, 0xe5, 0xb7, 0xf3, 0xeb, 0x7f, 0x0d, 0x00, 0x4f,
+	0xfc, 0x50, 0x42, 0x53, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -666,6 +822,9 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule updates the lockup and vesting schedules of an
+	// existing ClawbackVestingAccount.
+	UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -721,6 +880,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error) {
+	out := new(MsgUpdateVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/UpdateVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
@@ -735,6 +903,9 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule updates the lockup and vesting schedules of an
+	// existing ClawbackVestingAccount.
+	UpdateVestingSchedule(context.Context, *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -756,6 +927,9 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) UpdateVestingSchedule(ctx context.Context, req *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -851,6 +1025,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/UpdateVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, req.(*MsgUpdateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -875,6 +1067,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "UpdateVestingSchedule",
+			Handler:    _Msg_UpdateVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/tx.proto",
@@ -970,6 +1166,30 @@ func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VestingTemplate != nil {
+		{
+			size, err := m.VestingTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LockupTemplate != nil {
+		{
+			size, err := m.LockupTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -998,12 +1218,12 @@ func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.VestingAddress) > 0 {
@@ -1247,6 +1467,108 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopUp) > 0 {
+		for iNdEx := len(m.TopUp) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUp[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1315,6 +1637,14 @@ func (m *MsgFundVestingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.LockupTemplate != nil {
+		l = m.LockupTemplate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VestingTemplate != nil {
+		l = m.VestingTemplate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1415,6 +1745,50 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TopUp) > 0 {
+		for _, e := range m.TopUp {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1799,11 +2173,83 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockupTemplate == nil {
+				m.LockupTemplate = &ScheduleTemplate{}
+			}
+			if err := m.LockupTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VestingTemplate == nil {
+				m.VestingTemplate = &ScheduleTemplate{}
+			}
+			if err := m.VestingTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
@@ -2428,6 +2874,272 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUp = append(m.TopUp, types1.Coin{})
+			if err := m.TopUp[len(m.TopUp)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
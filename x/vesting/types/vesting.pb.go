@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -30,6 +32,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ScheduleInterval defines the interval between the tranches of a schedule
+// template.
+type ScheduleInterval int32
+
+const (
+	// SCHEDULE_INTERVAL_UNSPECIFIED defines an invalid/undefined interval.
+	SCHEDULE_INTERVAL_UNSPECIFIED ScheduleInterval = 0
+	// SCHEDULE_INTERVAL_CONTINUOUS - the coins are streamed linearly every second.
+	SCHEDULE_INTERVAL_CONTINUOUS ScheduleInterval = 1
+	// SCHEDULE_INTERVAL_MONTHLY - the coins are released in monthly tranches.
+	SCHEDULE_INTERVAL_MONTHLY ScheduleInterval = 2
+	// SCHEDULE_INTERVAL_QUARTERLY - the coins are released in quarterly tranches.
+	SCHEDULE_INTERVAL_QUARTERLY ScheduleInterval = 3
+)
+
+var ScheduleInterval_name = map[int32]string{
+	0: "SCHEDULE_INTERVAL_UNSPECIFIED",
+	1: "SCHEDULE_INTERVAL_CONTINUOUS",
+	2: "SCHEDULE_INTERVAL_MONTHLY",
+	3: "SCHEDULE_INTERVAL_QUARTERLY",
+}
+
+var ScheduleInterval_value = map[string]int32{
+	"SCHEDULE_INTERVAL_UNSPECIFIED": 0,
+	"SCHEDULE_INTERVAL_CONTINUOUS":  1,
+	"SCHEDULE_INTERVAL_MONTHLY":     2,
+	"SCHEDULE_INTERVAL_QUARTERLY":   3,
+}
+
+func (x ScheduleInterval) String() string {
+	return proto.EnumName(ScheduleInterval_name, int32(x))
+}
+
+func (ScheduleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{0}
+}
+
 // ClawbackVestingAccount implements the VestingAccount interface. It provides
 // an account that can hold contributions subject to "lockup" (like a
 // PeriodicVestingAccount), or vesting which is subject to clawback
@@ -46,6 +85,9 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// vesting_streams defines the continuous vesting schedules, which vest
+	// linearly every second in addition to the vesting periods
+	VestingStreams []VestingStream `protobuf:"bytes,6,rep,name=vesting_streams,json=vestingStreams,proto3" json:"vesting_streams"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -80,6 +122,162 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
+// ScheduleTemplate defines a lockup or vesting schedule relative to the start
+// time, which releases the coins linearly over the duration, either
+// continuously or in tranches, after an optional cliff. The coins released
+// before the cliff are released at the cliff.
+type ScheduleTemplate struct {
+	// interval defines the interval between the tranches of the schedule
+	Interval ScheduleInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=evmos.vesting.v2.ScheduleInterval" json:"interval,omitempty"`
+	// cliff defines the time in seconds after the start time before which no
+	// coins are released
+	Cliff int64 `protobuf:"varint,2,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// duration defines the time in seconds after the start time at which all
+	// the coins are released. It must be a multiple of the interval for
+	// tranches.
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// amount defines the coins released by the schedule
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ScheduleTemplate) Reset()         { *m = ScheduleTemplate{} }
+func (m *ScheduleTemplate) String() string { return proto.CompactTextString(m) }
+func (*ScheduleTemplate) ProtoMessage()    {}
+func (*ScheduleTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{1}
+}
+func (m *ScheduleTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleTemplate.Merge(m, src)
+}
+func (m *ScheduleTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleTemplate proto.InternalMessageInfo
+
+func (m *ScheduleTemplate) GetInterval() ScheduleInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return SCHEDULE_INTERVAL_UNSPECIFIED
+}
+
+func (m *ScheduleTemplate) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *ScheduleTemplate) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScheduleTemplate) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// VestingStream defines a continuous vesting schedule, which vests the coins
+// linearly every second between the start time and the end of the duration.
+// The coins vested before the cliff are vested at the cliff.
+type VestingStream struct {
+	// start_time defines the unix time at which the stream begins
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff defines the time in seconds after the start time before which no
+	// coins are vested
+	Cliff int64 `protobuf:"varint,2,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// duration defines the time in seconds after the start time at which all
+	// the coins are vested
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// amount defines the coins vested by the stream
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingStream) Reset()         { *m = VestingStream{} }
+func (m *VestingStream) String() string { return proto.CompactTextString(m) }
+func (*VestingStream) ProtoMessage()    {}
+func (*VestingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{2}
+}
+func (m *VestingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingStream.Merge(m, src)
+}
+func (m *VestingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingStream proto.InternalMessageInfo
+
+func (m *VestingStream) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingStream) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *VestingStream) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *VestingStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // ClawbackProposal is a gov Content type to clawback funds
 // from a vesting account that has this functionality enabled.
 type ClawbackProposal struct {
@@ -101,7 +299,7 @@ func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
 func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
 func (*ClawbackProposal) ProtoMessage()    {}
 func (*ClawbackProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0001d894a8ee0c72, []int{1}
+	return fileDescriptor_0001d894a8ee0c72, []int{3}
 }
 func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,47 +357,67 @@ func (m *ClawbackProposal) GetDestinationAddress() string {
 }
 
 func init() {
+	proto.RegisterEnum("evmos.vesting.v2.ScheduleInterval", ScheduleInterval_name, ScheduleInterval_value)
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "evmos.vesting.v2.ClawbackVestingAccount")
+	proto.RegisterType((*ScheduleTemplate)(nil), "evmos.vesting.v2.ScheduleTemplate")
+	proto.RegisterType((*VestingStream)(nil), "evmos.vesting.v2.VestingStream")
 	proto.RegisterType((*ClawbackProposal)(nil), "evmos.vesting.v2.ClawbackProposal")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/vesting.proto", fileDescriptor_0001d894a8ee0c72) }
 
 var fileDescriptor_0001d894a8ee0c72 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xd8, 0xae, 0xd2, 0x29, 0xad, 0xeb, 0x58, 0xa4, 0xf4, 0x90, 0x94, 0x45, 0xa1, 0x16,
-	0xcc, 0xb8, 0x15, 0x04, 0xf7, 0xb6, 0x51, 0xc4, 0xe3, 0x52, 0xc4, 0x83, 0x97, 0x30, 0x49, 0x66,
-	0xb3, 0xa1, 0x49, 0x26, 0x64, 0x26, 0x75, 0xfd, 0x07, 0x22, 0x88, 0x7b, 0x14, 0xbc, 0xf4, 0x28,
-	0x9e, 0xf6, 0x5f, 0xb8, 0xc7, 0x1e, 0x3d, 0xed, 0x4a, 0x7b, 0x58, 0x7f, 0x86, 0x64, 0x66, 0xb2,
-	0xa6, 0xa8, 0x57, 0x2f, 0xc9, 0xcc, 0x7b, 0x5f, 0xbe, 0x79, 0x6f, 0xbe, 0x17, 0x68, 0xd2, 0x79,
-	0xc2, 0x38, 0x9e, 0x53, 0x2e, 0xa2, 0x34, 0xc4, 0xf3, 0x49, 0xb5, 0xb4, 0xb3, 0x9c, 0x09, 0x86,
-	0xb6, 0x25, 0x6f, 0x57, 0xe0, 0x7c, 0x32, 0xb8, 0x45, 0x92, 0x28, 0x65, 0x58, 0x3e, 0x55, 0xd1,
-	0xe0, 0xae, 0xcf, 0xf8, 0x46, 0x97, 0x5d, 0x8f, 0x0a, 0xb2, 0xbb, 0xd9, 0x6a, 0xd0, 0x0b, 0x59,
-	0xc8, 0xe4, 0x12, 0x97, 0x2b, 0x8d, 0x5a, 0x21, 0x63, 0x61, 0x4c, 0xb1, 0xdc, 0x79, 0xc5, 0x21,
-	0x16, 0x51, 0x42, 0xb9, 0x20, 0x49, 0xa6, 0x0a, 0x76, 0xbe, 0x35, 0xe1, 0x9d, 0xa7, 0x31, 0x79,
-	0xe3, 0x11, 0x7f, 0xf6, 0x4a, 0x35, 0xdc, 0xf7, 0x7d, 0x56, 0xa4, 0x02, 0x79, 0xb0, 0xe7, 0x11,
-	0x4e, 0x5d, 0x7d, 0x8e, 0x4b, 0x14, 0xde, 0x07, 0x43, 0x30, 0x6a, 0x4f, 0xc6, 0xb6, 0x92, 0xf5,
-	0x5b, 0xbc, 0x92, 0x65, 0x3b, 0x84, 0xd3, 0xcd, 0x4e, 0x4e, 0x73, 0x79, 0x6e, 0x81, 0x29, 0xf2,
-	0xfe, 0x60, 0xd0, 0x3d, 0xd8, 0x3d, 0x2c, 0xd2, 0x80, 0xe6, 0x2e, 0x09, 0x82, 0x9c, 0x72, 0xde,
-	0xbf, 0x36, 0x04, 0xa3, 0xd6, 0xb4, 0xa3, 0xd0, 0x7d, 0x05, 0xa2, 0x17, 0x10, 0x72, 0x41, 0x72,
-	0xe1, 0x96, 0xf2, 0xfb, 0x0d, 0x29, 0x60, 0x60, 0x2b, 0x6f, 0x76, 0xe5, 0xcd, 0x7e, 0x59, 0x79,
-	0x73, 0x3a, 0x67, 0xe7, 0x96, 0x71, 0x72, 0x61, 0x81, 0x2f, 0x97, 0xa7, 0x63, 0x30, 0x6d, 0xc9,
-	0x8f, 0x4b, 0x1a, 0x7d, 0x00, 0xb0, 0x1b, 0x33, 0x7f, 0x56, 0x64, 0x6e, 0x46, 0xf3, 0x88, 0x05,
-	0xbc, 0xdf, 0x1c, 0x36, 0x46, 0xed, 0x89, 0xf9, 0x2f, 0x3f, 0x07, 0xb2, 0xcc, 0x79, 0x5e, 0xb6,
-	0xfc, 0x7a, 0x61, 0x3d, 0x09, 0x23, 0x71, 0x54, 0x78, 0xb6, 0xcf, 0x12, 0xac, 0x07, 0xa3, 0x5e,
-	0x0f, 0x78, 0x30, 0xc3, 0xc7, 0x98, 0x14, 0xe2, 0xe8, 0x6a, 0x54, 0xe2, 0x6d, 0x46, 0xb9, 0xee,
-	0xc0, 0x95, 0x96, 0x8e, 0x3a, 0x5d, 0x63, 0xe8, 0x23, 0x80, 0x37, 0xab, 0x0b, 0xae, 0x04, 0x6d,
-	0xfd, 0x57, 0x41, 0x5d, 0xcd, 0x69, 0x70, 0xef, 0xf1, 0xbb, 0x85, 0x65, 0x7c, 0x5a, 0x58, 0xc6,
-	0xfb, 0xcb, 0xd3, 0xf1, 0x7d, 0x15, 0xe0, 0xe3, 0x7a, 0x84, 0xff, 0x1e, 0x97, 0x9d, 0xcf, 0x00,
-	0x6e, 0x57, 0xd4, 0x41, 0xce, 0x32, 0xc6, 0x49, 0x8c, 0x7a, 0x70, 0x4b, 0x44, 0x22, 0xa6, 0x32,
-	0x34, 0xad, 0xa9, 0xda, 0xa0, 0x21, 0x6c, 0x07, 0x94, 0xfb, 0x79, 0x94, 0x89, 0x88, 0xa5, 0x7a,
-	0xe4, 0x75, 0x08, 0xf5, 0xe1, 0x8d, 0x2a, 0x10, 0x0d, 0xc9, 0x56, 0x5b, 0x84, 0xe1, 0xed, 0x40,
-	0x1e, 0x4c, 0xca, 0xc2, 0xab, 0xd8, 0x34, 0x65, 0x15, 0xaa, 0x51, 0x3a, 0x3b, 0x7b, 0xcd, 0x9f,
-	0x0b, 0xcb, 0x70, 0x9e, 0x9d, 0xad, 0x4c, 0xb0, 0x5c, 0x99, 0xe0, 0xc7, 0xca, 0x04, 0x27, 0x6b,
-	0xd3, 0x58, 0xae, 0x4d, 0xe3, 0xfb, 0xda, 0x34, 0x5e, 0x8f, 0x6b, 0xf7, 0xa7, 0xdc, 0xea, 0x9f,
-	0x76, 0xf2, 0xb0, 0xe6, 0x5b, 0x5e, 0x9c, 0x77, 0x5d, 0x66, 0xed, 0xd1, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xd1, 0xdd, 0x7b, 0xd8, 0x03, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xf6, 0x6c, 0xd2, 0xb2, 0x99, 0x2a, 0x21, 0x0c, 0x15, 0xca, 0x06, 0x6a, 0x87, 0x08, 0xa4,
+	0x10, 0x09, 0x9b, 0x0d, 0x02, 0x89, 0x3d, 0x20, 0x25, 0x69, 0x56, 0x8d, 0x14, 0xd2, 0xe2, 0x24,
+	0x2b, 0x2d, 0x97, 0x68, 0x6c, 0x4f, 0x12, 0xab, 0xb6, 0xc7, 0xf2, 0x8c, 0xc3, 0xf2, 0x0f, 0x56,
+	0x48, 0x88, 0x3d, 0x22, 0x01, 0x52, 0x25, 0x2e, 0x88, 0xd3, 0xfe, 0x8c, 0x15, 0xa7, 0x1e, 0x39,
+	0xb5, 0xa8, 0x3d, 0x94, 0x13, 0xbf, 0x01, 0x79, 0xc6, 0x4e, 0x13, 0x02, 0x1c, 0xd1, 0x5e, 0xec,
+	0x99, 0xf7, 0xbd, 0x79, 0xf3, 0xbd, 0xf9, 0xbe, 0x19, 0xa8, 0x92, 0xa5, 0x4f, 0x99, 0xb1, 0x24,
+	0x8c, 0xbb, 0xc1, 0xdc, 0x58, 0xb6, 0xb2, 0xa1, 0x1e, 0x46, 0x94, 0x53, 0x54, 0x16, 0xb8, 0x9e,
+	0x05, 0x97, 0xad, 0xea, 0x6b, 0xd8, 0x77, 0x03, 0x6a, 0x88, 0xaf, 0x4c, 0xaa, 0xaa, 0x36, 0x65,
+	0x49, 0x15, 0x0b, 0x33, 0x62, 0x2c, 0xef, 0x5b, 0x84, 0xe3, 0xfb, 0x86, 0x4d, 0xdd, 0x20, 0xc5,
+	0xdf, 0x49, 0xf1, 0xd5, 0x2e, 0x69, 0xca, 0xc6, 0x56, 0xd5, 0xfd, 0x39, 0x9d, 0x53, 0x31, 0x34,
+	0x92, 0x51, 0x1a, 0xd5, 0xe6, 0x94, 0xce, 0x3d, 0x62, 0x88, 0x99, 0x15, 0xcf, 0x0c, 0xee, 0xfa,
+	0x84, 0x71, 0xec, 0x87, 0x32, 0xa1, 0xfe, 0xc3, 0x0e, 0x7c, 0xa3, 0xeb, 0xe1, 0x2f, 0x2d, 0x6c,
+	0x9f, 0x3e, 0x92, 0x05, 0xdb, 0xb6, 0x4d, 0xe3, 0x80, 0x23, 0x0b, 0xee, 0x27, 0x94, 0xa6, 0xe9,
+	0x3e, 0x53, 0x2c, 0xe3, 0x15, 0x50, 0x03, 0x8d, 0xbd, 0x56, 0x53, 0x97, 0xb4, 0x6e, 0x9b, 0x93,
+	0xb4, 0xf4, 0x0e, 0x66, 0x64, 0xb3, 0x52, 0x27, 0x7f, 0x7e, 0xa1, 0x01, 0x13, 0x59, 0x5b, 0x08,
+	0x7a, 0x17, 0x96, 0x66, 0x71, 0xe0, 0x90, 0x68, 0x8a, 0x1d, 0x27, 0x22, 0x8c, 0x55, 0xee, 0xd4,
+	0x40, 0xa3, 0x60, 0x16, 0x65, 0xb4, 0x2d, 0x83, 0xe8, 0x08, 0x42, 0xc6, 0x71, 0xc4, 0xa7, 0x09,
+	0xfd, 0x4a, 0x4e, 0x10, 0xa8, 0xea, 0xb2, 0x37, 0x3d, 0xeb, 0x4d, 0x1f, 0x67, 0xbd, 0x75, 0x8a,
+	0x2f, 0x2e, 0x34, 0xe5, 0xd9, 0xa5, 0x06, 0x7e, 0xbe, 0x79, 0xde, 0x04, 0x66, 0x41, 0x2c, 0x4e,
+	0x60, 0xf4, 0x0d, 0x80, 0x25, 0x8f, 0xda, 0xa7, 0x71, 0x38, 0x0d, 0x49, 0xe4, 0x52, 0x87, 0x55,
+	0xf2, 0xb5, 0x5c, 0x63, 0xaf, 0xa5, 0xfe, 0x5b, 0x3f, 0x27, 0x22, 0xad, 0xf3, 0x30, 0x29, 0xf9,
+	0xcb, 0xa5, 0xf6, 0xc9, 0xdc, 0xe5, 0x8b, 0xd8, 0xd2, 0x6d, 0xea, 0x1b, 0xa9, 0x30, 0xf2, 0xf7,
+	0x3e, 0x73, 0x4e, 0x8d, 0x27, 0x06, 0x8e, 0xf9, 0x62, 0x25, 0x15, 0xff, 0x2a, 0x24, 0x2c, 0xad,
+	0xc0, 0x24, 0x97, 0xa2, 0xdc, 0x3d, 0x8d, 0xa1, 0x6f, 0x01, 0x7c, 0x35, 0x3b, 0xe0, 0x8c, 0xd0,
+	0xce, 0xff, 0x4a, 0xa8, 0x94, 0x62, 0x19, 0xa3, 0xd1, 0x2d, 0x21, 0xc6, 0x23, 0x82, 0x7d, 0x56,
+	0xd9, 0x15, 0x84, 0x34, 0xfd, 0xef, 0x6e, 0xd6, 0x53, 0x35, 0x47, 0x22, 0xaf, 0x53, 0x48, 0x18,
+	0x6d, 0x16, 0x95, 0x08, 0x7b, 0xf0, 0xf1, 0xd3, 0x33, 0x4d, 0xf9, 0xee, 0x4c, 0x53, 0xbe, 0xbe,
+	0x79, 0xde, 0x7c, 0x4f, 0xde, 0x9a, 0x27, 0xeb, 0xf7, 0xe6, 0x9f, 0x3d, 0x58, 0xff, 0x13, 0xc0,
+	0xf2, 0xc8, 0x5e, 0x10, 0x27, 0xf6, 0xc8, 0x98, 0xf8, 0xa1, 0x87, 0x39, 0x41, 0x9f, 0xc2, 0xbb,
+	0x6e, 0xc0, 0x49, 0xb4, 0xc4, 0x9e, 0x30, 0x63, 0xa9, 0x55, 0xdf, 0xa6, 0x96, 0xad, 0xea, 0xa7,
+	0x99, 0xe6, 0x6a, 0x0d, 0xda, 0x87, 0x3b, 0xb6, 0xe7, 0xce, 0x66, 0xc2, 0x6b, 0x39, 0x53, 0x4e,
+	0x50, 0x15, 0xde, 0x75, 0xe2, 0x08, 0x73, 0x97, 0x06, 0xc2, 0x61, 0x39, 0x73, 0x35, 0x47, 0x0b,
+	0xb8, 0x8b, 0x7d, 0x61, 0x7e, 0x69, 0x96, 0x7b, 0x99, 0x36, 0x89, 0xa5, 0x57, 0xc2, 0x74, 0xa9,
+	0x1b, 0x74, 0x3e, 0x4a, 0x65, 0x69, 0xfc, 0xa7, 0x2c, 0x52, 0x87, 0x64, 0x41, 0xaa, 0x42, 0x5a,
+	0xbf, 0xfe, 0x2b, 0x80, 0xc5, 0x8d, 0x53, 0x45, 0x07, 0x1b, 0xde, 0x07, 0x82, 0xd9, 0x9a, 0xa1,
+	0x5f, 0xe6, 0x66, 0xbe, 0x07, 0xb0, 0x9c, 0x09, 0x7b, 0x12, 0xd1, 0x90, 0x32, 0x79, 0xfa, 0xdc,
+	0xe5, 0x9e, 0x6c, 0xa5, 0x60, 0xca, 0x09, 0xaa, 0xc1, 0x3d, 0x87, 0x30, 0x3b, 0x72, 0x43, 0xc1,
+	0x59, 0xbe, 0x02, 0xeb, 0x21, 0x54, 0x81, 0xaf, 0x64, 0x6f, 0x44, 0x4e, 0xa0, 0xd9, 0x14, 0x19,
+	0xf0, 0x75, 0x47, 0x1c, 0x99, 0xe8, 0x6f, 0xf5, 0x92, 0xe4, 0x45, 0x16, 0x5a, 0x83, 0xd2, 0xe7,
+	0xe4, 0x41, 0xfe, 0x8f, 0x33, 0x4d, 0x69, 0xfe, 0xb8, 0xe6, 0xad, 0xcc, 0x25, 0xe8, 0x6d, 0x78,
+	0x30, 0xea, 0x1e, 0xf5, 0x0e, 0x27, 0x83, 0xde, 0xb4, 0x3f, 0x1c, 0xf7, 0xcc, 0x47, 0xed, 0xc1,
+	0x74, 0x32, 0x1c, 0x9d, 0xf4, 0xba, 0xfd, 0x87, 0xfd, 0xde, 0x61, 0x59, 0x41, 0x35, 0xf8, 0xd6,
+	0x76, 0x4a, 0xf7, 0x78, 0x38, 0xee, 0x0f, 0x27, 0xc7, 0x93, 0x51, 0x19, 0xa0, 0x03, 0x78, 0x6f,
+	0x3b, 0xe3, 0xb3, 0xe3, 0xe1, 0xf8, 0x68, 0xf0, 0xb8, 0x7c, 0x07, 0x69, 0xf0, 0xcd, 0x6d, 0xf8,
+	0xf3, 0x49, 0xdb, 0x1c, 0xf7, 0xcc, 0xc1, 0xe3, 0x72, 0xae, 0x9a, 0x7f, 0xfa, 0x93, 0xaa, 0x74,
+	0x0e, 0x5f, 0x5c, 0xa9, 0xe0, 0xfc, 0x4a, 0x05, 0xbf, 0x5f, 0xa9, 0xe0, 0xd9, 0xb5, 0xaa, 0x9c,
+	0x5f, 0xab, 0xca, 0x6f, 0xd7, 0xaa, 0xf2, 0x45, 0x73, 0x4d, 0x0e, 0x79, 0x97, 0xe4, 0x77, 0xd9,
+	0xfa, 0x60, 0xed, 0x56, 0x09, 0x59, 0xac, 0x5d, 0xf1, 0x3c, 0x7e, 0xf8, 0xd7, 0x00, 0x62, 0xd1,
+	0x45, 0x68, 0xab, 0x06, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -222,6 +440,20 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingStreams) > 0 {
+		for iNdEx := len(m.VestingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,6 +512,110 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Duration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClawbackProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -370,6 +706,60 @@ func (m *ClawbackVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
+	if len(m.VestingStreams) > 0 {
+		for _, e := range m.VestingStreams {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovVesting(uint64(m.Interval))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovVesting(uint64(m.Duration))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -602,6 +992,322 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingStreams = append(m.VestingStreams, VestingStream{})
+			if err := m.VestingStreams[len(m.VestingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= ScheduleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])